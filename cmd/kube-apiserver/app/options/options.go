@@ -19,6 +19,7 @@ package options
 
 import (
 	"net"
+	"strconv"
 	"strings"
 	"time"
 
@@ -49,6 +50,8 @@ type ServerRunOptions struct {
 	CloudProvider           *kubeoptions.CloudProviderOptions
 	APIEnablement           *genericoptions.APIEnablementOptions
 
+	AcknowledgeInsecureConfiguration bool
+
 	AllowPrivileged           bool
 	EnableLogsHandler         bool
 	EventTTL                  time.Duration
@@ -148,6 +151,10 @@ func (s *ServerRunOptions) Flags() (fss cliflag.NamedFlagSets) {
 	fs.BoolVar(&s.EnableLogsHandler, "enable-logs-handler", s.EnableLogsHandler,
 		"If true, install a /logs handler for the apiserver logs.")
 
+	fs.BoolVar(&s.AcknowledgeInsecureConfiguration, "acknowledge-insecure-configuration", s.AcknowledgeInsecureConfiguration,
+		"If true, configuration combinations flagged as dangerous by the startup linter "+
+			"(for example an insecure port bound to a non-loopback address) are logged as warnings instead of failing validation.")
+
 	// Deprecated in release 1.9
 	fs.StringVar(&s.SSHUser, "ssh-user", s.SSHUser,
 		"If non-empty, use secure SSH proxy to the nodes, using this user name")
@@ -230,3 +237,20 @@ func (s *ServerRunOptions) Flags() (fss cliflag.NamedFlagSets) {
 
 	return fss
 }
+
+// StartupDiagnostics assembles a structured summary of the effective server
+// configuration, suitable for logging and for linting dangerous combinations.
+func (s *ServerRunOptions) StartupDiagnostics() *genericoptions.StartupDiagnostics {
+	d := &genericoptions.StartupDiagnostics{
+		AuthorizationModes: s.Authorization.Modes,
+		AdmissionPlugins:   s.Admission.GenericAdmission.EnabledPluginNames(),
+		StorageEndpoints:   genericoptions.RedactEndpoints(s.Etcd.StorageConfig.Transport.ServerList),
+	}
+	if s.SecureServing != nil && s.SecureServing.BindPort != 0 {
+		d.SecureBindAddress = net.JoinHostPort(s.SecureServing.BindAddress.String(), strconv.Itoa(s.SecureServing.BindPort))
+	}
+	if s.InsecureServing != nil && s.InsecureServing.BindPort != 0 {
+		d.InsecureBindAddress = net.JoinHostPort(s.InsecureServing.BindAddress.String(), strconv.Itoa(s.InsecureServing.BindPort))
+	}
+	return d
+}
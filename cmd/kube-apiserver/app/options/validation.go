@@ -22,6 +22,7 @@ import (
 
 	apiextensionsapiserver "k8s.io/apiextensions-apiserver/pkg/apiserver"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/klog"
 	aggregatorscheme "k8s.io/kube-aggregator/pkg/apiserver/scheme"
 	"k8s.io/kubernetes/pkg/api/legacyscheme"
 	"k8s.io/kubernetes/pkg/features"
@@ -83,6 +84,28 @@ func validateTokenRequest(options *ServerRunOptions) []error {
 	return errs
 }
 
+// validateStartupConfiguration runs the startup configuration linter over the
+// assembled diagnostics. Lint findings fail validation unless the operator
+// acknowledged them with --acknowledge-insecure-configuration, in which case
+// they are downgraded to warnings.
+func validateStartupConfiguration(options *ServerRunOptions) []error {
+	lintErrs := options.StartupDiagnostics().Lint()
+	if len(lintErrs) == 0 {
+		return nil
+	}
+	if options.AcknowledgeInsecureConfiguration {
+		for _, err := range lintErrs {
+			klog.Warningf("Insecure configuration acknowledged by --acknowledge-insecure-configuration: %v", err)
+		}
+		return nil
+	}
+	var errs []error
+	for _, err := range lintErrs {
+		errs = append(errs, fmt.Errorf("%v (pass --acknowledge-insecure-configuration to start anyway)", err))
+	}
+	return errs
+}
+
 // Validate checks ServerRunOptions and return a slice of found errs.
 func (s *ServerRunOptions) Validate() []error {
 	var errs []error
@@ -100,6 +123,7 @@ func (s *ServerRunOptions) Validate() []error {
 	errs = append(errs, s.InsecureServing.Validate()...)
 	errs = append(errs, s.APIEnablement.Validate(legacyscheme.Scheme, apiextensionsapiserver.Scheme, aggregatorscheme.Scheme)...)
 	errs = append(errs, validateTokenRequest(s)...)
+	errs = append(errs, validateStartupConfiguration(s)...)
 
 	return errs
 }
@@ -144,6 +144,9 @@ func Run(completeOptions completedServerRunOptions, stopCh <-chan struct{}) erro
 	// To help debugging, immediately log version
 	klog.Infof("Version: %+v", version.Get())
 
+	// Log a single structured summary of the effective configuration.
+	completeOptions.StartupDiagnostics().Log()
+
 	server, err := CreateServerChain(completeOptions, stopCh)
 	if err != nil {
 		return err
@@ -171,6 +171,9 @@ func Run(c *config.CompletedConfig, stopCh <-chan struct{}) error {
 
 	// Setup any healthz checks we will want to use.
 	var checks []healthz.HealthzChecker
+	checks = append(checks, healthz.NamedCheck("controller-syncs", func(_ *http.Request) error {
+		return controller.CheckSyncMonitors()
+	}))
 	var electionChecker *leaderelection.HealthzAdaptor
 	if c.ComponentConfig.Generic.LeaderElection.LeaderElect {
 		electionChecker = leaderelection.NewLeaderHealthzAdaptor(time.Second * 20)
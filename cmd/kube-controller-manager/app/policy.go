@@ -22,6 +22,8 @@ package app
 
 import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/scale"
 	"k8s.io/kubernetes/pkg/controller/disruption"
 
 	"net/http"
@@ -40,6 +42,14 @@ func startDisruptionController(ctx ControllerContext) (http.Handler, bool, error
 			resource, group+"/"+version)
 		return nil, false, nil
 	}
+	client := ctx.ClientBuilder.ClientOrDie("disruption-controller")
+	config := ctx.ClientBuilder.ConfigOrDie("disruption-controller")
+	scaleKindResolver := scale.NewDiscoveryScaleKindResolver(client.Discovery())
+	scaleClient, err := scale.NewForConfig(config, ctx.RESTMapper, dynamic.LegacyAPIPathResolverFunc, scaleKindResolver)
+	if err != nil {
+		return nil, false, err
+	}
+
 	go disruption.NewDisruptionController(
 		ctx.InformerFactory.Core().V1().Pods(),
 		ctx.InformerFactory.Policy().V1beta1().PodDisruptionBudgets(),
@@ -47,7 +57,9 @@ func startDisruptionController(ctx ControllerContext) (http.Handler, bool, error
 		ctx.InformerFactory.Apps().V1().ReplicaSets(),
 		ctx.InformerFactory.Apps().V1().Deployments(),
 		ctx.InformerFactory.Apps().V1().StatefulSets(),
-		ctx.ClientBuilder.ClientOrDie("disruption-controller"),
+		client,
+		ctx.RESTMapper,
+		scaleClient,
 	).Run(ctx.Stop)
 	return nil, true, nil
 }
@@ -51,6 +51,20 @@ type Config struct {
 
 	// LeaderElection is optional.
 	LeaderElection *leaderelection.LeaderElectionConfig
+
+	// ConfigFile is the location the ComponentConfig was loaded from, or
+	// empty if it was assembled from flags. When set, the file is watched
+	// for live reloads of the fields that can change without a restart.
+	ConfigFile string
+
+	// ReacquireLeaderLease makes the scheduler re-enter the leader election
+	// loop after losing its lease instead of exiting the process.
+	ReacquireLeaderLease bool
+
+	// LeaderDemotionHook is optional. It is invoked after the ordered
+	// shutdown of a leadership term, so that callers embedding the scheduler
+	// can hand off state before the process exits or re-acquires the lease.
+	LeaderDemotionHook func()
 }
 
 type completedConfig struct {
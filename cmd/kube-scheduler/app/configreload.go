@@ -0,0 +1,180 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"net/http"
+	"path/filepath"
+	goruntime "runtime"
+	"sync/atomic"
+
+	"github.com/fsnotify/fsnotify"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/klog"
+	"k8s.io/kubernetes/cmd/kube-scheduler/app/options"
+	"k8s.io/kubernetes/pkg/scheduler"
+	kubeschedulerconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
+)
+
+// profilingGate dynamically enables or disables the pprof endpoints so that a
+// config file reload can toggle profiling without restarting the server.
+type profilingGate struct {
+	enabled int32
+}
+
+func newProfilingGate(enabled bool) *profilingGate {
+	g := &profilingGate{}
+	g.Set(enabled)
+	return g
+}
+
+// Set opens or closes the gate.
+func (g *profilingGate) Set(enabled bool) {
+	if enabled {
+		atomic.StoreInt32(&g.enabled, 1)
+	} else {
+		atomic.StoreInt32(&g.enabled, 0)
+	}
+}
+
+// Enabled reports whether the gate is currently open.
+func (g *profilingGate) Enabled() bool {
+	return atomic.LoadInt32(&g.enabled) == 1
+}
+
+// guard serves from handler while the gate is open and returns 404 otherwise,
+// so disabled profiling endpoints look exactly as if they were never
+// installed.
+func (g *profilingGate) guard(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !g.Enabled() {
+			http.NotFound(w, req)
+			return
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// setBlockProfileRate enables or disables goroutine blocking profiles to
+// match the contention profiling setting.
+func setBlockProfileRate(enabled bool) {
+	if enabled {
+		goruntime.SetBlockProfileRate(1)
+	} else {
+		goruntime.SetBlockProfileRate(0)
+	}
+}
+
+// configReloader watches the --config file and applies changes to the small
+// set of fields that can take effect without re-initializing the scheduler:
+// percentageOfNodesToScore and the profiling toggles. All other changes are
+// logged as requiring a restart and otherwise ignored.
+type configReloader struct {
+	path      string
+	current   kubeschedulerconfig.KubeSchedulerConfiguration
+	sched     *scheduler.Scheduler
+	profiling *profilingGate
+}
+
+func newConfigReloader(path string, current kubeschedulerconfig.KubeSchedulerConfiguration, sched *scheduler.Scheduler, profiling *profilingGate) *configReloader {
+	return &configReloader{
+		path:      path,
+		current:   current,
+		sched:     sched,
+		profiling: profiling,
+	}
+}
+
+// run watches the directory containing the config file, rather than the file
+// itself, so that atomic replacements (the usual ConfigMap update pattern of
+// writing a new file and renaming it over the old one) are observed as well
+// as in-place writes. It returns when stopCh is closed.
+func (r *configReloader) run(stopCh <-chan struct{}) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		klog.Errorf("config reload disabled, cannot create file watcher: %v", err)
+		return
+	}
+	defer watcher.Close()
+
+	dir := filepath.Dir(r.path)
+	if err := watcher.Add(dir); err != nil {
+		klog.Errorf("config reload disabled, cannot watch %q: %v", dir, err)
+		return
+	}
+
+	for {
+		select {
+		case event := <-watcher.Events:
+			if filepath.Clean(event.Name) != filepath.Clean(r.path) {
+				continue
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+				continue
+			}
+			r.reload()
+		case err := <-watcher.Errors:
+			klog.Errorf("error watching config file %q: %v", r.path, err)
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+func (r *configReloader) reload() {
+	cfg, err := options.LoadConfigFromFile(r.path)
+	if err != nil {
+		klog.Errorf("rejecting config reload, cannot load %q: %v", r.path, err)
+		return
+	}
+	r.apply(cfg)
+}
+
+// apply folds the reloadable fields of cfg into the running scheduler and
+// reports any remaining differences as requiring a restart.
+func (r *configReloader) apply(cfg *kubeschedulerconfig.KubeSchedulerConfiguration) {
+	if cfg.PercentageOfNodesToScore != r.current.PercentageOfNodesToScore {
+		if r.sched.SetPercentageOfNodesToScore(cfg.PercentageOfNodesToScore) {
+			klog.Infof("config reload: percentageOfNodesToScore changed from %d to %d", r.current.PercentageOfNodesToScore, cfg.PercentageOfNodesToScore)
+			r.current.PercentageOfNodesToScore = cfg.PercentageOfNodesToScore
+		} else {
+			klog.Warningf("config reload: the configured algorithm does not support changing percentageOfNodesToScore at runtime")
+		}
+	}
+
+	if cfg.EnableProfiling != r.current.EnableProfiling {
+		r.profiling.Set(cfg.EnableProfiling)
+		klog.Infof("config reload: enableProfiling changed from %t to %t", r.current.EnableProfiling, cfg.EnableProfiling)
+		r.current.EnableProfiling = cfg.EnableProfiling
+	}
+	if cfg.EnableContentionProfiling != r.current.EnableContentionProfiling {
+		klog.Infof("config reload: enableContentionProfiling changed from %t to %t", r.current.EnableContentionProfiling, cfg.EnableContentionProfiling)
+		r.current.EnableContentionProfiling = cfg.EnableContentionProfiling
+	}
+	setBlockProfileRate(r.current.EnableProfiling && r.current.EnableContentionProfiling)
+
+	// Everything else requires re-initialization. Make it obvious that the
+	// remaining changes were ignored rather than silently dropping them.
+	rest := *cfg
+	rest.PercentageOfNodesToScore = r.current.PercentageOfNodesToScore
+	rest.EnableProfiling = r.current.EnableProfiling
+	rest.EnableContentionProfiling = r.current.EnableContentionProfiling
+	if !apiequality.Semantic.DeepEqual(rest, r.current) {
+		klog.Warningf("config reload: %q changes fields beyond percentageOfNodesToScore, enableProfiling and enableContentionProfiling; restart the scheduler to apply them", r.path)
+	}
+}
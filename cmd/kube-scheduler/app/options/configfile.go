@@ -27,6 +27,13 @@ import (
 	kubeschedulerconfigv1alpha1 "k8s.io/kubernetes/pkg/scheduler/apis/config/v1alpha1"
 )
 
+// LoadConfigFromFile loads a KubeSchedulerConfiguration from the given file.
+// It is used both for the initial --config load and for live reloads of the
+// same file.
+func LoadConfigFromFile(file string) (*kubeschedulerconfig.KubeSchedulerConfiguration, error) {
+	return loadConfigFromFile(file)
+}
+
 func loadConfigFromFile(file string) (*kubeschedulerconfig.KubeSchedulerConfiguration, error) {
 	data, err := ioutil.ReadFile(file)
 	if err != nil {
@@ -69,6 +69,10 @@ type Options struct {
 	WriteConfigTo string
 
 	Master string
+
+	// ReacquireLeaderLease makes the scheduler re-enter the leader election
+	// loop after losing its lease instead of exiting the process.
+	ReacquireLeaderLease bool
 }
 
 // NewOptions returns default scheduler app options.
@@ -153,6 +157,8 @@ func (o *Options) Flags() (nfs cliflag.NamedFlagSets) {
 	o.Deprecated.AddFlags(nfs.FlagSet("deprecated"), &o.ComponentConfig)
 
 	leaderelectionconfig.BindFlags(&o.ComponentConfig.LeaderElection.LeaderElectionConfiguration, nfs.FlagSet("leader election"))
+	nfs.FlagSet("leader election").BoolVar(&o.ReacquireLeaderLease, "leader-elect-reacquire", o.ReacquireLeaderLease,
+		"If true, re-enter the leader election loop after losing the leader lease instead of exiting the process.")
 	utilfeature.DefaultMutableFeatureGate.AddFlag(nfs.FlagSet("feature gate"))
 
 	return nfs
@@ -180,6 +186,7 @@ func (o *Options) ApplyTo(c *schedulerappconfig.Config) error {
 		// none of the deprecated flags in o.Deprecated are taken into consideration. This is the old
 		// behaviour of the flags we have to keep.
 		c.ComponentConfig = *cfg
+		c.ConfigFile = o.ConfigFile
 
 		if err := o.CombinedInsecureServing.ApplyToFromLoadedConfig(c, &c.ComponentConfig); err != nil {
 			return err
@@ -198,6 +205,8 @@ func (o *Options) ApplyTo(c *schedulerappconfig.Config) error {
 		}
 	}
 
+	c.ReacquireLeaderLease = o.ReacquireLeaderLease
+
 	return nil
 }
 
@@ -0,0 +1,127 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/klog"
+)
+
+// requiredResource identifies an API resource that one of the scheduler's
+// informers needs to list and watch.
+type requiredResource struct {
+	groupVersion string
+	group        string
+	resource     string
+}
+
+// requiredResources covers every informer started by Run. Keep this list in
+// sync with the informers passed to scheduler.New.
+var requiredResources = []requiredResource{
+	{groupVersion: "v1", group: "", resource: "pods"},
+	{groupVersion: "v1", group: "", resource: "nodes"},
+	{groupVersion: "v1", group: "", resource: "persistentvolumes"},
+	{groupVersion: "v1", group: "", resource: "persistentvolumeclaims"},
+	{groupVersion: "v1", group: "", resource: "replicationcontrollers"},
+	{groupVersion: "v1", group: "", resource: "services"},
+	{groupVersion: "apps/v1", group: "apps", resource: "replicasets"},
+	{groupVersion: "apps/v1", group: "apps", resource: "statefulsets"},
+	{groupVersion: "policy/v1beta1", group: "policy", resource: "poddisruptionbudgets"},
+	{groupVersion: "storage.k8s.io/v1", group: "storage.k8s.io", resource: "storageclasses"},
+}
+
+// runPreflightChecks verifies that the API versions backing the scheduler's
+// informers are served and that the scheduler is authorized to list and watch
+// them. All problems are aggregated into a single error so a misconfigured
+// cluster fails fast with one actionable message instead of the scheduler
+// running with silently empty caches.
+func runPreflightChecks(client clientset.Interface) error {
+	var errs []error
+	errs = append(errs, checkAPIAvailability(client)...)
+	errs = append(errs, checkInformerPermissions(client)...)
+	return utilerrors.NewAggregate(errs)
+}
+
+// checkAPIAvailability checks via discovery that every required resource is
+// served by the cluster.
+func checkAPIAvailability(client clientset.Interface) []error {
+	var errs []error
+	served := map[string]map[string]bool{}
+	for _, r := range requiredResources {
+		resources, ok := served[r.groupVersion]
+		if !ok {
+			resourceList, err := client.Discovery().ServerResourcesForGroupVersion(r.groupVersion)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					errs = append(errs, fmt.Errorf("API version %q required by the scheduler is not served by this cluster", r.groupVersion))
+				} else {
+					errs = append(errs, fmt.Errorf("failed to discover API version %q: %v", r.groupVersion, err))
+				}
+				served[r.groupVersion] = nil
+				continue
+			}
+			resources = map[string]bool{}
+			for _, apiResource := range resourceList.APIResources {
+				resources[apiResource.Name] = true
+			}
+			served[r.groupVersion] = resources
+		}
+		if resources == nil {
+			// discovery of this group version already failed above
+			continue
+		}
+		if !resources[r.resource] {
+			errs = append(errs, fmt.Errorf("resource %q in API version %q required by the scheduler is not served by this cluster", r.resource, r.groupVersion))
+		}
+	}
+	return errs
+}
+
+// checkInformerPermissions issues a SelfSubjectAccessReview for the list and
+// watch verbs on every required resource. If the cluster cannot answer access
+// reviews at all, the check is skipped with a warning rather than failing
+// startup.
+func checkInformerPermissions(client clientset.Interface) []error {
+	var errs []error
+	for _, r := range requiredResources {
+		for _, verb := range []string{"list", "watch"} {
+			sar := &authorizationv1.SelfSubjectAccessReview{
+				Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+					ResourceAttributes: &authorizationv1.ResourceAttributes{
+						Group:    r.group,
+						Resource: r.resource,
+						Verb:     verb,
+					},
+				},
+			}
+			result, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(sar)
+			if err != nil {
+				klog.Warningf("Skipping scheduler permission preflight checks: SelfSubjectAccessReview failed: %v", err)
+				return errs
+			}
+			if !result.Status.Allowed {
+				errs = append(errs, fmt.Errorf("scheduler is not permitted to %s %q in API version %q", verb, r.resource, r.groupVersion))
+			}
+		}
+	}
+	return errs
+}
@@ -23,7 +23,6 @@ import (
 	"io"
 	"net/http"
 	"os"
-	goruntime "runtime"
 
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -160,6 +159,12 @@ func Run(cc schedulerserverconfig.CompletedConfig, stopCh <-chan struct{}) error
 	// To help debugging, immediately log version
 	klog.V(1).Infof("Starting Kubernetes Scheduler version %+v", version.Get())
 
+	// Fail fast if the cluster does not serve the resources the scheduler's
+	// informers depend on, or does not permit the scheduler to watch them.
+	if err := runPreflightChecks(cc.Client); err != nil {
+		return fmt.Errorf("scheduler preflight checks failed: %v", err)
+	}
+
 	// Create the scheduler.
 	sched, err := scheduler.New(cc.Client,
 		cc.InformerFactory.Core().V1().Nodes(),
@@ -182,7 +187,8 @@ func Run(cc schedulerserverconfig.CompletedConfig, stopCh <-chan struct{}) error
 		scheduler.WithHardPodAffinitySymmetricWeight(cc.ComponentConfig.HardPodAffinitySymmetricWeight),
 		scheduler.WithPreemptionDisabled(cc.ComponentConfig.DisablePreemption),
 		scheduler.WithPercentageOfNodesToScore(cc.ComponentConfig.PercentageOfNodesToScore),
-		scheduler.WithBindTimeoutSeconds(*cc.ComponentConfig.BindTimeoutSeconds))
+		scheduler.WithBindTimeoutSeconds(*cc.ComponentConfig.BindTimeoutSeconds),
+		scheduler.WithDecisionLog(cc.ComponentConfig.DecisionLogFile, cc.ComponentConfig.DecisionLogSampleRate))
 	if err != nil {
 		return err
 	}
@@ -199,22 +205,33 @@ func Run(cc schedulerserverconfig.CompletedConfig, stopCh <-chan struct{}) error
 		checks = append(checks, cc.LeaderElection.WatchDog)
 	}
 
+	// The profiling endpoints are installed unconditionally but gated, so a
+	// config file reload can toggle them without restarting the server.
+	profiling := newProfilingGate(cc.ComponentConfig.EnableProfiling)
+	setBlockProfileRate(cc.ComponentConfig.EnableProfiling && cc.ComponentConfig.EnableContentionProfiling)
+
+	// If the component config was loaded from a file, watch it and live-apply
+	// the fields that do not require re-initialization.
+	if cc.ConfigFile != "" {
+		go newConfigReloader(cc.ConfigFile, cc.ComponentConfig, sched, profiling).run(stopCh)
+	}
+
 	// Start up the healthz server.
 	if cc.InsecureServing != nil {
 		separateMetrics := cc.InsecureMetricsServing != nil
-		handler := buildHandlerChain(newHealthzHandler(&cc.ComponentConfig, separateMetrics, checks...), nil, nil)
+		handler := buildHandlerChain(newHealthzHandler(&cc.ComponentConfig, separateMetrics, profiling, checks...), nil, nil)
 		if err := cc.InsecureServing.Serve(handler, 0, stopCh); err != nil {
 			return fmt.Errorf("failed to start healthz server: %v", err)
 		}
 	}
 	if cc.InsecureMetricsServing != nil {
-		handler := buildHandlerChain(newMetricsHandler(&cc.ComponentConfig), nil, nil)
+		handler := buildHandlerChain(newMetricsHandler(&cc.ComponentConfig, profiling), nil, nil)
 		if err := cc.InsecureMetricsServing.Serve(handler, 0, stopCh); err != nil {
 			return fmt.Errorf("failed to start metrics server: %v", err)
 		}
 	}
 	if cc.SecureServing != nil {
-		handler := buildHandlerChain(newHealthzHandler(&cc.ComponentConfig, false, checks...), cc.Authentication.Authenticator, cc.Authorization.Authorizer)
+		handler := buildHandlerChain(newHealthzHandler(&cc.ComponentConfig, false, profiling, checks...), cc.Authentication.Authenticator, cc.Authorization.Authorizer)
 		// TODO: handle stoppedCh returned by c.SecureServing.Serve
 		if _, err := cc.SecureServing.Serve(handler, 0, stopCh); err != nil {
 			// fail early for secure handlers, removing the old error loop from above
@@ -252,6 +269,13 @@ func Run(cc schedulerserverconfig.CompletedConfig, stopCh <-chan struct{}) error
 			OnStartedLeading: run,
 			OnStoppedLeading: func() {
 				utilruntime.HandleError(fmt.Errorf("lost master"))
+				// Demote in order: stop popping pods off the queue, release
+				// assumed pods so the next leader starts from a clean cache,
+				// and flush per-term metrics. Only then hand off state.
+				sched.StopScheduling()
+				if cc.LeaderDemotionHook != nil {
+					cc.LeaderDemotionHook()
+				}
 			},
 		}
 		leaderElector, err := leaderelection.NewLeaderElector(*cc.LeaderElection)
@@ -259,9 +283,19 @@ func Run(cc schedulerserverconfig.CompletedConfig, stopCh <-chan struct{}) error
 			return fmt.Errorf("couldn't create leader elector: %v", err)
 		}
 
-		leaderElector.Run(ctx)
+		for {
+			leaderElector.Run(ctx)
 
-		return fmt.Errorf("lost lease")
+			if !cc.ReacquireLeaderLease {
+				return fmt.Errorf("lost lease")
+			}
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("lost lease")
+			default:
+			}
+			klog.Infof("lost the leader lease, re-entering the election")
+		}
 	}
 
 	// Leader election is disabled, so runCommand inline until done.
@@ -296,33 +330,34 @@ func installMetricHandler(pathRecorderMux *mux.PathRecorderMux) {
 	})
 }
 
+// installProfiling always installs the pprof routes but guards them behind
+// the given gate, so that a config file reload can enable or disable
+// profiling on a running server.
+func installProfiling(pathRecorderMux *mux.PathRecorderMux, profiling *profilingGate) {
+	pprofMux := mux.NewPathRecorderMux("kube-scheduler-pprof")
+	routes.Profiling{}.Install(pprofMux)
+	guarded := profiling.guard(pprofMux)
+	pathRecorderMux.UnlistedHandle("/debug/pprof", guarded)
+	pathRecorderMux.UnlistedHandlePrefix("/debug/pprof/", guarded)
+}
+
 // newMetricsHandler builds a metrics server from the config.
-func newMetricsHandler(config *kubeschedulerconfig.KubeSchedulerConfiguration) http.Handler {
+func newMetricsHandler(config *kubeschedulerconfig.KubeSchedulerConfiguration, profiling *profilingGate) http.Handler {
 	pathRecorderMux := mux.NewPathRecorderMux("kube-scheduler")
 	installMetricHandler(pathRecorderMux)
-	if config.EnableProfiling {
-		routes.Profiling{}.Install(pathRecorderMux)
-		if config.EnableContentionProfiling {
-			goruntime.SetBlockProfileRate(1)
-		}
-	}
+	installProfiling(pathRecorderMux, profiling)
 	return pathRecorderMux
 }
 
 // newHealthzHandler creates a healthz server from the config, and will also
 // embed the metrics handler if the healthz and metrics address configurations
 // are the same.
-func newHealthzHandler(config *kubeschedulerconfig.KubeSchedulerConfiguration, separateMetrics bool, checks ...healthz.HealthzChecker) http.Handler {
+func newHealthzHandler(config *kubeschedulerconfig.KubeSchedulerConfiguration, separateMetrics bool, profiling *profilingGate, checks ...healthz.HealthzChecker) http.Handler {
 	pathRecorderMux := mux.NewPathRecorderMux("kube-scheduler")
 	healthz.InstallHandler(pathRecorderMux, checks...)
 	if !separateMetrics {
 		installMetricHandler(pathRecorderMux)
 	}
-	if config.EnableProfiling {
-		routes.Profiling{}.Install(pathRecorderMux)
-		if config.EnableContentionProfiling {
-			goruntime.SetBlockProfileRate(1)
-		}
-	}
+	installProfiling(pathRecorderMux, profiling)
 	return pathRecorderMux
 }
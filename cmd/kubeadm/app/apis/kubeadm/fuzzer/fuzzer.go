@@ -99,6 +99,11 @@ func fuzzClusterConfiguration(obj *kubeadm.ClusterConfiguration, c fuzz.Continue
 	obj.APIServer.TimeoutForControlPlane = &metav1.Duration{
 		Duration: constants.DefaultControlPlaneTimeout,
 	}
+
+	// Pin values for fields that are not present in v1beta1
+	obj.APIServer.FrontProxy = nil
+	obj.CertificateValidityPeriod = nil
+	obj.CACertificateValidityPeriod = nil
 }
 
 func fuzzDNS(obj *kubeadm.DNS, c fuzz.Continue) {
@@ -102,6 +102,14 @@ type ClusterConfiguration struct {
 	// CertificatesDir specifies where to store or look for all required certificates.
 	CertificatesDir string
 
+	// CertificateValidityPeriod specifies the validity period for non-CA certificates
+	// generated by kubeadm. If unset, a default of one year is used.
+	CertificateValidityPeriod *metav1.Duration
+
+	// CACertificateValidityPeriod specifies the validity period for CA certificates
+	// generated by kubeadm. If unset, a default of ten years is used.
+	CACertificateValidityPeriod *metav1.Duration
+
 	// ImageRepository sets the container registry to pull images from.
 	// If empty, `k8s.gcr.io` will be used by default; in case of kubernetes version is a CI build (kubernetes version starts with `ci/` or `ci-cross/`)
 	// `gcr.io/kubernetes-ci-images` will be used as a default for control plane components and for kube-proxy, while `k8s.gcr.io`
@@ -143,6 +151,30 @@ type APIServer struct {
 
 	// TimeoutForControlPlane controls the timeout that we use for API server to appear
 	TimeoutForControlPlane *metav1.Duration
+
+	// FrontProxy configures the request-header authentication used by the aggregation
+	// layer. If nil, the kubeadm defaults matching the generated front-proxy client
+	// certificate are used.
+	FrontProxy *FrontProxy
+}
+
+// FrontProxy holds the request-header settings used by the API server to authenticate
+// requests coming from the front proxy (the aggregation layer). Setting these is required
+// when the aggregation layer is terminated by an external proxy whose client certificates
+// are signed by an externally supplied front-proxy CA.
+type FrontProxy struct {
+	// AllowedNames is the list of client certificate common names allowed to provide
+	// the request-header authentication headers. An empty list allows any name.
+	AllowedNames []string
+
+	// UsernameHeaders is the list of headers to inspect for the user name.
+	UsernameHeaders []string
+
+	// GroupHeaders is the list of headers to inspect for the user groups.
+	GroupHeaders []string
+
+	// ExtraHeaderPrefixes is the list of header prefixes to inspect for extra user information.
+	ExtraHeaderPrefixes []string
 }
 
 // DNSAddOnType defines string identifying DNS add-on types
@@ -229,6 +261,11 @@ type NodeRegistrationOptions struct {
 	// kubeadm writes at runtime for the kubelet to source. This overrides the generic base-level configuration in the kubelet-config-1.X ConfigMap
 	// Flags have higher priority when parsing. These values are local and specific to the node kubeadm is executing on.
 	KubeletExtraArgs map[string]string
+
+	// Labels specifies the labels the Node API object should be registered with. They are passed to the kubelet
+	// via its --node-labels flag. Label keys in the "kubernetes.io" or "k8s.io" namespaces must be in the allowlist
+	// the NodeRestriction admission plugin accepts, otherwise the kubelet refuses to register the node.
+	Labels map[string]string
 }
 
 // Networking contains elements describing cluster's networking configuration.
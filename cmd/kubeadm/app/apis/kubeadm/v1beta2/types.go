@@ -101,6 +101,14 @@ type ClusterConfiguration struct {
 	// CertificatesDir specifies where to store or look for all required certificates.
 	CertificatesDir string `json:"certificatesDir,omitempty"`
 
+	// CertificateValidityPeriod specifies the validity period for non-CA certificates
+	// generated by kubeadm. If unset, a default of one year is used.
+	CertificateValidityPeriod *metav1.Duration `json:"certificateValidityPeriod,omitempty"`
+
+	// CACertificateValidityPeriod specifies the validity period for CA certificates
+	// generated by kubeadm. If unset, a default of ten years is used.
+	CACertificateValidityPeriod *metav1.Duration `json:"caCertificateValidityPeriod,omitempty"`
+
 	// ImageRepository sets the container registry to pull images from.
 	// If empty, `k8s.gcr.io` will be used by default; in case of kubernetes version is a CI build (kubernetes version starts with `ci/` or `ci-cross/`)
 	// `gcr.io/kubernetes-ci-images` will be used as a default for control plane components and for kube-proxy, while `k8s.gcr.io`
@@ -137,6 +145,30 @@ type APIServer struct {
 
 	// TimeoutForControlPlane controls the timeout that we use for API server to appear
 	TimeoutForControlPlane *metav1.Duration `json:"timeoutForControlPlane,omitempty"`
+
+	// FrontProxy configures the request-header authentication used by the aggregation
+	// layer. If nil, the kubeadm defaults matching the generated front-proxy client
+	// certificate are used.
+	FrontProxy *FrontProxy `json:"frontProxy,omitempty"`
+}
+
+// FrontProxy holds the request-header settings used by the API server to authenticate
+// requests coming from the front proxy (the aggregation layer). Setting these is required
+// when the aggregation layer is terminated by an external proxy whose client certificates
+// are signed by an externally supplied front-proxy CA.
+type FrontProxy struct {
+	// AllowedNames is the list of client certificate common names allowed to provide
+	// the request-header authentication headers. An empty list allows any name.
+	AllowedNames []string `json:"allowedNames,omitempty"`
+
+	// UsernameHeaders is the list of headers to inspect for the user name.
+	UsernameHeaders []string `json:"usernameHeaders,omitempty"`
+
+	// GroupHeaders is the list of headers to inspect for the user groups.
+	GroupHeaders []string `json:"groupHeaders,omitempty"`
+
+	// ExtraHeaderPrefixes is the list of header prefixes to inspect for extra user information.
+	ExtraHeaderPrefixes []string `json:"extraHeaderPrefixes,omitempty"`
 }
 
 // DNSAddOnType defines string identifying DNS add-on types
@@ -215,6 +247,11 @@ type NodeRegistrationOptions struct {
 	// kubeadm writes at runtime for the kubelet to source. This overrides the generic base-level configuration in the kubelet-config-1.X ConfigMap
 	// Flags have higher priority when parsing. These values are local and specific to the node kubeadm is executing on.
 	KubeletExtraArgs map[string]string `json:"kubeletExtraArgs,omitempty"`
+
+	// Labels specifies the labels the Node API object should be registered with. They are passed to the kubelet
+	// via its --node-labels flag. Label keys in the "kubernetes.io" or "k8s.io" namespaces must be in the allowlist
+	// the NodeRestriction admission plugin accepts, otherwise the kubelet refuses to register the node.
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 // Networking contains elements describing cluster's networking configuration
@@ -27,6 +27,8 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -40,6 +42,7 @@ import (
 	"k8s.io/kubernetes/cmd/kubeadm/app/features"
 	kubeadmutil "k8s.io/kubernetes/cmd/kubeadm/app/util"
 	apivalidation "k8s.io/kubernetes/pkg/apis/core/validation"
+	kubeletapis "k8s.io/kubernetes/pkg/kubelet/apis"
 	"k8s.io/kubernetes/pkg/registry/core/service/ipallocator"
 )
 
@@ -63,10 +66,21 @@ func ValidateClusterConfiguration(c *kubeadm.ClusterConfiguration) field.ErrorLi
 	allErrs = append(allErrs, ValidateFeatureGates(c.FeatureGates, field.NewPath("featureGates"))...)
 	allErrs = append(allErrs, ValidateHostPort(c.ControlPlaneEndpoint, field.NewPath("controlPlaneEndpoint"))...)
 	allErrs = append(allErrs, ValidateEtcd(&c.Etcd, field.NewPath("etcd"))...)
+	allErrs = append(allErrs, ValidateCertificateValidityPeriod(c.CertificateValidityPeriod, field.NewPath("certificateValidityPeriod"))...)
+	allErrs = append(allErrs, ValidateCertificateValidityPeriod(c.CACertificateValidityPeriod, field.NewPath("caCertificateValidityPeriod"))...)
 	allErrs = append(allErrs, componentconfigs.Known.Validate(c)...)
 	return allErrs
 }
 
+// ValidateCertificateValidityPeriod validates an optional certificate validity period and collects all encountered errors
+func ValidateCertificateValidityPeriod(d *metav1.Duration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if d != nil && d.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, d.Duration.String(), "certificate validity period must be greater than 0"))
+	}
+	return allErrs
+}
+
 // ValidateAPIServer validates a APIServer object and collects all encountered errors
 func ValidateAPIServer(a *kubeadm.APIServer, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -106,10 +120,44 @@ func ValidateNodeRegistrationOptions(nro *kubeadm.NodeRegistrationOptions, fldPa
 		allErrs = append(allErrs, apivalidation.ValidateDNS1123Subdomain(nro.Name, field.NewPath("name"))...)
 	}
 	allErrs = append(allErrs, ValidateSocketPath(nro.CRISocket, fldPath.Child("criSocket"))...)
+	allErrs = append(allErrs, ValidateNodeLabels(nro.Labels, fldPath.Child("labels"))...)
 	// TODO: Maybe validate .Taints as well in the future using something like validateNodeTaints() in pkg/apis/core/validation
 	return allErrs
 }
 
+// ValidateNodeLabels validates labels the Node API object should be registered with.
+// Because the labels are applied by the kubelet itself, label keys in the "kubernetes.io" or "k8s.io"
+// namespaces must be in the allowlist the NodeRestriction admission plugin accepts from nodes.
+func ValidateNodeLabels(labels map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := metav1validation.ValidateLabels(labels, fldPath)
+	for key := range labels {
+		if isKubernetesLabel(key) && !kubeletapis.IsKubeletLabel(key) {
+			allErrs = append(allErrs, field.Invalid(fldPath, key,
+				fmt.Sprintf("label keys in the %q namespace must begin with an allowed prefix (%s) or be in the specifically allowed set (%s)",
+					getLabelNamespace(key), strings.Join(kubeletapis.KubeletLabelNamespaces(), ", "), strings.Join(kubeletapis.KubeletLabels(), ", "))))
+		}
+	}
+	return allErrs
+}
+
+func isKubernetesLabel(key string) bool {
+	namespace := getLabelNamespace(key)
+	if namespace == "kubernetes.io" || strings.HasSuffix(namespace, ".kubernetes.io") {
+		return true
+	}
+	if namespace == "k8s.io" || strings.HasSuffix(namespace, ".k8s.io") {
+		return true
+	}
+	return false
+}
+
+func getLabelNamespace(key string) string {
+	if parts := strings.SplitN(key, "/", 2); len(parts) == 2 {
+		return parts[0]
+	}
+	return ""
+}
+
 // ValidateDiscovery validates discovery related configuration and collects all encountered errors
 func ValidateDiscovery(d *kubeadm.Discovery, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -132,6 +132,35 @@ func TestValidateNodeRegistrationOptions(t *testing.T) {
 	}
 }
 
+func TestValidateNodeLabels(t *testing.T) {
+	var tests = []struct {
+		labels         map[string]string
+		expectedErrors bool
+	}{
+		{nil, false}, // ok if not provided
+		{map[string]string{"pool": "workers"}, false},                   // unprefixed keys are supported
+		{map[string]string{"example.com/gpu-node": "true"}, false},      // keys outside the kubernetes.io namespace are supported
+		{map[string]string{"node.kubernetes.io/os": "linux"}, false},    // allowed kubernetes.io namespace prefix
+		{map[string]string{"kubernetes.io/hostname": "foo"}, false},     // specifically allowed kubernetes.io label
+		{map[string]string{"node-role.kubernetes.io/master": ""}, true}, // kubernetes.io namespace label outside the kubelet allowlist
+		{map[string]string{"foo.k8s.io/bar": "baz"}, true},              // k8s.io namespace label outside the kubelet allowlist
+		{map[string]string{"-invalid-key": "value"}, true},              // invalid label key
+		{map[string]string{"pool": "invalid value!"}, true},             // invalid label value
+	}
+	for _, rt := range tests {
+		actual := ValidateNodeLabels(rt.labels, field.NewPath("labels"))
+		actualErrors := len(actual) > 0
+		if actualErrors != rt.expectedErrors {
+			t.Errorf(
+				"failed ValidateNodeLabels: labels: %v\n\texpected: %t\n\t  actual: %t",
+				rt.labels,
+				rt.expectedErrors,
+				actualErrors,
+			)
+		}
+	}
+}
+
 func TestValidateCertSANs(t *testing.T) {
 	var tests = []struct {
 		sans     []string
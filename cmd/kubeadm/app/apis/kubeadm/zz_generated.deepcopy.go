@@ -58,6 +58,11 @@ func (in *APIServer) DeepCopyInto(out *APIServer) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.FrontProxy != nil {
+		in, out := &in.FrontProxy, &out.FrontProxy
+		*out = new(FrontProxy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -159,6 +164,16 @@ func (in *ClusterConfiguration) DeepCopyInto(out *ClusterConfiguration) {
 	in.ControllerManager.DeepCopyInto(&out.ControllerManager)
 	in.Scheduler.DeepCopyInto(&out.Scheduler)
 	out.DNS = in.DNS
+	if in.CertificateValidityPeriod != nil {
+		in, out := &in.CertificateValidityPeriod, &out.CertificateValidityPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CACertificateValidityPeriod != nil {
+		in, out := &in.CACertificateValidityPeriod, &out.CACertificateValidityPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.FeatureGates != nil {
 		in, out := &in.FeatureGates, &out.FeatureGates
 		*out = make(map[string]bool, len(*in))
@@ -384,6 +399,42 @@ func (in *FileDiscovery) DeepCopy() *FileDiscovery {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontProxy) DeepCopyInto(out *FrontProxy) {
+	*out = *in
+	if in.AllowedNames != nil {
+		in, out := &in.AllowedNames, &out.AllowedNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UsernameHeaders != nil {
+		in, out := &in.UsernameHeaders, &out.UsernameHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GroupHeaders != nil {
+		in, out := &in.GroupHeaders, &out.GroupHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraHeaderPrefixes != nil {
+		in, out := &in.ExtraHeaderPrefixes, &out.ExtraHeaderPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontProxy.
+func (in *FrontProxy) DeepCopy() *FrontProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostPathMount) DeepCopyInto(out *HostPathMount) {
 	*out = *in
@@ -567,6 +618,13 @@ func (in *NodeRegistrationOptions) DeepCopyInto(out *NodeRegistrationOptions) {
 			(*out)[key] = val
 		}
 	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -30,7 +30,9 @@ func NewCmdAlpha(in io.Reader, out io.Writer) *cobra.Command {
 		Short: "Kubeadm experimental sub-commands",
 	}
 
-	cmd.AddCommand(newCmdCertsUtility(out))
+	cmd.AddCommand(NewCmdCertsUtility(out))
+	cmd.AddCommand(newCmdCheckUtility(out))
+	cmd.AddCommand(newCmdEtcdUtility(out))
 	cmd.AddCommand(newCmdKubeletUtility())
 	cmd.AddCommand(newCmdKubeConfigUtility(out))
 	cmd.AddCommand(NewCmdSelfhosting(in))
@@ -0,0 +1,230 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alpha
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientset "k8s.io/client-go/kubernetes"
+	kubeadmapiv1beta1 "k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm/v1beta1"
+	"k8s.io/kubernetes/cmd/kubeadm/app/cmd/options"
+	cmdutil "k8s.io/kubernetes/cmd/kubeadm/app/cmd/util"
+	kubeadmconstants "k8s.io/kubernetes/cmd/kubeadm/app/constants"
+	"k8s.io/kubernetes/cmd/kubeadm/app/images"
+	kubeadmutil "k8s.io/kubernetes/cmd/kubeadm/app/util"
+	kubeconfigutil "k8s.io/kubernetes/cmd/kubeadm/app/util/kubeconfig"
+	"k8s.io/kubernetes/pkg/util/normalizer"
+)
+
+var (
+	checkClusterLongDesc = normalizer.LongDesc(`
+		Run a minimal set of smoke tests against a bootstrapped cluster: schedule a pause
+		pod, verify that cluster DNS is serving, verify that the API server is reachable
+		through the "kubernetes" service and verify that kube-proxy is running on every
+		node. Each check reports pass or fail together with diagnostics, so bootstrap
+		health can be verified without installing the full end-to-end suite.
+		` + cmdutil.AlphaDisclaimer)
+
+	checkClusterExample = normalizer.Examples(`
+		# Run the cluster smoke tests using the admin kubeconfig.
+		kubeadm alpha check cluster
+	`)
+)
+
+// clusterCheck is a single smoke test run against a live cluster.
+type clusterCheck struct {
+	// Name identifies the check in the pass/fail report.
+	Name string
+	// Run performs the check, returning a diagnostic error on failure.
+	Run func(client clientset.Interface, timeout time.Duration) error
+}
+
+// clusterChecks is the ordered list of smoke tests run by "kubeadm alpha check cluster".
+var clusterChecks = []clusterCheck{
+	{Name: "pod-scheduling", Run: checkPodScheduling},
+	{Name: "cluster-dns", Run: checkClusterDNS},
+	{Name: "apiserver-service", Run: checkAPIServerService},
+	{Name: "kube-proxy", Run: checkKubeProxy},
+}
+
+// newCmdCheckUtility returns the "kubeadm alpha check" command.
+func newCmdCheckUtility(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Commands related to checking the health of a bootstrapped cluster",
+		Long:  cmdutil.MacroCommandLongDescription,
+	}
+
+	cmd.AddCommand(newCmdCheckCluster(out))
+
+	return cmd
+}
+
+// newCmdCheckCluster returns the "kubeadm alpha check cluster" command.
+func newCmdCheckCluster(out io.Writer) *cobra.Command {
+	var kubeConfigFile string
+	timeout := 5 * time.Minute
+
+	cmd := &cobra.Command{
+		Use:     "cluster",
+		Short:   "Run conformance smoke tests against a bootstrapped cluster",
+		Long:    checkClusterLongDesc,
+		Example: checkClusterExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			kubeConfigFile = cmdutil.GetKubeConfigPath(kubeConfigFile)
+			client, err := kubeconfigutil.ClientSetFromFile(kubeConfigFile)
+			kubeadmutil.CheckErr(err)
+
+			err = runClusterChecks(out, client, timeout)
+			kubeadmutil.CheckErr(err)
+		},
+	}
+
+	options.AddKubeConfigFlag(cmd.Flags(), &kubeConfigFile)
+	cmd.Flags().DurationVar(&timeout, "timeout", timeout, "The maximum amount of time a single check is allowed to take")
+
+	return cmd
+}
+
+// runClusterChecks runs all cluster smoke tests, printing a pass/fail line per
+// check, and returns an error naming the failed checks if any check failed.
+func runClusterChecks(out io.Writer, client clientset.Interface, timeout time.Duration) error {
+	var failed []string
+	for _, check := range clusterChecks {
+		if err := check.Run(client, timeout); err != nil {
+			fmt.Fprintf(out, "[check] %s: FAILED: %v\n", check.Name, err)
+			failed = append(failed, check.Name)
+			continue
+		}
+		fmt.Fprintf(out, "[check] %s: passed\n", check.Name)
+	}
+	if len(failed) > 0 {
+		return errors.Errorf("cluster checks failed: %v", failed)
+	}
+	return nil
+}
+
+// checkPodScheduling schedules a pause pod and waits for it to start running,
+// verifying the scheduler and at least one node are functional. The pod is
+// always deleted afterwards.
+func checkPodScheduling(client clientset.Interface, timeout time.Duration) error {
+	podName := fmt.Sprintf("kubeadm-check-%s", rand.String(5))
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      podName,
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name:  "pause",
+					Image: images.GetGenericImage(kubeadmapiv1beta1.DefaultImageRepository, "pause", kubeadmconstants.PauseVersion),
+				},
+			},
+		},
+	}
+
+	if _, err := client.CoreV1().Pods(metav1.NamespaceDefault).Create(pod); err != nil {
+		return errors.Wrap(err, "couldn't create the pause pod")
+	}
+	defer func() {
+		client.CoreV1().Pods(metav1.NamespaceDefault).Delete(podName, &metav1.DeleteOptions{})
+	}()
+
+	lastPhase := v1.PodUnknown
+	err := wait.PollImmediate(kubeadmconstants.APICallRetryInterval, timeout, func() (bool, error) {
+		pod, err := client.CoreV1().Pods(metav1.NamespaceDefault).Get(podName, metav1.GetOptions{})
+		if err != nil {
+			return false, nil
+		}
+		lastPhase = pod.Status.Phase
+		return pod.Status.Phase == v1.PodRunning, nil
+	})
+	if err != nil {
+		return errors.Errorf("the pause pod %q did not start running within %v; last observed phase: %s", podName, timeout, lastPhase)
+	}
+	return nil
+}
+
+// checkClusterDNS verifies that the cluster DNS deployment is serving by
+// requiring at least one ready endpoint behind the kube-dns service.
+func checkClusterDNS(client clientset.Interface, timeout time.Duration) error {
+	ep, err := client.CoreV1().Endpoints(metav1.NamespaceSystem).Get(kubeadmconstants.KubeDNSDeploymentName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return errors.Errorf("the %q service has no endpoints object; is the DNS add-on deployed?", kubeadmconstants.KubeDNSDeploymentName)
+		}
+		return errors.Wrap(err, "couldn't get the cluster DNS endpoints")
+	}
+	if countReadyEndpoints(ep) == 0 {
+		return errors.Errorf("the %q service has no ready endpoints; DNS lookups from pods will fail", kubeadmconstants.KubeDNSDeploymentName)
+	}
+	return nil
+}
+
+// checkAPIServerService verifies that the API server is reachable through the
+// "kubernetes" service in the default namespace by requiring at least one
+// ready endpoint behind it.
+func checkAPIServerService(client clientset.Interface, timeout time.Duration) error {
+	ep, err := client.CoreV1().Endpoints(metav1.NamespaceDefault).Get("kubernetes", metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrap(err, "couldn't get the endpoints of the kubernetes service")
+	}
+	if countReadyEndpoints(ep) == 0 {
+		return errors.New("the kubernetes service has no ready endpoints; the API server is not reachable through its service IP")
+	}
+	return nil
+}
+
+// checkKubeProxy verifies that the kube-proxy DaemonSet has a ready pod on
+// every node it is scheduled to, so that service routing rules are programmed
+// cluster-wide.
+func checkKubeProxy(client clientset.Interface, timeout time.Duration) error {
+	ds, err := client.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(kubeadmconstants.KubeProxy, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return errors.New("the kube-proxy DaemonSet was not found; is the kube-proxy add-on deployed?")
+		}
+		return errors.Wrap(err, "couldn't get the kube-proxy DaemonSet")
+	}
+	if ds.Status.DesiredNumberScheduled == 0 {
+		return errors.New("the kube-proxy DaemonSet is not scheduled to any node")
+	}
+	if ds.Status.NumberReady < ds.Status.DesiredNumberScheduled {
+		return errors.Errorf("only %d out of %d kube-proxy pods are ready", ds.Status.NumberReady, ds.Status.DesiredNumberScheduled)
+	}
+	return nil
+}
+
+// countReadyEndpoints returns the number of ready addresses in an endpoints object.
+func countReadyEndpoints(ep *v1.Endpoints) int {
+	ready := 0
+	for _, subset := range ep.Subsets {
+		ready += len(subset.Addresses)
+	}
+	return ready
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alpha
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kubeadmapiv1beta1 "k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm/v1beta1"
+	"k8s.io/kubernetes/cmd/kubeadm/app/cmd/options"
+	cmdutil "k8s.io/kubernetes/cmd/kubeadm/app/cmd/util"
+	kubeadmutil "k8s.io/kubernetes/cmd/kubeadm/app/util"
+	etcdutil "k8s.io/kubernetes/cmd/kubeadm/app/util/etcd"
+	kubeconfigutil "k8s.io/kubernetes/cmd/kubeadm/app/util/kubeconfig"
+	"k8s.io/kubernetes/pkg/util/normalizer"
+)
+
+var (
+	etcdMemberListLongDesc = normalizer.LongDesc(`
+		List the members of the local/stacked etcd cluster used by this Kubernetes cluster.
+		` + cmdutil.AlphaDisclaimer)
+
+	etcdMemberRemoveLongDesc = normalizer.LongDesc(`
+		Remove the member with the given name from the local/stacked etcd cluster, so that
+		scaling a control plane down does not leave an orphan etcd member behind that can
+		break quorum.
+		` + cmdutil.AlphaDisclaimer)
+
+	etcdMemberRemoveExample = normalizer.Examples(`
+		# Remove the etcd member of a control-plane node that was deleted.
+		kubeadm alpha etcd member remove cp-node-2
+	`)
+)
+
+// newCmdEtcdUtility returns the "kubeadm alpha etcd" command.
+func newCmdEtcdUtility(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "etcd",
+		Short: "Commands related to managing the local etcd cluster",
+		Long:  cmdutil.MacroCommandLongDescription,
+	}
+
+	cmd.AddCommand(newCmdEtcdMember(out))
+
+	return cmd
+}
+
+// newCmdEtcdMember returns the "kubeadm alpha etcd member" command.
+func newCmdEtcdMember(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "member",
+		Short: "Manage the members of the local etcd cluster",
+		Long:  cmdutil.MacroCommandLongDescription,
+	}
+
+	cmd.AddCommand(newCmdEtcdMemberList(out))
+	cmd.AddCommand(newCmdEtcdMemberRemove(out))
+
+	return cmd
+}
+
+// newCmdEtcdMemberList returns the "kubeadm alpha etcd member list" command.
+func newCmdEtcdMemberList(out io.Writer) *cobra.Command {
+	var kubeConfigFile string
+	certificatesDir := kubeadmapiv1beta1.DefaultCertificatesDir
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the members of the local etcd cluster",
+		Long:  etcdMemberListLongDesc,
+		Run: func(cmd *cobra.Command, args []string) {
+			etcdClient, err := getEtcdClient(kubeConfigFile, certificatesDir)
+			kubeadmutil.CheckErr(err)
+
+			members, err := etcdClient.ListMembers()
+			kubeadmutil.CheckErr(err)
+
+			printEtcdMembers(out, members)
+		},
+	}
+
+	options.AddKubeConfigFlag(cmd.Flags(), &kubeConfigFile)
+	cmd.Flags().StringVar(&certificatesDir, options.CertificatesDir, certificatesDir, "The path where the etcd certificates are stored")
+
+	return cmd
+}
+
+// newCmdEtcdMemberRemove returns the "kubeadm alpha etcd member remove" command.
+func newCmdEtcdMemberRemove(out io.Writer) *cobra.Command {
+	var kubeConfigFile string
+	certificatesDir := kubeadmapiv1beta1.DefaultCertificatesDir
+
+	cmd := &cobra.Command{
+		Use:     "remove <name>",
+		Short:   "Remove a member from the local etcd cluster by name",
+		Long:    etcdMemberRemoveLongDesc,
+		Example: etcdMemberRemoveExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				kubeadmutil.CheckErr(errors.New("missing required argument: the name of the etcd member to remove"))
+			}
+			name := args[0]
+
+			etcdClient, err := getEtcdClient(kubeConfigFile, certificatesDir)
+			kubeadmutil.CheckErr(err)
+
+			id, err := etcdClient.GetMemberIDByName(name)
+			kubeadmutil.CheckErr(err)
+			if id == 0 {
+				kubeadmutil.CheckErr(errors.Errorf("the etcd cluster has no member named %q", name))
+			}
+
+			members, err := etcdClient.RemoveMember(id)
+			kubeadmutil.CheckErr(err)
+
+			fmt.Fprintf(out, "[etcd] Removed member %q from the etcd cluster\n", name)
+			printEtcdMembers(out, members)
+		},
+	}
+
+	options.AddKubeConfigFlag(cmd.Flags(), &kubeConfigFile)
+	cmd.Flags().StringVar(&certificatesDir, options.CertificatesDir, certificatesDir, "The path where the etcd certificates are stored")
+
+	return cmd
+}
+
+// getEtcdClient returns an etcd client configured from the etcd endpoints recorded in
+// the cluster and the local etcd certificates.
+func getEtcdClient(kubeConfigFile, certificatesDir string) (*etcdutil.Client, error) {
+	kubeConfigFile = cmdutil.GetKubeConfigPath(kubeConfigFile)
+	client, err := kubeconfigutil.ClientSetFromFile(kubeConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	return etcdutil.NewFromCluster(client, certificatesDir)
+}
+
+// printEtcdMembers writes the current etcd member list to out.
+func printEtcdMembers(out io.Writer, members []etcdutil.Member) {
+	for _, member := range members {
+		fmt.Fprintf(out, "[etcd] Member: %s %s\n", member.Name, member.PeerURL)
+	}
+}
@@ -84,11 +84,13 @@ func NewKubeadmCommand(in io.Reader, out, err io.Writer) *cobra.Command {
 	cmds.AddCommand(NewCmdConfig(out))
 	cmds.AddCommand(NewCmdInit(out, nil))
 	cmds.AddCommand(NewCmdJoin(out, nil))
+	cmds.AddCommand(NewCmdRemove(in, out))
 	cmds.AddCommand(NewCmdReset(in, out, nil))
 	cmds.AddCommand(NewCmdVersion(out))
 	cmds.AddCommand(NewCmdToken(out, err))
 	cmds.AddCommand(upgrade.NewCmdUpgrade(out))
 	cmds.AddCommand(alpha.NewCmdAlpha(in, out))
+	cmds.AddCommand(alpha.NewCmdCertsUtility(out))
 
 	AddKubeadmOtherFlags(cmds.PersistentFlags(), &rootfsPath)
 
@@ -428,7 +428,7 @@ func NewCmdConfigImagesPull() *cobra.Command {
 			kubeadmutil.CheckErr(err)
 			containerRuntime, err := utilruntime.NewContainerRuntime(utilsexec.New(), internalcfg.NodeRegistration.CRISocket)
 			kubeadmutil.CheckErr(err)
-			PullControlPlaneImages(containerRuntime, &internalcfg.ClusterConfiguration)
+			kubeadmutil.CheckErr(PullControlPlaneImages(containerRuntime, &internalcfg.ClusterConfiguration))
 		},
 	}
 	AddImagesCommonConfigFlags(cmd.PersistentFlags(), externalcfg, &cfgPath, &featureGatesString)
@@ -454,6 +454,7 @@ func NewImagesPull(runtime utilruntime.ContainerRuntime, images []string) *Image
 // PullControlPlaneImages pulls all images that the ImagesPull knows about
 func PullControlPlaneImages(runtime utilruntime.ContainerRuntime, cfg *kubeadmapi.ClusterConfiguration) error {
 	images := images.GetControlPlaneImages(cfg)
+	fmt.Printf("[config/images] Pulling %d images through the CRI socket\n", len(images))
 	for _, image := range images {
 		if err := runtime.PullImage(image); err != nil {
 			return errors.Wrapf(err, "failed to pull image %q", image)
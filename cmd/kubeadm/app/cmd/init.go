@@ -17,6 +17,7 @@ limitations under the License.
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -98,6 +99,7 @@ type initOptions struct {
 	externalcfg             *kubeadmapiv1beta2.InitConfiguration
 	uploadCerts             bool
 	skipCertificateKeyPrint bool
+	outputFormat            string
 }
 
 // compile-time assert that the local data object satisfies the phases data interface.
@@ -119,8 +121,18 @@ type initData struct {
 	outputWriter            io.Writer
 	uploadCerts             bool
 	skipCertificateKeyPrint bool
+	outputFormat            string
 }
 
+const (
+	// outputFormatText is the default human oriented output of kubeadm init.
+	outputFormatText = "text"
+	// outputFormatJSON makes kubeadm init print its summary (kubeconfig path,
+	// tokens and join commands) as a single JSON document for consumption by
+	// automation.
+	outputFormatJSON = "json"
+)
+
 // NewCmdInit returns "kubeadm init" command.
 // NB. initOptions is exposed as parameter for allowing unit testing of
 //     the newInitOptions method, that implements all the command options validation logic
@@ -261,6 +273,10 @@ func AddInitOtherFlags(flagSet *flag.FlagSet, initOptions *initOptions) {
 		&initOptions.skipCertificateKeyPrint, options.SkipCertificateKeyPrint, initOptions.skipCertificateKeyPrint,
 		"Don't print the key used to encrypt the control-plane certificates.",
 	)
+	flagSet.StringVarP(
+		&initOptions.outputFormat, options.Output, "o", initOptions.outputFormat,
+		"Output format. One of: text|json.",
+	)
 }
 
 // newInitOptions returns a struct ready for being used for creating cmd init flags.
@@ -279,6 +295,7 @@ func newInitOptions() *initOptions {
 		kubeconfigDir:  kubeadmconstants.KubernetesDir,
 		kubeconfigPath: kubeadmconstants.GetAdminKubeConfigPath(),
 		uploadCerts:    false,
+		outputFormat:   outputFormatText,
 	}
 }
 
@@ -373,10 +390,15 @@ func newInitData(cmd *cobra.Command, args []string, options *initOptions, out io
 		return nil, errors.New("can't use upload-certs with an external CA or an external front-proxy CA")
 	}
 
+	if options.outputFormat != outputFormatText && options.outputFormat != outputFormatJSON {
+		return nil, errors.Errorf("invalid output format %q. Valid values are %q and %q", options.outputFormat, outputFormatText, outputFormatJSON)
+	}
+
 	return &initData{
 		cfg:                     cfg,
 		certificatesDir:         cfg.CertificatesDir,
 		skipTokenPrint:          options.skipTokenPrint,
+		outputFormat:            options.outputFormat,
 		dryRun:                  options.dryRun,
 		dryRunDir:               dryRunDir,
 		kubeconfigDir:           options.kubeconfigDir,
@@ -536,10 +558,64 @@ func printJoinCommand(out io.Writer, adminKubeConfigPath, token string, i *initD
 	return initDoneTempl.Execute(out, ctx)
 }
 
+// initJSONResult is the machine-readable summary of a successful kubeadm init,
+// printed instead of the human oriented done message when --output=json is set.
+type initJSONResult struct {
+	KubeConfigPath          string   `json:"kubeConfigPath"`
+	Tokens                  []string `json:"tokens,omitempty"`
+	CertificateKey          string   `json:"certificateKey,omitempty"`
+	JoinWorkerCommand       string   `json:"joinWorkerCommand"`
+	JoinControlPlaneCommand string   `json:"joinControlPlaneCommand,omitempty"`
+}
+
+func printInitResultJSON(out io.Writer, adminKubeConfigPath string, i *initData) error {
+	result := initJSONResult{
+		KubeConfigPath: adminKubeConfigPath,
+	}
+	if !i.skipTokenPrint {
+		result.Tokens = i.Tokens()
+	}
+
+	token := ""
+	if tokens := i.Tokens(); len(tokens) > 0 {
+		token = tokens[0]
+	}
+	joinWorkerCommand, err := cmdutil.GetJoinWorkerCommand(adminKubeConfigPath, token, i.skipTokenPrint)
+	if err != nil {
+		return err
+	}
+	result.JoinWorkerCommand = joinWorkerCommand
+
+	if i.Cfg().ControlPlaneEndpoint != "" {
+		joinControlPlaneCommand, err := cmdutil.GetJoinControlPlaneCommand(adminKubeConfigPath, token, i.CertificateKey(), i.skipTokenPrint, i.skipCertificateKeyPrint)
+		if err != nil {
+			return err
+		}
+		result.JoinControlPlaneCommand = joinControlPlaneCommand
+		if i.uploadCerts && !i.skipCertificateKeyPrint {
+			result.CertificateKey = i.CertificateKey()
+		}
+	}
+
+	b, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%s\n", b)
+	return nil
+}
+
 // showJoinCommand prints the join command after all the phases in init have finished
 func showJoinCommand(i *initData, out io.Writer) error {
 	adminKubeConfigPath := i.KubeConfigPath()
 
+	if i.outputFormat == outputFormatJSON {
+		if err := printInitResultJSON(out, adminKubeConfigPath, i); err != nil {
+			return errors.Wrap(err, "failed to print init result")
+		}
+		return nil
+	}
+
 	// Prints the join command, multiple times in case the user has multiple tokens
 	for _, token := range i.Tokens() {
 		if err := printJoinCommand(out, adminKubeConfigPath, token, i); err != nil {
@@ -17,6 +17,7 @@ limitations under the License.
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -128,6 +129,7 @@ type joinOptions struct {
 	controlPlane          bool
 	ignorePreflightErrors []string
 	externalcfg           *kubeadmapiv1beta2.JoinConfiguration
+	outputFormat          string
 }
 
 // compile-time assert that the local data object satisfies the phases data interface.
@@ -142,6 +144,7 @@ type joinData struct {
 	clientSet             *clientset.Clientset
 	ignorePreflightErrors sets.String
 	outputWriter          io.Writer
+	outputFormat          string
 }
 
 // NewCmdJoin returns "kubeadm join" command.
@@ -167,6 +170,12 @@ func NewCmdJoin(out io.Writer, joinOptions *joinOptions) *cobra.Command {
 			err = joinRunner.Run(args)
 			kubeadmutil.CheckErr(err)
 
+			if data.outputFormat == outputFormatJSON {
+				err = printJoinResultJSON(data)
+				kubeadmutil.CheckErr(err)
+				return
+			}
+
 			// if the node is hosting a new control plane instance
 			if data.cfg.ControlPlane != nil {
 				// outputs the join control plane done message and exit
@@ -275,6 +284,10 @@ func addJoinOtherFlags(flagSet *flag.FlagSet, joinOptions *joinOptions) {
 		&joinOptions.controlPlane, options.ControlPlane, joinOptions.controlPlane,
 		"Create a new control plane instance on this node",
 	)
+	flagSet.StringVarP(
+		&joinOptions.outputFormat, options.Output, "o", joinOptions.outputFormat,
+		"Output format. One of: text|json.",
+	)
 }
 
 // newJoinOptions returns a struct ready for being used for creating cmd join flags.
@@ -292,7 +305,8 @@ func newJoinOptions() *joinOptions {
 	kubeadmscheme.Scheme.Default(externalcfg)
 
 	return &joinOptions{
-		externalcfg: externalcfg,
+		externalcfg:  externalcfg,
+		outputFormat: outputFormatText,
 	}
 }
 
@@ -303,6 +317,10 @@ func newJoinData(cmd *cobra.Command, args []string, opt *joinOptions, out io.Wri
 	// Re-apply defaults to the public kubeadm API (this will set only values not exposed/not set as a flags)
 	kubeadmscheme.Scheme.Default(opt.externalcfg)
 
+	if opt.outputFormat != "" && opt.outputFormat != outputFormatText && opt.outputFormat != outputFormatJSON {
+		return nil, errors.Errorf("invalid output format %q. Valid values are %q and %q", opt.outputFormat, outputFormatText, outputFormatJSON)
+	}
+
 	// Validate standalone flags values and/or combination of flags and then assigns
 	// validated values to the public kubeadm config API when applicable
 
@@ -402,9 +420,33 @@ func newJoinData(cmd *cobra.Command, args []string, opt *joinOptions, out io.Wri
 		tlsBootstrapCfg:       tlsBootstrapCfg,
 		ignorePreflightErrors: ignorePreflightErrorsSet,
 		outputWriter:          out,
+		outputFormat:          opt.outputFormat,
 	}, nil
 }
 
+// joinJSONResult is the machine-readable summary of a successful kubeadm join,
+// printed instead of the human oriented done message when --output=json is set.
+type joinJSONResult struct {
+	ControlPlane   bool   `json:"controlPlane"`
+	KubeConfigPath string `json:"kubeConfigPath,omitempty"`
+}
+
+// printJoinResultJSON prints the result of the join workflow as a JSON document.
+func printJoinResultJSON(data *joinData) error {
+	result := joinJSONResult{
+		ControlPlane: data.cfg.ControlPlane != nil,
+	}
+	if data.cfg.ControlPlane != nil {
+		result.KubeConfigPath = kubeadmconstants.GetAdminKubeConfigPath()
+	}
+	output, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal the join result")
+	}
+	fmt.Fprintln(data.outputWriter, string(output))
+	return nil
+}
+
 // CertificateKey returns the key used to encrypt the certs.
 func (j *joinData) CertificateKey() string {
 	if j.cfg.ControlPlane != nil {
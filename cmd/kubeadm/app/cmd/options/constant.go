@@ -74,6 +74,9 @@ const (
 	// NodeName flag sets the node name.
 	NodeName = "node-name"
 
+	// Output flag sets the format in which machine-readable command output is printed.
+	Output = "output"
+
 	// SchedulerExtraArgs flag sets extra flags to pass to the Scheduler or override default ones in form of <flagname>=<value>".
 	SchedulerExtraArgs = "scheduler-extra-args"
 
@@ -130,4 +133,7 @@ const (
 
 	// ForceReset flag instruct kubeadm to reset the node without prompting for confirmation
 	ForceReset = "force"
+
+	// CleanupNetwork flag instructs kubeadm reset to also flush iptables and IPVS rules and remove CNI state
+	CleanupNetwork = "cleanup-network"
 )
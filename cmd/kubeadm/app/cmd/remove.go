@@ -0,0 +1,186 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/lithammer/dedent"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	kubeadmapi "k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm"
+	"k8s.io/kubernetes/cmd/kubeadm/app/cmd/options"
+	cmdutil "k8s.io/kubernetes/cmd/kubeadm/app/cmd/util"
+	kubeadmconstants "k8s.io/kubernetes/cmd/kubeadm/app/constants"
+	etcdphase "k8s.io/kubernetes/cmd/kubeadm/app/phases/etcd"
+	uploadconfig "k8s.io/kubernetes/cmd/kubeadm/app/phases/uploadconfig"
+	kubeadmutil "k8s.io/kubernetes/cmd/kubeadm/app/util"
+	configutil "k8s.io/kubernetes/cmd/kubeadm/app/util/config"
+	etcdutil "k8s.io/kubernetes/cmd/kubeadm/app/util/etcd"
+)
+
+var removeControlPlaneLongDescription = dedent.Dedent(`
+	Remove a control plane node from the cluster, reversing the effects of joining it.
+
+	The command removes the stacked etcd member hosted on the node, deletes the node's
+	API endpoint from the ClusterStatus stored in the kubeadm-config ConfigMap and,
+	when run on the node being removed, cleans up the static Pod manifests and
+	certificates written by "kubeadm init" or "kubeadm join".
+
+	Before touching the etcd cluster the command verifies that all the remaining etcd
+	members are healthy, so that quorum is kept once the member is gone.
+	`)
+
+// removeControlPlaneOptions defines all the options exposed via flags by kubeadm remove control-plane.
+type removeControlPlaneOptions struct {
+	kubeconfigPath string
+	force          bool
+}
+
+// NewCmdRemove returns the "kubeadm remove" command
+func NewCmdRemove(in io.Reader, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Remove a node from the cluster",
+		RunE:  cmdutil.SubCmdRunE("remove"),
+	}
+
+	cmd.AddCommand(newCmdRemoveControlPlane(in, out))
+	return cmd
+}
+
+// newCmdRemoveControlPlane returns the "kubeadm remove control-plane" command
+func newCmdRemoveControlPlane(in io.Reader, out io.Writer) *cobra.Command {
+	removeOptions := &removeControlPlaneOptions{
+		kubeconfigPath: kubeadmconstants.GetAdminKubeConfigPath(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "control-plane NODE",
+		Short: "Remove a control plane node from the cluster",
+		Long:  removeControlPlaneLongDescription,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				kubeadmutil.CheckErr(errors.New("missing NODE argument"))
+			}
+			kubeadmutil.CheckErr(runRemoveControlPlane(args[0], removeOptions, in, out))
+		},
+	}
+
+	options.AddKubeConfigFlag(cmd.Flags(), &removeOptions.kubeconfigPath)
+	cmd.Flags().BoolVarP(
+		&removeOptions.force, options.ForceReset, "f", false,
+		"Remove the control plane node without prompting for confirmation.",
+	)
+
+	return cmd
+}
+
+func runRemoveControlPlane(nodeName string, removeOptions *removeControlPlaneOptions, in io.Reader, out io.Writer) error {
+	client, err := getClientset(removeOptions.kubeconfigPath, false)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't create a Kubernetes client from file %q", removeOptions.kubeconfigPath)
+	}
+
+	cfg, err := configutil.FetchInitConfigurationFromCluster(client, out, "remove", false)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch the kubeadm configuration from the cluster")
+	}
+
+	clusterStatus, err := configutil.GetClusterStatus(client)
+	if err != nil {
+		return err
+	}
+	endpoint, ok := clusterStatus.APIEndpoints[nodeName]
+	if !ok {
+		return errors.Errorf("node %q is not registered as a control plane node in the %q ConfigMap", nodeName, kubeadmconstants.KubeadmConfigConfigMap)
+	}
+
+	if !removeOptions.force {
+		fmt.Fprintf(out, "[remove] WARNING: The control plane node %q will be removed from the cluster.\n", nodeName)
+		fmt.Fprint(out, "[remove] Are you sure you want to proceed? [y/N]: ")
+		s := bufio.NewScanner(in)
+		s.Scan()
+		if err := s.Err(); err != nil {
+			return err
+		}
+		if strings.ToLower(s.Text()) != "y" {
+			return errors.New("Aborted remove operation")
+		}
+	}
+
+	// Only stacked etcd members are managed by kubeadm; an external etcd cluster is left alone.
+	if cfg.Etcd.External == nil {
+		if err := checkEtcdQuorumAfterRemoval(clusterStatus, cfg, nodeName); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "[remove] Removing the etcd member for node %q\n", nodeName)
+		if err := etcdphase.RemoveStackedEtcdMemberForNode(client, &cfg.ClusterConfiguration, &endpoint); err != nil {
+			return errors.Wrap(err, "failed to remove the etcd member")
+		}
+	}
+
+	if err := uploadconfig.ResetClusterStatusForNode(nodeName, client); err != nil {
+		return err
+	}
+
+	// When removing the node kubeadm runs on, also clean up the static Pod manifests
+	// and the certificates written by "kubeadm init" or "kubeadm join".
+	if nodeName == cfg.NodeRegistration.Name {
+		fmt.Fprintln(out, "[remove] Removing local static Pod manifests and certificates")
+		resetConfigDir(kubeadmconstants.KubernetesDir, cfg.CertificatesDir)
+	}
+
+	fmt.Fprintf(out, "[remove] Control plane node %q removed from the cluster\n", nodeName)
+	return nil
+}
+
+// checkEtcdQuorumAfterRemoval verifies that the etcd cluster keeps quorum once the
+// member for the given node is gone, by checking that all the other etcd members
+// are up. The member being removed is deliberately not checked, so that a dead
+// control plane node can still be removed.
+func checkEtcdQuorumAfterRemoval(clusterStatus *kubeadmapi.ClusterStatus, cfg *kubeadmapi.InitConfiguration, nodeName string) error {
+	remainingEndpoints := []string{}
+	for name, endpoint := range clusterStatus.APIEndpoints {
+		if name == nodeName {
+			continue
+		}
+		remainingEndpoints = append(remainingEndpoints, etcdutil.GetClientURLByIP(endpoint.AdvertiseAddress))
+	}
+	if len(remainingEndpoints) == 0 {
+		return errors.New("cannot remove the only control plane node; use 'kubeadm reset' to tear down the cluster instead")
+	}
+
+	etcdClient, err := etcdutil.New(
+		remainingEndpoints,
+		filepath.Join(cfg.CertificatesDir, kubeadmconstants.EtcdCACertName),
+		filepath.Join(cfg.CertificatesDir, kubeadmconstants.EtcdHealthcheckClientCertName),
+		filepath.Join(cfg.CertificatesDir, kubeadmconstants.EtcdHealthcheckClientKeyName),
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, err := etcdClient.GetClusterStatus(); err != nil {
+		return errors.Wrap(err, "the remaining etcd members would not keep quorum after the removal")
+	}
+	return nil
+}
@@ -53,6 +53,7 @@ import (
 // resetOptions defines all the options exposed via flags by kubeadm reset.
 type resetOptions struct {
 	certificatesDir       string
+	cleanupNetwork        bool
 	criSocketPath         string
 	forceReset            bool
 	ignorePreflightErrors []string
@@ -63,6 +64,7 @@ type resetOptions struct {
 // this data is shared across all the phases that are included in the workflow.
 type resetData struct {
 	certificatesDir       string
+	cleanupNetwork        bool
 	client                clientset.Interface
 	criSocketPath         string
 	forceReset            bool
@@ -111,6 +113,7 @@ func newResetData(cmd *cobra.Command, options *resetOptions, in io.Reader, out i
 
 	return &resetData{
 		certificatesDir:       options.certificatesDir,
+		cleanupNetwork:        options.cleanupNetwork,
 		client:                client,
 		criSocketPath:         criSocketPath,
 		forceReset:            options.forceReset,
@@ -131,6 +134,10 @@ func AddResetFlags(flagSet *flag.FlagSet, resetOptions *resetOptions) {
 		&resetOptions.forceReset, options.ForceReset, "f", false,
 		"Reset the node without prompting for confirmation.",
 	)
+	flagSet.BoolVar(
+		&resetOptions.cleanupNetwork, options.CleanupNetwork, false,
+		"Also flush iptables and IPVS rules created by kube-proxy and remove CNI state from /var/lib/cni. This is disruptive to anything else using the host's network stack.",
+	)
 
 	options.AddKubeConfigFlag(flagSet, &resetOptions.kubeconfigPath)
 	options.AddIgnorePreflightErrorsFlag(flagSet, &resetOptions.ignorePreflightErrors)
@@ -198,6 +205,11 @@ func (r *resetData) ForceReset() bool {
 	return r.forceReset
 }
 
+// CleanupNetwork returns the cleanupNetwork flag.
+func (r *resetData) CleanupNetwork() bool {
+	return r.cleanupNetwork
+}
+
 // InputReader returns the io.reader used to read messages.
 func (r *resetData) InputReader() io.Reader {
 	return r.inputReader
@@ -242,6 +254,8 @@ func (r *resetData) Run() error {
 		return err
 	}
 
+	report := &resetReport{}
+
 	// Reset the ClusterStatus for a given control-plane node.
 	if isControlPlane() && cfg != nil {
 		uploadconfig.ResetClusterStatusForNode(cfg.NodeRegistration.Name, client)
@@ -261,6 +275,7 @@ func (r *resetData) Run() error {
 	} else {
 		fmt.Println("[reset] No etcd config found. Assuming external etcd")
 		fmt.Println("[reset] Please manually reset etcd to prevent further issues")
+		report.markSkipped("etcd data", "no etcd config found, assuming external etcd")
 	}
 
 	// Try to stop the kubelet service
@@ -285,6 +300,7 @@ func (r *resetData) Run() error {
 	absoluteKubeletRunDirectory, err = filepath.EvalSymlinks(kubeadmconstants.KubeletRunDirectory)
 	if err != nil {
 		klog.Errorf("[reset] Failed to evaluate the %q directory. Skipping its unmount and cleanup: %v", kubeadmconstants.KubeletRunDirectory, err)
+		report.markSkipped(kubeadmconstants.KubeletRunDirectory, "failed to evaluate the directory")
 	} else {
 		// Only unmount mount points which start with "/var/lib/kubelet" or absolute path of symbolic link, and avoid using empty absoluteKubeletRunDirectory
 		umountDirsCmd := fmt.Sprintf("awk '$2 ~ path {print $2}' path=%s/ /proc/mounts | xargs -r umount", absoluteKubeletRunDirectory)
@@ -292,6 +308,7 @@ func (r *resetData) Run() error {
 		umountOutputBytes, err := exec.Command("sh", "-c", umountDirsCmd).Output()
 		if err != nil {
 			klog.Errorf("[reset] Failed to unmount mounted directories in %s: %s\n", kubeadmconstants.KubeletRunDirectory, string(umountOutputBytes))
+			report.markSkipped(kubeadmconstants.KubeletRunDirectory, "failed to unmount mounted directories")
 		} else {
 			// Only clean absoluteKubeletRunDirectory if umountDirsCmd passed without error
 			dirsToClean = append(dirsToClean, absoluteKubeletRunDirectory)
@@ -301,6 +318,9 @@ func (r *resetData) Run() error {
 	klog.V(1).Info("[reset] Removing Kubernetes-managed containers")
 	if err := removeContainers(utilsexec.New(), r.criSocketPath); err != nil {
 		klog.Errorf("[reset] Failed to remove containers: %v", err)
+		report.markSkipped("Kubernetes-managed containers", err.Error())
+	} else {
+		report.markCleaned("Kubernetes-managed containers")
 	}
 
 	dirsToClean = append(dirsToClean, []string{"/etc/cni/net.d", "/var/lib/dockershim", "/var/run/kubernetes"}...)
@@ -309,7 +329,12 @@ func (r *resetData) Run() error {
 	fmt.Printf("[reset] Deleting contents of stateful directories: %v\n", dirsToClean)
 	for _, dir := range dirsToClean {
 		klog.V(1).Infof("[reset] Deleting content of %s", dir)
-		cleanDir(dir)
+		if err := cleanDir(dir); err != nil {
+			klog.Errorf("[reset] Failed to delete contents of %q: %v", dir, err)
+			report.markSkipped(dir, err.Error())
+		} else {
+			report.markCleaned(dir)
+		}
 	}
 
 	// Remove contents from the config and pki directories
@@ -318,17 +343,35 @@ func (r *resetData) Run() error {
 		klog.Warningf("[reset] WARNING: Cleaning a non-default certificates directory: %q\n", certsDir)
 	}
 	resetConfigDir(kubeadmconstants.KubernetesDir, certsDir)
+	report.markCleaned(fmt.Sprintf("contents of the %s and %s directories", kubeadmconstants.KubernetesDir, certsDir))
 
-	// Output help text instructing user how to remove iptables rules
-	msg := dedent.Dedent(`
-		The reset process does not reset or clean up iptables rules or IPVS tables.
-		If you wish to reset iptables, you must do so manually.
-		For example:
-		iptables -F && iptables -t nat -F && iptables -t mangle -F && iptables -X
+	if r.CleanupNetwork() {
+		cleanupNetworking(report)
+	} else {
+		report.markSkipped("iptables and IPVS rules", fmt.Sprintf("--%s not set", options.CleanupNetwork))
+		report.markSkipped(cniStateDir, fmt.Sprintf("--%s not set", options.CleanupNetwork))
+	}
+	report.markSkipped("kubeconfig files", "remove them manually, e.g. $HOME/.kube/config")
+
+	report.print(r.outputWriter)
+
+	if !r.CleanupNetwork() {
+		// Output help text instructing user how to remove iptables rules
+		msg := dedent.Dedent(`
+			The reset process does not reset or clean up iptables rules or IPVS tables.
+			If you wish to reset iptables, you must do so manually.
+			For example:
+			iptables -F && iptables -t nat -F && iptables -t mangle -F && iptables -X
 
-		If your cluster was setup to utilize IPVS, run ipvsadm --clear (or similar)
-		to reset your system's IPVS tables.
+			If your cluster was setup to utilize IPVS, run ipvsadm --clear (or similar)
+			to reset your system's IPVS tables.
+
+			Alternatively, re-run reset with the --cleanup-network flag.
+		`)
+		fmt.Print(msg)
+	}
 
+	msg := dedent.Dedent(`
 		The reset process does not clean your kubeconfig files and you must remove them manually.
 		Please, check the contents of the $HOME/.kube/config file.
 	`)
@@ -337,6 +380,76 @@ func (r *resetData) Run() error {
 	return nil
 }
 
+// cniStateDir is where CNI plugins keep their runtime state, e.g. IPAM allocations.
+const cniStateDir = "/var/lib/cni"
+
+// cleanupNetworking flushes the iptables and IPVS rules created by kube-proxy and removes
+// CNI state from /var/lib/cni. It is only invoked when the user explicitly asked for it,
+// since flushing the host's network stack affects more than Kubernetes.
+func cleanupNetworking(report *resetReport) {
+	fmt.Println("[reset] Flushing iptables rules")
+	flushed := true
+	for _, args := range [][]string{
+		{"-F"},
+		{"-t", "nat", "-F"},
+		{"-t", "mangle", "-F"},
+		{"-X"},
+	} {
+		if out, err := exec.Command("iptables", args...).CombinedOutput(); err != nil {
+			klog.Errorf("[reset] Failed to run iptables %s: %v: %s", strings.Join(args, " "), err, string(out))
+			flushed = false
+		}
+	}
+	if flushed {
+		report.markCleaned("iptables rules")
+	} else {
+		report.markSkipped("iptables rules", "flushing failed, reset them manually")
+	}
+
+	if _, err := exec.LookPath("ipvsadm"); err != nil {
+		report.markSkipped("IPVS tables", "ipvsadm not found")
+	} else if out, err := exec.Command("ipvsadm", "--clear").CombinedOutput(); err != nil {
+		klog.Errorf("[reset] Failed to clear IPVS tables: %v: %s", err, string(out))
+		report.markSkipped("IPVS tables", "ipvsadm --clear failed, reset them manually")
+	} else {
+		report.markCleaned("IPVS tables")
+	}
+
+	fmt.Printf("[reset] Deleting CNI state in %q\n", cniStateDir)
+	if err := cleanDir(cniStateDir); err != nil {
+		klog.Errorf("[reset] Failed to remove CNI state in %s: %v", cniStateDir, err)
+		report.markSkipped(cniStateDir, err.Error())
+	} else {
+		report.markCleaned(cniStateDir)
+	}
+}
+
+// resetReport records what the reset process cleaned and what it left behind so a
+// summary can be printed at the end of the run.
+type resetReport struct {
+	cleaned []string
+	skipped []string
+}
+
+func (rep *resetReport) markCleaned(item string) {
+	rep.cleaned = append(rep.cleaned, item)
+}
+
+func (rep *resetReport) markSkipped(item string, reason string) {
+	rep.skipped = append(rep.skipped, fmt.Sprintf("%s (%s)", item, reason))
+}
+
+// print writes the cleanup summary to w.
+func (rep *resetReport) print(w io.Writer) {
+	fmt.Fprintln(w, "[reset] Cleanup summary:")
+	for _, item := range rep.cleaned {
+		fmt.Fprintf(w, "[reset]   cleaned: %s\n", item)
+	}
+	for _, item := range rep.skipped {
+		fmt.Fprintf(w, "[reset]   skipped: %s\n", item)
+	}
+}
+
 func getEtcdDataDir(manifestPath string, cfg *kubeadmapi.InitConfiguration) (string, error) {
 	const etcdVolumeName = "etcd-data"
 	var dataDir string
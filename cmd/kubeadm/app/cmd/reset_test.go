@@ -17,6 +17,7 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -330,3 +331,19 @@ func TestGetEtcdDataDir(t *testing.T) {
 		})
 	}
 }
+
+func TestResetReport(t *testing.T) {
+	report := &resetReport{}
+	report.markCleaned("/var/lib/kubelet")
+	report.markSkipped("iptables and IPVS rules", "--cleanup-network not set")
+
+	var out bytes.Buffer
+	report.print(&out)
+
+	expected := "[reset] Cleanup summary:\n" +
+		"[reset]   cleaned: /var/lib/kubelet\n" +
+		"[reset]   skipped: iptables and IPVS rules (--cleanup-network not set)\n"
+	if out.String() != expected {
+		t.Errorf("expected summary:\n%s\ngot:\n%s", expected, out.String())
+	}
+}
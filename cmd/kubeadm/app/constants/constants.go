@@ -45,6 +45,9 @@ const (
 	// CertificateValidity defines the validity for all the signed certificates generated by kubeadm
 	CertificateValidity = time.Hour * 24 * 365
 
+	// CACertificateValidity defines the validity for all the CA certificates generated by kubeadm
+	CACertificateValidity = time.Hour * 24 * 365 * 10
+
 	// CACertAndKeyBaseName defines certificate authority base name
 	CACertAndKeyBaseName = "ca"
 	// CACertName defines certificate name
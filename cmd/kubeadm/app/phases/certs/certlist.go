@@ -19,6 +19,7 @@ package certs
 import (
 	"crypto"
 	"crypto/x509"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -53,13 +54,31 @@ func (k *KubeadmCert) GetConfig(ic *kubeadmapi.InitConfiguration) (*certutil.Con
 	return &k.config, nil
 }
 
+// certificateValidity returns the validity period to use for non-CA certificates,
+// honoring the ClusterConfiguration override when set.
+func certificateValidity(ic *kubeadmapi.InitConfiguration) time.Duration {
+	if ic.CertificateValidityPeriod != nil {
+		return ic.CertificateValidityPeriod.Duration
+	}
+	return kubeadmconstants.CertificateValidity
+}
+
+// caCertificateValidity returns the validity period to use for CA certificates,
+// honoring the ClusterConfiguration override when set.
+func caCertificateValidity(ic *kubeadmapi.InitConfiguration) time.Duration {
+	if ic.CACertificateValidityPeriod != nil {
+		return ic.CACertificateValidityPeriod.Duration
+	}
+	return kubeadmconstants.CACertificateValidity
+}
+
 // CreateFromCA makes and writes a certificate using the given CA cert and key.
 func (k *KubeadmCert) CreateFromCA(ic *kubeadmapi.InitConfiguration, caCert *x509.Certificate, caKey crypto.Signer) error {
 	cfg, err := k.GetConfig(ic)
 	if err != nil {
 		return errors.Wrapf(err, "couldn't create %q certificate", k.Name)
 	}
-	cert, key, err := pkiutil.NewCertAndKey(caCert, caKey, cfg)
+	cert, key, err := pkiutil.NewCertAndKeyWithValidity(caCert, caKey, cfg, certificateValidity(ic))
 	if err != nil {
 		return err
 	}
@@ -85,7 +104,7 @@ func (k *KubeadmCert) CreateAsCA(ic *kubeadmapi.InitConfiguration) (*x509.Certif
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "couldn't get configuration for %q CA certificate", k.Name)
 	}
-	caCert, caKey, err := pkiutil.NewCertificateAuthority(cfg)
+	caCert, caKey, err := pkiutil.NewCertificateAuthorityWithValidity(cfg, caCertificateValidity(ic))
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "couldn't generate %q CA certificate", k.Name)
 	}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/pkg/errors"
 	certutil "k8s.io/client-go/util/cert"
@@ -196,6 +197,12 @@ func writeCertificateAuthorithyFilesIfNotExist(pkiDir string, baseName string, c
 			return errors.Errorf("certificate %s is not a CA", baseName)
 		}
 
+		// Pre-generated CAs copied from another host may be expired or not
+		// valid yet; fail fast instead of bootstrapping a broken cluster
+		if err := validateCertPeriod(caCert, 0); err != nil {
+			return errors.Wrapf(err, "certificate %s is invalid", baseName)
+		}
+
 		// kubeadm doesn't validate the existing certificate Authority more than this;
 		// Basically, if we find a certificate file with the same path; and it is a CA
 		// kubeadm thinks those files are equal and doesn't bother writing a new file
@@ -235,6 +242,12 @@ func writeCertificateFilesIfNotExist(pkiDir string, baseName string, signingCert
 			return err
 		}
 
+		// Certificates generated off-box ahead of time may no longer be
+		// within their validity period by the time they are consumed
+		if err := validateCertPeriod(signedCert, 0); err != nil {
+			return errors.Wrapf(err, "certificate %s is invalid", baseName)
+		}
+
 		fmt.Printf("[certs] Using the existing %q certificate and key\n", baseName)
 	} else {
 		// Write .crt and .key files to disk
@@ -424,6 +437,20 @@ func validatePrivatePublicKey(l certKeyLocation) error {
 	return nil
 }
 
+// validateCertPeriod checks if the certificate is valid relative to the current time
+// (+/- offset)
+func validateCertPeriod(cert *x509.Certificate, offset time.Duration) error {
+	period := fmt.Sprintf("NotBefore: %v, NotAfter: %v", cert.NotBefore, cert.NotAfter)
+	now := time.Now().Add(offset)
+	if now.Before(cert.NotBefore) {
+		return errors.Errorf("the certificate is not valid yet: %s", period)
+	}
+	if now.After(cert.NotAfter) {
+		return errors.Errorf("the certificate has expired: %s", period)
+	}
+	return nil
+}
+
 // validateCertificateWithConfig makes sure that a given certificate is valid at
 // least for the SANs defined in the configuration.
 func validateCertificateWithConfig(cert *x509.Certificate, baseName string, cfg *certutil.Config) error {
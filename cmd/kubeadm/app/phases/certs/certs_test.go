@@ -27,6 +27,7 @@ import (
 	"path"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -814,3 +815,49 @@ func assertCertsExist(t *testing.T, dir string) {
 		}
 	}
 }
+
+func TestValidateCertPeriod(t *testing.T) {
+	createCert := func(notBefore, notAfter time.Time) *x509.Certificate {
+		return &x509.Certificate{
+			NotBefore: notBefore,
+			NotAfter:  notAfter,
+		}
+	}
+	now := time.Now()
+	tests := []struct {
+		name        string
+		cert        *x509.Certificate
+		offset      time.Duration
+		expectedErr bool
+	}{
+		{
+			name:        "valid cert",
+			cert:        createCert(now.Add(-time.Hour), now.Add(time.Hour)),
+			expectedErr: false,
+		},
+		{
+			name:        "expired cert",
+			cert:        createCert(now.Add(-2*time.Hour), now.Add(-time.Hour)),
+			expectedErr: true,
+		},
+		{
+			name:        "not yet valid cert",
+			cert:        createCert(now.Add(time.Hour), now.Add(2*time.Hour)),
+			expectedErr: true,
+		},
+		{
+			name:        "valid cert with offset into the future",
+			cert:        createCert(now.Add(-time.Hour), now.Add(time.Hour)),
+			offset:      2 * time.Hour,
+			expectedErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateCertPeriod(test.cert, test.offset)
+			if (err != nil) != test.expectedErr {
+				t.Errorf("expected error: %v, got: %v", test.expectedErr, err)
+			}
+		})
+	}
+}
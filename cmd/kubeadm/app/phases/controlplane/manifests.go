@@ -191,6 +191,24 @@ func getAPIServerCommand(cfg *kubeadmapi.ClusterConfiguration, localAPIEndpoint
 		}
 	}
 
+	// Apply the request-header settings for the aggregation layer, if configured.
+	// These take precedence over the kubeadm defaults but can still be overridden
+	// with ExtraArgs below.
+	if fp := cfg.APIServer.FrontProxy; fp != nil {
+		if len(fp.AllowedNames) > 0 {
+			defaultArguments["requestheader-allowed-names"] = strings.Join(fp.AllowedNames, ",")
+		}
+		if len(fp.UsernameHeaders) > 0 {
+			defaultArguments["requestheader-username-headers"] = strings.Join(fp.UsernameHeaders, ",")
+		}
+		if len(fp.GroupHeaders) > 0 {
+			defaultArguments["requestheader-group-headers"] = strings.Join(fp.GroupHeaders, ",")
+		}
+		if len(fp.ExtraHeaderPrefixes) > 0 {
+			defaultArguments["requestheader-extra-headers-prefix"] = strings.Join(fp.ExtraHeaderPrefixes, ",")
+		}
+	}
+
 	if cfg.APIServer.ExtraArgs == nil {
 		cfg.APIServer.ExtraArgs = map[string]string{}
 	}
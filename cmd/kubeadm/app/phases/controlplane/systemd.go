@@ -0,0 +1,112 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplane
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/klog"
+	kubeadmapi "k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm"
+	kubeadmconstants "k8s.io/kubernetes/cmd/kubeadm/app/constants"
+)
+
+// defaultUnitBinDir is the directory where the control plane binaries are
+// expected to be installed when they run directly on the host.
+const defaultUnitBinDir = "/usr/bin"
+
+// GetSystemdUnits renders systemd unit files for the requested control plane
+// components, for deployments that run the control plane binaries directly on
+// the host instead of as static Pods. The returned map is keyed by component
+// name; values are complete unit file contents.
+// The units are built from the same command lines as the static Pod manifests,
+// so flags derived from the ClusterConfiguration are identical in both modes.
+func GetSystemdUnits(cfg *kubeadmapi.ClusterConfiguration, endpoint *kubeadmapi.APIEndpoint, k8sVersion *version.Version, componentNames ...string) (map[string]string, error) {
+	specs := GetStaticPodSpecs(cfg, endpoint, k8sVersion)
+
+	units := map[string]string{}
+	for _, componentName := range componentNames {
+		spec, exists := specs[componentName]
+		if !exists {
+			return nil, errors.Errorf("couldn't retrieve StaticPodSpec for %q", componentName)
+		}
+		units[componentName] = renderSystemdUnit(componentName, spec.Spec.Containers[0].Command, spec.Spec.Containers[0].Env)
+	}
+	return units, nil
+}
+
+// CreateSystemdUnitFiles writes systemd unit files for the requested control
+// plane components into unitDir (typically /etc/systemd/system).
+func CreateSystemdUnitFiles(unitDir string, cfg *kubeadmapi.ClusterConfiguration, endpoint *kubeadmapi.APIEndpoint, componentNames ...string) error {
+	k8sVersion, err := version.ParseSemantic(cfg.KubernetesVersion)
+	if err != nil {
+		return err
+	}
+
+	units, err := GetSystemdUnits(cfg, endpoint, k8sVersion, componentNames...)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(unitDir, 0700); err != nil {
+		return errors.Wrapf(err, "failed to create directory %q", unitDir)
+	}
+
+	for _, componentName := range componentNames {
+		filename := filepath.Join(unitDir, componentName+".service")
+		if err := ioutil.WriteFile(filename, []byte(units[componentName]), 0600); err != nil {
+			return errors.Wrapf(err, "failed to write systemd unit file for %q", componentName)
+		}
+		klog.V(1).Infof("[control-plane] wrote systemd unit for component %q to %q\n", componentName, filename)
+	}
+
+	return nil
+}
+
+// renderSystemdUnit builds the unit file contents for a single component.
+// The first element of command is the component binary name; it is replaced
+// with the expected host path of the binary.
+func renderSystemdUnit(componentName string, command []string, env []v1.EnvVar) string {
+	execStart := []string{filepath.Join(defaultUnitBinDir, command[0])}
+	execStart = append(execStart, command[1:]...)
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "[Unit]\n")
+	fmt.Fprintf(&buf, "Description=Kubernetes %s\n", componentName)
+	fmt.Fprintf(&buf, "Documentation=https://kubernetes.io/docs/\n")
+	fmt.Fprintf(&buf, "After=network.target\n")
+	if componentName != kubeadmconstants.KubeAPIServer {
+		fmt.Fprintf(&buf, "After=%s.service\n", kubeadmconstants.KubeAPIServer)
+	}
+	fmt.Fprintf(&buf, "\n[Service]\n")
+	for _, e := range env {
+		fmt.Fprintf(&buf, "Environment=%q\n", fmt.Sprintf("%s=%s", e.Name, e.Value))
+	}
+	fmt.Fprintf(&buf, "ExecStart=%s\n", strings.Join(execStart, " \\\n    "))
+	fmt.Fprintf(&buf, "Restart=always\n")
+	fmt.Fprintf(&buf, "RestartSec=10\n")
+	fmt.Fprintf(&buf, "StartLimitInterval=0\n")
+	fmt.Fprintf(&buf, "\n[Install]\n")
+	fmt.Fprintf(&buf, "WantedBy=multi-user.target\n")
+	return buf.String()
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplane
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/version"
+	kubeadmapi "k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm"
+	kubeadmconstants "k8s.io/kubernetes/cmd/kubeadm/app/constants"
+)
+
+func TestGetSystemdUnits(t *testing.T) {
+
+	// Creates a Cluster Configuration
+	cfg := &kubeadmapi.ClusterConfiguration{
+		KubernetesVersion: "v1.9.0",
+	}
+
+	k8sVersion, _ := version.ParseSemantic(cfg.KubernetesVersion)
+
+	components := []string{kubeadmconstants.KubeAPIServer, kubeadmconstants.KubeControllerManager, kubeadmconstants.KubeScheduler}
+	units, err := GetSystemdUnits(cfg, &kubeadmapi.APIEndpoint{}, k8sVersion, components...)
+	if err != nil {
+		t.Fatalf("GetSystemdUnits returned unexpected error: %v", err)
+	}
+
+	for _, componentName := range components {
+		t.Run(componentName, func(t *testing.T) {
+			unit, ok := units[componentName]
+			if !ok {
+				t.Fatalf("GetSystemdUnits did not render a unit for %q", componentName)
+			}
+			if !strings.Contains(unit, "ExecStart=/usr/bin/"+componentName) {
+				t.Errorf("unit for %q does not start the component binary:\n%s", componentName, unit)
+			}
+			if !strings.Contains(unit, "[Install]") {
+				t.Errorf("unit for %q is missing an [Install] section:\n%s", componentName, unit)
+			}
+		})
+	}
+
+	// an unknown component must be rejected
+	if _, err := GetSystemdUnits(cfg, &kubeadmapi.APIEndpoint{}, k8sVersion, "some-other-component"); err == nil {
+		t.Errorf("GetSystemdUnits did not return an error for an unknown component")
+	}
+}
@@ -84,6 +84,13 @@ func CheckLocalEtcdClusterStatus(client clientset.Interface, cfg *kubeadmapi.Clu
 // RemoveStackedEtcdMemberFromCluster will remove a local etcd member from etcd cluster,
 // when reset the control plane node.
 func RemoveStackedEtcdMemberFromCluster(client clientset.Interface, cfg *kubeadmapi.InitConfiguration) error {
+	return RemoveStackedEtcdMemberForNode(client, &cfg.ClusterConfiguration, &cfg.LocalAPIEndpoint)
+}
+
+// RemoveStackedEtcdMemberForNode will remove the etcd member that is listening on
+// the peer address derived from the given API endpoint. It is used when removing
+// a control plane node, which is not necessarily the node kubeadm runs on.
+func RemoveStackedEtcdMemberForNode(client clientset.Interface, cfg *kubeadmapi.ClusterConfiguration, endpoint *kubeadmapi.APIEndpoint) error {
 	// creates an etcd client that connects to all the local/stacked etcd members
 	klog.V(1).Info("[etcd] creating etcd client that connects to etcd pods")
 	etcdClient, err := etcdutil.NewFromCluster(client, cfg.CertificatesDir)
@@ -92,7 +99,7 @@ func RemoveStackedEtcdMemberFromCluster(client clientset.Interface, cfg *kubeadm
 	}
 
 	// notifies the other members of the etcd cluster about the removing member
-	etcdPeerAddress := etcdutil.GetPeerURL(&cfg.LocalAPIEndpoint)
+	etcdPeerAddress := etcdutil.GetPeerURL(endpoint)
 
 	klog.V(2).Infof("[etcd] get the member id from peer: %s", etcdPeerAddress)
 	id, err := etcdClient.GetMemberID(etcdPeerAddress)
@@ -21,6 +21,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/kubernetes/cmd/kubeadm/app/constants"
 	"k8s.io/kubernetes/cmd/kubeadm/app/images"
 	kubeadmutil "k8s.io/kubernetes/cmd/kubeadm/app/util"
+	utilruntime "k8s.io/kubernetes/cmd/kubeadm/app/util/runtime"
 	nodeutil "k8s.io/kubernetes/pkg/util/node"
 	"k8s.io/kubernetes/pkg/util/procfs"
 	utilsexec "k8s.io/utils/exec"
@@ -88,6 +90,15 @@ func buildKubeletArgMap(opts kubeletFlagsOpts) map[string]string {
 	} else {
 		kubeletFlags["container-runtime"] = "remote"
 		kubeletFlags["container-runtime-endpoint"] = opts.nodeRegOpts.CRISocket
+		// align the kubelet's cgroup driver with the one the CRI runtime
+		// reports, if it reports one at all
+		if containerRuntime, err := utilruntime.NewContainerRuntime(opts.execer, opts.nodeRegOpts.CRISocket); err != nil {
+			klog.Warningf("cannot automatically assign a '--cgroup-driver' value when starting the Kubelet: %v\n", err)
+		} else if driver, err := containerRuntime.CgroupDriver(); err != nil {
+			klog.Warningf("cannot automatically assign a '--cgroup-driver' value when starting the Kubelet: %v\n", err)
+		} else {
+			kubeletFlags["cgroup-driver"] = driver
+		}
 	}
 
 	if opts.registerTaintsUsingFlags && opts.nodeRegOpts.Taints != nil && len(opts.nodeRegOpts.Taints) > 0 {
@@ -99,6 +110,17 @@ func buildKubeletArgMap(opts kubeletFlagsOpts) map[string]string {
 		kubeletFlags["register-with-taints"] = strings.Join(taintStrs, ",")
 	}
 
+	if len(opts.nodeRegOpts.Labels) > 0 {
+		labelStrs := []string{}
+		for key, value := range opts.nodeRegOpts.Labels {
+			labelStrs = append(labelStrs, key+"="+value)
+		}
+		// Sort the label strings so the flag value is deterministic
+		sort.Strings(labelStrs)
+
+		kubeletFlags["node-labels"] = strings.Join(labelStrs, ",")
+	}
+
 	if pids, _ := opts.pidOfFunc("systemd-resolved"); len(pids) > 0 {
 		// procfs.PidOf only returns an error if the regex is empty or doesn't compile, so we can ignore it
 		kubeletFlags["resolv-conf"] = "/run/systemd/resolve/resolv.conf"
@@ -110,8 +132,6 @@ func buildKubeletArgMap(opts kubeletFlagsOpts) map[string]string {
 		kubeletFlags["hostname-override"] = opts.nodeRegOpts.Name
 	}
 
-	// TODO: Conditionally set `--cgroup-driver` to either `systemd` or `cgroupfs` for CRI other than Docker
-
 	return kubeletFlags
 }
 
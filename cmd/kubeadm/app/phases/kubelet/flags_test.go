@@ -220,6 +220,27 @@ func TestBuildKubeletArgMap(t *testing.T) {
 				"register-with-taints":       "foo=bar:baz,key=val:eff",
 			},
 		},
+		{
+			name: "nodeRegOpts.Labels are rendered deterministically",
+			opts: kubeletFlagsOpts{
+				nodeRegOpts: &kubeadmapi.NodeRegistrationOptions{
+					CRISocket: "/var/run/containerd.sock",
+					Name:      "foo",
+					Labels: map[string]string{
+						"pool":                 "workers",
+						"example.com/gpu-node": "true",
+					},
+				},
+				execer:          cgroupfsCgroupExecer,
+				pidOfFunc:       binaryNotRunningPidOfFunc,
+				defaultHostname: "foo",
+			},
+			expected: map[string]string{
+				"container-runtime":          "remote",
+				"container-runtime-endpoint": "/var/run/containerd.sock",
+				"node-labels":                "example.com/gpu-node=true,pool=workers",
+			},
+		},
 		{
 			name: "systemd-resolved running",
 			opts: kubeletFlagsOpts{
@@ -109,6 +109,35 @@ func (crc ContainerRuntimeCheck) Check() (warnings, errorList []error) {
 	return warnings, errorList
 }
 
+// ContainerRuntimeCgroupDriverCheck verifies that the cgroup driver reported
+// by the container runtime matches the one the kubelet is configured with.
+type ContainerRuntimeCgroupDriverCheck struct {
+	runtime       utilruntime.ContainerRuntime
+	kubeletDriver string
+}
+
+// Name returns label for ContainerRuntimeCgroupDriverCheck.
+func (ContainerRuntimeCgroupDriverCheck) Name() string {
+	return "CRICgroupDriver"
+}
+
+// Check compares the cgroup driver of the container runtime with the kubelet's.
+func (crc ContainerRuntimeCgroupDriverCheck) Check() (warnings, errorList []error) {
+	klog.V(1).Infoln("validating the container runtime cgroup driver")
+	driver, err := crc.runtime.CgroupDriver()
+	if err != nil {
+		// not every runtime exposes its cgroup driver, so failing to detect
+		// it is only worth a warning
+		warnings = append(warnings, errors.Wrap(err, "couldn't detect the container runtime cgroup driver"))
+		return warnings, nil
+	}
+	if crc.kubeletDriver != "" && crc.kubeletDriver != driver {
+		errorList = append(errorList, errors.Errorf("the container runtime is using %q as the cgroup driver, but the kubelet is configured with %q. "+
+			"Set the kubelet's 'cgroup-driver' to %q or reconfigure the container runtime", driver, crc.kubeletDriver, driver))
+	}
+	return warnings, errorList
+}
+
 // ServiceCheck verifies that the given service is enabled and active. If we do not
 // detect a supported init system however, all checks are skipped and a warning is
 // returned.
@@ -1025,6 +1054,10 @@ func addCommonChecks(execer utilsexec.Interface, k8sVersion string, nodeReg *kub
 		fmt.Printf("[preflight] WARNING: Couldn't create the interface used for talking to the container runtime: %v\n", err)
 	} else {
 		checks = append(checks, ContainerRuntimeCheck{runtime: containerRuntime})
+		checks = append(checks, ContainerRuntimeCgroupDriverCheck{
+			runtime:       containerRuntime,
+			kubeletDriver: nodeReg.KubeletExtraArgs["cgroup-driver"],
+		})
 		if containerRuntime.IsDocker() {
 			isDocker = true
 			checks = append(checks, ServiceCheck{Service: "docker", CheckIfActive: true})
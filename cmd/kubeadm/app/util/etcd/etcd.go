@@ -174,6 +174,60 @@ func (c *Client) GetMemberID(peerURL string) (uint64, error) {
 	return 0, nil
 }
 
+// ListMembers returns the list of members of the etcd cluster
+func (c *Client) ListMembers() ([]Member, error) {
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:   c.Endpoints,
+		DialTimeout: 30 * time.Second,
+		TLS:         c.TLS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	resp, err := cli.MemberList(ctx)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+
+	ret := []Member{}
+	for _, m := range resp.Members {
+		ret = append(ret, Member{Name: m.Name, PeerURL: m.PeerURLs[0]})
+	}
+	return ret, nil
+}
+
+// GetMemberIDByName returns the member ID of the member with the given name, or zero
+// if the cluster has no member with that name
+func (c *Client) GetMemberIDByName(name string) (uint64, error) {
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:   c.Endpoints,
+		DialTimeout: 30 * time.Second,
+		TLS:         c.TLS,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	resp, err := cli.MemberList(ctx)
+	cancel()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, member := range resp.Members {
+		if member.GetName() == name {
+			return member.GetID(), nil
+		}
+	}
+	return 0, nil
+}
+
 // RemoveMember notifies an etcd cluster to remove an existing member
 func (c *Client) RemoveMember(id uint64) ([]Member, error) {
 	cli, err := clientv3.New(clientv3.Config{
@@ -58,12 +58,18 @@ const (
 
 // NewCertificateAuthority creates new certificate and private key for the certificate authority
 func NewCertificateAuthority(config *certutil.Config) (*x509.Certificate, crypto.Signer, error) {
+	return NewCertificateAuthorityWithValidity(config, kubeadmconstants.CACertificateValidity)
+}
+
+// NewCertificateAuthorityWithValidity creates new certificate and private key for the
+// certificate authority, using the given validity period instead of the default one.
+func NewCertificateAuthorityWithValidity(config *certutil.Config, validity time.Duration) (*x509.Certificate, crypto.Signer, error) {
 	key, err := NewPrivateKey()
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "unable to create private key while generating CA certificate")
 	}
 
-	cert, err := certutil.NewSelfSignedCACert(*config, key)
+	cert, err := newSelfSignedCACert(config, key, validity)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "unable to create self-signed CA certificate")
 	}
@@ -71,14 +77,44 @@ func NewCertificateAuthority(config *certutil.Config) (*x509.Certificate, crypto
 	return cert, key, nil
 }
 
+// newSelfSignedCACert creates a CA certificate with the given validity period. It mirrors
+// certutil.NewSelfSignedCACert, which hardcodes the validity to ten years.
+func newSelfSignedCACert(cfg *certutil.Config, key crypto.Signer, validity time.Duration) (*x509.Certificate, error) {
+	now := time.Now()
+	tmpl := x509.Certificate{
+		SerialNumber: new(big.Int).SetInt64(0),
+		Subject: pkix.Name{
+			CommonName:   cfg.CommonName,
+			Organization: cfg.Organization,
+		},
+		NotBefore:             now.UTC(),
+		NotAfter:              now.Add(validity).UTC(),
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	certDERBytes, err := x509.CreateCertificate(cryptorand.Reader, &tmpl, &tmpl, key.Public(), key)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(certDERBytes)
+}
+
 // NewCertAndKey creates new certificate and key by passing the certificate authority certificate and key
 func NewCertAndKey(caCert *x509.Certificate, caKey crypto.Signer, config *certutil.Config) (*x509.Certificate, crypto.Signer, error) {
+	return NewCertAndKeyWithValidity(caCert, caKey, config, kubeadmconstants.CertificateValidity)
+}
+
+// NewCertAndKeyWithValidity creates new certificate and key by passing the certificate
+// authority certificate and key, using the given validity period instead of the default one.
+func NewCertAndKeyWithValidity(caCert *x509.Certificate, caKey crypto.Signer, config *certutil.Config, validity time.Duration) (*x509.Certificate, crypto.Signer, error) {
 	key, err := NewPrivateKey()
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "unable to create private key")
 	}
 
-	cert, err := NewSignedCert(config, key, caCert, caKey)
+	cert, err := NewSignedCertWithValidity(config, key, caCert, caKey, validity)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "unable to sign certificate")
 	}
@@ -551,6 +587,12 @@ func NewPrivateKey() (crypto.Signer, error) {
 
 // NewSignedCert creates a signed certificate using the given CA certificate and key
 func NewSignedCert(cfg *certutil.Config, key crypto.Signer, caCert *x509.Certificate, caKey crypto.Signer) (*x509.Certificate, error) {
+	return NewSignedCertWithValidity(cfg, key, caCert, caKey, kubeadmconstants.CertificateValidity)
+}
+
+// NewSignedCertWithValidity creates a signed certificate using the given CA certificate
+// and key, using the given validity period instead of the default one.
+func NewSignedCertWithValidity(cfg *certutil.Config, key crypto.Signer, caCert *x509.Certificate, caKey crypto.Signer, validity time.Duration) (*x509.Certificate, error) {
 	serial, err := cryptorand.Int(cryptorand.Reader, new(big.Int).SetInt64(math.MaxInt64))
 	if err != nil {
 		return nil, err
@@ -571,7 +613,7 @@ func NewSignedCert(cfg *certutil.Config, key crypto.Signer, caCert *x509.Certifi
 		IPAddresses:  cfg.AltNames.IPs,
 		SerialNumber: serial,
 		NotBefore:    caCert.NotBefore,
-		NotAfter:     time.Now().Add(kubeadmconstants.CertificateValidity).UTC(),
+		NotAfter:     time.Now().Add(validity).UTC(),
 		KeyUsage:     x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
 		ExtKeyUsage:  cfg.Usages,
 	}
@@ -17,6 +17,7 @@ limitations under the License.
 package util
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	goruntime "runtime"
@@ -26,6 +27,7 @@ import (
 
 	errorsutil "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/kubernetes/cmd/kubeadm/app/constants"
+	kubeadmutil "k8s.io/kubernetes/cmd/kubeadm/app/util"
 	utilsexec "k8s.io/utils/exec"
 )
 
@@ -37,6 +39,7 @@ type ContainerRuntime interface {
 	RemoveContainers(containers []string) error
 	PullImage(image string) error
 	ImageExists(image string) (bool, error)
+	CgroupDriver() (string, error)
 }
 
 // CRIRuntime is a struct that interfaces with the CRI
@@ -180,6 +183,69 @@ func (runtime *DockerRuntime) ImageExists(image string) (bool, error) {
 	return err == nil, nil
 }
 
+// CgroupDriver returns the cgroup driver the runtime reports via 'crictl info'.
+// Not every runtime exposes its cgroup driver in its status; an error is
+// returned when it cannot be determined.
+func (runtime *CRIRuntime) CgroupDriver() (string, error) {
+	out, err := runtime.exec.Command("crictl", "-r", runtime.criSocket, "info").CombinedOutput()
+	if err != nil {
+		return "", errors.Wrapf(err, "output: %s, error", string(out))
+	}
+	return parseCgroupDriverFromCRIInfo(out)
+}
+
+// CgroupDriver returns the cgroup driver the Docker daemon is configured with
+func (runtime *DockerRuntime) CgroupDriver() (string, error) {
+	out, err := runtime.exec.Command("docker", "info", "-f", "{{.CgroupDriver}}").Output()
+	if err != nil {
+		return "", errors.Wrap(err, "cannot execute 'docker info'")
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// parseCgroupDriverFromCRIInfo digs the cgroup driver out of the JSON status
+// blob returned by 'crictl info'. The field is runtime specific: CRI-O
+// reports a "cgroupDriver" string while containerd reports a "SystemdCgroup"
+// boolean, so both spellings are searched recursively.
+func parseCgroupDriverFromCRIInfo(out []byte) (string, error) {
+	var info map[string]interface{}
+	if err := json.Unmarshal(out, &info); err != nil {
+		return "", errors.Wrap(err, "cannot parse 'crictl info' output")
+	}
+	if driver := findCgroupDriver(info); driver != "" {
+		return driver, nil
+	}
+	return "", errors.New("the container runtime did not report its cgroup driver")
+}
+
+func findCgroupDriver(value interface{}) string {
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	for key, v := range obj {
+		switch strings.ToLower(key) {
+		case "cgroupdriver":
+			if driver, ok := v.(string); ok && driver != "" {
+				return driver
+			}
+		case "systemdcgroup":
+			if systemd, ok := v.(bool); ok {
+				if systemd {
+					return kubeadmutil.CgroupDriverSystemd
+				}
+				return kubeadmutil.CgroupDriverCgroupfs
+			}
+		}
+	}
+	for _, v := range obj {
+		if driver := findCgroupDriver(v); driver != "" {
+			return driver
+		}
+	}
+	return ""
+}
+
 // isExistingSocket checks if path exists and is domain socket
 func isExistingSocket(path string) bool {
 	fileInfo, err := os.Stat(path)
@@ -448,3 +448,56 @@ func TestDetectCRISocketImpl(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCgroupDriverFromCRIInfo(t *testing.T) {
+	cases := []struct {
+		name    string
+		output  string
+		driver  string
+		isError bool
+	}{
+		{
+			name:   "valid: cri-o style cgroupDriver field",
+			output: `{"status":{"conditions":[]},"config":{"cgroupDriver":"systemd"}}`,
+			driver: "systemd",
+		},
+		{
+			name:   "valid: containerd style SystemdCgroup true",
+			output: `{"config":{"containerd":{"runtimes":{"runc":{"options":{"SystemdCgroup":true}}}}}}`,
+			driver: "systemd",
+		},
+		{
+			name:   "valid: containerd style SystemdCgroup false",
+			output: `{"config":{"containerd":{"runtimes":{"runc":{"options":{"SystemdCgroup":false}}}}}}`,
+			driver: "cgroupfs",
+		},
+		{
+			name:    "invalid: no driver information",
+			output:  `{"status":{"conditions":[]}}`,
+			isError: true,
+		},
+		{
+			name:    "invalid: not JSON",
+			output:  `no json here`,
+			isError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			driver, err := parseCgroupDriverFromCRIInfo([]byte(tc.output))
+			if tc.isError {
+				if err == nil {
+					t.Fatalf("unexpected success parsing %q", tc.output)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if driver != tc.driver {
+				t.Fatalf("expected driver %q, got %q", tc.driver, driver)
+			}
+		})
+	}
+}
@@ -46,6 +46,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Cluster{},
 		&ClusterList{},
+		&ReplicaSchedulingPreference{},
+		&ReplicaSchedulingPreferenceList{},
 	)
 	return nil
 }
@@ -162,6 +162,61 @@ type ClusterPreferences struct {
 	Weight int64
 }
 
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ReplicaSchedulingPreference expresses as a first-class API object how the replicas of
+// a federated workload should be distributed over the member clusters. It supersedes the
+// per-type preferences annotations: when a preference object targeting a workload exists,
+// the annotation on that workload is ignored.
+type ReplicaSchedulingPreference struct {
+	metav1.TypeMeta
+	// Standard object's metadata. The preference applies to the workload of kind
+	// Spec.TargetKind with the same name and namespace.
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the desired replica distribution.
+	// +optional
+	Spec ReplicaSchedulingPreferenceSpec
+}
+
+// ReplicaSchedulingPreferenceSpec describes the desired distribution of the replicas of
+// a federated workload over the member clusters.
+type ReplicaSchedulingPreferenceSpec struct {
+	// Kind of the federated workload the preference applies to, e.g. "ReplicaSet" or
+	// "Deployment". The workload is identified by this kind and the name and namespace
+	// of the preference object.
+	TargetKind string
+
+	// If set to true then already scheduled and running replicas may be moved to other
+	// clusters in order to match the preferences. Replicas placed in clusters that are
+	// no longer ready are always rescheduled, regardless of this setting.
+	// +optional
+	Rebalance bool
+
+	// A mapping between cluster names and preferences regarding the local workload
+	// object in these clusters.
+	// "*" (if provided) applies to all clusters if an explicit mapping is not provided.
+	// If omitted, clusters without explicit preferences should not have any replicas
+	// scheduled.
+	// +optional
+	Clusters map[string]ClusterPreferences
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ReplicaSchedulingPreferenceList is a list of ReplicaSchedulingPreference objects.
+type ReplicaSchedulingPreferenceList struct {
+	metav1.TypeMeta
+	// Standard list metadata.
+	// +optional
+	metav1.ListMeta
+
+	// List of ReplicaSchedulingPreference objects.
+	Items []ReplicaSchedulingPreference
+}
+
 // Annotation for a federated service to keep record of service loadbalancer ingresses in federated cluster
 type FederatedServiceIngress struct {
 	// List of loadbalancer ingress of a service in all federated clusters
@@ -126,6 +126,79 @@ type ClusterList struct {
 	Items []Cluster `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ReplicaSchedulingPreference expresses as a first-class API object how the replicas of
+// a federated workload should be distributed over the member clusters. It supersedes the
+// per-type preferences annotations: when a preference object targeting a workload exists,
+// the annotation on that workload is ignored.
+type ReplicaSchedulingPreference struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata. The preference applies to the workload of kind
+	// Spec.TargetKind with the same name and namespace.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the desired replica distribution.
+	// +optional
+	Spec ReplicaSchedulingPreferenceSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// ReplicaSchedulingPreferenceSpec describes the desired distribution of the replicas of
+// a federated workload over the member clusters.
+type ReplicaSchedulingPreferenceSpec struct {
+	// Kind of the federated workload the preference applies to, e.g. "ReplicaSet" or
+	// "Deployment". The workload is identified by this kind and the name and namespace
+	// of the preference object.
+	TargetKind string `json:"targetKind" protobuf:"bytes,1,opt,name=targetKind"`
+
+	// If set to true then already scheduled and running replicas may be moved to other
+	// clusters in order to match the preferences. Replicas placed in clusters that are
+	// no longer ready are always rescheduled, regardless of this setting.
+	// +optional
+	Rebalance bool `json:"rebalance,omitempty" protobuf:"varint,2,opt,name=rebalance"`
+
+	// A mapping between cluster names and preferences regarding the local workload
+	// object in these clusters.
+	// "*" (if provided) applies to all clusters if an explicit mapping is not provided.
+	// If omitted, clusters without explicit preferences should not have any replicas
+	// scheduled.
+	// +optional
+	Clusters map[string]ClusterPreferences `json:"clusters,omitempty" protobuf:"bytes,3,rep,name=clusters"`
+}
+
+// ClusterPreferences regard the number of replicas assigned to a cluster workload object
+// within a federated workload object.
+type ClusterPreferences struct {
+	// Minimum number of replicas that should be assigned to this cluster workload object.
+	// 0 by default.
+	// +optional
+	MinReplicas int64 `json:"minReplicas,omitempty" protobuf:"varint,1,opt,name=minReplicas"`
+
+	// Maximum number of replicas that should be assigned to this cluster workload object.
+	// Unbounded if no value provided (default).
+	// +optional
+	MaxReplicas *int64 `json:"maxReplicas,omitempty" protobuf:"varint,2,opt,name=maxReplicas"`
+
+	// A number expressing the preference to put an additional replica to this cluster
+	// workload object. 0 by default.
+	Weight int64 `json:"weight,omitempty" protobuf:"varint,3,opt,name=weight"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ReplicaSchedulingPreferenceList is a list of ReplicaSchedulingPreference objects.
+type ReplicaSchedulingPreferenceList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of ReplicaSchedulingPreference objects.
+	Items []ReplicaSchedulingPreference `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
 // Expressed as value of annotation for selecting the clusters on which a resource is created.
 type ClusterSelector []ClusterSelectorRequirement
 
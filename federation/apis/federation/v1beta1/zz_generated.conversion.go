@@ -43,10 +43,18 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_federation_ClusterCondition_To_v1beta1_ClusterCondition,
 		Convert_v1beta1_ClusterList_To_federation_ClusterList,
 		Convert_federation_ClusterList_To_v1beta1_ClusterList,
+		Convert_v1beta1_ClusterPreferences_To_federation_ClusterPreferences,
+		Convert_federation_ClusterPreferences_To_v1beta1_ClusterPreferences,
 		Convert_v1beta1_ClusterSpec_To_federation_ClusterSpec,
 		Convert_federation_ClusterSpec_To_v1beta1_ClusterSpec,
 		Convert_v1beta1_ClusterStatus_To_federation_ClusterStatus,
 		Convert_federation_ClusterStatus_To_v1beta1_ClusterStatus,
+		Convert_v1beta1_ReplicaSchedulingPreference_To_federation_ReplicaSchedulingPreference,
+		Convert_federation_ReplicaSchedulingPreference_To_v1beta1_ReplicaSchedulingPreference,
+		Convert_v1beta1_ReplicaSchedulingPreferenceList_To_federation_ReplicaSchedulingPreferenceList,
+		Convert_federation_ReplicaSchedulingPreferenceList_To_v1beta1_ReplicaSchedulingPreferenceList,
+		Convert_v1beta1_ReplicaSchedulingPreferenceSpec_To_federation_ReplicaSchedulingPreferenceSpec,
+		Convert_federation_ReplicaSchedulingPreferenceSpec_To_v1beta1_ReplicaSchedulingPreferenceSpec,
 		Convert_v1beta1_ServerAddressByClientCIDR_To_federation_ServerAddressByClientCIDR,
 		Convert_federation_ServerAddressByClientCIDR_To_v1beta1_ServerAddressByClientCIDR,
 	)
@@ -136,6 +144,30 @@ func Convert_federation_ClusterList_To_v1beta1_ClusterList(in *federation.Cluste
 	return autoConvert_federation_ClusterList_To_v1beta1_ClusterList(in, out, s)
 }
 
+func autoConvert_v1beta1_ClusterPreferences_To_federation_ClusterPreferences(in *ClusterPreferences, out *federation.ClusterPreferences, s conversion.Scope) error {
+	out.MinReplicas = in.MinReplicas
+	out.MaxReplicas = (*int64)(unsafe.Pointer(in.MaxReplicas))
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1beta1_ClusterPreferences_To_federation_ClusterPreferences is an autogenerated conversion function.
+func Convert_v1beta1_ClusterPreferences_To_federation_ClusterPreferences(in *ClusterPreferences, out *federation.ClusterPreferences, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterPreferences_To_federation_ClusterPreferences(in, out, s)
+}
+
+func autoConvert_federation_ClusterPreferences_To_v1beta1_ClusterPreferences(in *federation.ClusterPreferences, out *ClusterPreferences, s conversion.Scope) error {
+	out.MinReplicas = in.MinReplicas
+	out.MaxReplicas = (*int64)(unsafe.Pointer(in.MaxReplicas))
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_federation_ClusterPreferences_To_v1beta1_ClusterPreferences is an autogenerated conversion function.
+func Convert_federation_ClusterPreferences_To_v1beta1_ClusterPreferences(in *federation.ClusterPreferences, out *ClusterPreferences, s conversion.Scope) error {
+	return autoConvert_federation_ClusterPreferences_To_v1beta1_ClusterPreferences(in, out, s)
+}
+
 func autoConvert_v1beta1_ClusterSpec_To_federation_ClusterSpec(in *ClusterSpec, out *federation.ClusterSpec, s conversion.Scope) error {
 	out.ServerAddressByClientCIDRs = *(*[]federation.ServerAddressByClientCIDR)(unsafe.Pointer(&in.ServerAddressByClientCIDRs))
 	out.SecretRef = (*api.LocalObjectReference)(unsafe.Pointer(in.SecretRef))
@@ -182,6 +214,78 @@ func Convert_federation_ClusterStatus_To_v1beta1_ClusterStatus(in *federation.Cl
 	return autoConvert_federation_ClusterStatus_To_v1beta1_ClusterStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_ReplicaSchedulingPreference_To_federation_ReplicaSchedulingPreference(in *ReplicaSchedulingPreference, out *federation.ReplicaSchedulingPreference, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ReplicaSchedulingPreferenceSpec_To_federation_ReplicaSchedulingPreferenceSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ReplicaSchedulingPreference_To_federation_ReplicaSchedulingPreference is an autogenerated conversion function.
+func Convert_v1beta1_ReplicaSchedulingPreference_To_federation_ReplicaSchedulingPreference(in *ReplicaSchedulingPreference, out *federation.ReplicaSchedulingPreference, s conversion.Scope) error {
+	return autoConvert_v1beta1_ReplicaSchedulingPreference_To_federation_ReplicaSchedulingPreference(in, out, s)
+}
+
+func autoConvert_federation_ReplicaSchedulingPreference_To_v1beta1_ReplicaSchedulingPreference(in *federation.ReplicaSchedulingPreference, out *ReplicaSchedulingPreference, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_federation_ReplicaSchedulingPreferenceSpec_To_v1beta1_ReplicaSchedulingPreferenceSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_federation_ReplicaSchedulingPreference_To_v1beta1_ReplicaSchedulingPreference is an autogenerated conversion function.
+func Convert_federation_ReplicaSchedulingPreference_To_v1beta1_ReplicaSchedulingPreference(in *federation.ReplicaSchedulingPreference, out *ReplicaSchedulingPreference, s conversion.Scope) error {
+	return autoConvert_federation_ReplicaSchedulingPreference_To_v1beta1_ReplicaSchedulingPreference(in, out, s)
+}
+
+func autoConvert_v1beta1_ReplicaSchedulingPreferenceList_To_federation_ReplicaSchedulingPreferenceList(in *ReplicaSchedulingPreferenceList, out *federation.ReplicaSchedulingPreferenceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]federation.ReplicaSchedulingPreference)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ReplicaSchedulingPreferenceList_To_federation_ReplicaSchedulingPreferenceList is an autogenerated conversion function.
+func Convert_v1beta1_ReplicaSchedulingPreferenceList_To_federation_ReplicaSchedulingPreferenceList(in *ReplicaSchedulingPreferenceList, out *federation.ReplicaSchedulingPreferenceList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ReplicaSchedulingPreferenceList_To_federation_ReplicaSchedulingPreferenceList(in, out, s)
+}
+
+func autoConvert_federation_ReplicaSchedulingPreferenceList_To_v1beta1_ReplicaSchedulingPreferenceList(in *federation.ReplicaSchedulingPreferenceList, out *ReplicaSchedulingPreferenceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ReplicaSchedulingPreference)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_federation_ReplicaSchedulingPreferenceList_To_v1beta1_ReplicaSchedulingPreferenceList is an autogenerated conversion function.
+func Convert_federation_ReplicaSchedulingPreferenceList_To_v1beta1_ReplicaSchedulingPreferenceList(in *federation.ReplicaSchedulingPreferenceList, out *ReplicaSchedulingPreferenceList, s conversion.Scope) error {
+	return autoConvert_federation_ReplicaSchedulingPreferenceList_To_v1beta1_ReplicaSchedulingPreferenceList(in, out, s)
+}
+
+func autoConvert_v1beta1_ReplicaSchedulingPreferenceSpec_To_federation_ReplicaSchedulingPreferenceSpec(in *ReplicaSchedulingPreferenceSpec, out *federation.ReplicaSchedulingPreferenceSpec, s conversion.Scope) error {
+	out.TargetKind = in.TargetKind
+	out.Rebalance = in.Rebalance
+	out.Clusters = *(*map[string]federation.ClusterPreferences)(unsafe.Pointer(&in.Clusters))
+	return nil
+}
+
+// Convert_v1beta1_ReplicaSchedulingPreferenceSpec_To_federation_ReplicaSchedulingPreferenceSpec is an autogenerated conversion function.
+func Convert_v1beta1_ReplicaSchedulingPreferenceSpec_To_federation_ReplicaSchedulingPreferenceSpec(in *ReplicaSchedulingPreferenceSpec, out *federation.ReplicaSchedulingPreferenceSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ReplicaSchedulingPreferenceSpec_To_federation_ReplicaSchedulingPreferenceSpec(in, out, s)
+}
+
+func autoConvert_federation_ReplicaSchedulingPreferenceSpec_To_v1beta1_ReplicaSchedulingPreferenceSpec(in *federation.ReplicaSchedulingPreferenceSpec, out *ReplicaSchedulingPreferenceSpec, s conversion.Scope) error {
+	out.TargetKind = in.TargetKind
+	out.Rebalance = in.Rebalance
+	out.Clusters = *(*map[string]ClusterPreferences)(unsafe.Pointer(&in.Clusters))
+	return nil
+}
+
+// Convert_federation_ReplicaSchedulingPreferenceSpec_To_v1beta1_ReplicaSchedulingPreferenceSpec is an autogenerated conversion function.
+func Convert_federation_ReplicaSchedulingPreferenceSpec_To_v1beta1_ReplicaSchedulingPreferenceSpec(in *federation.ReplicaSchedulingPreferenceSpec, out *ReplicaSchedulingPreferenceSpec, s conversion.Scope) error {
+	return autoConvert_federation_ReplicaSchedulingPreferenceSpec_To_v1beta1_ReplicaSchedulingPreferenceSpec(in, out, s)
+}
+
 func autoConvert_v1beta1_ServerAddressByClientCIDR_To_federation_ServerAddressByClientCIDR(in *ServerAddressByClientCIDR, out *federation.ServerAddressByClientCIDR, s conversion.Scope) error {
 	out.ClientCIDR = in.ClientCIDR
 	out.ServerAddress = in.ServerAddress
@@ -30,6 +30,10 @@ func (c *FakeFederationV1beta1) Clusters() v1beta1.ClusterInterface {
 	return &FakeClusters{c}
 }
 
+func (c *FakeFederationV1beta1) ReplicaSchedulingPreferences(namespace string) v1beta1.ReplicaSchedulingPreferenceInterface {
+	return &FakeReplicaSchedulingPreferences{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeFederationV1beta1) RESTClient() rest.Interface {
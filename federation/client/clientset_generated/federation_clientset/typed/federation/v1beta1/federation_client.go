@@ -26,6 +26,7 @@ import (
 type FederationV1beta1Interface interface {
 	RESTClient() rest.Interface
 	ClustersGetter
+	ReplicaSchedulingPreferencesGetter
 }
 
 // FederationV1beta1Client is used to interact with features provided by the federation group.
@@ -37,6 +38,10 @@ func (c *FederationV1beta1Client) Clusters() ClusterInterface {
 	return newClusters(c)
 }
 
+func (c *FederationV1beta1Client) ReplicaSchedulingPreferences(namespace string) ReplicaSchedulingPreferenceInterface {
+	return newReplicaSchedulingPreferences(c, namespace)
+}
+
 // NewForConfig creates a new FederationV1beta1Client for the given config.
 func NewForConfig(c *rest.Config) (*FederationV1beta1Client, error) {
 	config := *c
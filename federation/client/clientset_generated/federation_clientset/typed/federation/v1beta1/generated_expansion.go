@@ -17,3 +17,5 @@ limitations under the License.
 package v1beta1
 
 type ClusterExpansion interface{}
+
+type ReplicaSchedulingPreferenceExpansion interface{}
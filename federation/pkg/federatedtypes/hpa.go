@@ -18,6 +18,7 @@ package federatedtypes
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
@@ -687,9 +688,10 @@ func (a *HpaAdapter) distributeMaxReplicas(toDistributeMax int32, lists hpaLists
 			}
 		}
 	} else { // we have no new clusters but if still have max replicas to distribute;
-		// just distribute all in current clusters.
+		// just distribute all in current clusters, preferring the loaded ones.
 		for toDistributeMax > 0 {
-			for cluster, replicas := range scheduled {
+			for _, cluster := range clustersByLoad(currentObjs, scheduled) {
+				replicas := scheduled[cluster]
 				if replicas == nil {
 					replicas = &replicaNums{min: 0, max: 0}
 					scheduled[cluster] = replicas
@@ -789,9 +791,10 @@ func (a *HpaAdapter) distributeMinReplicas(toDistributeMin int32, lists hpaLists
 			}
 		}
 	} else { // we have no new clusters but if still have min replicas to distribute;
-		// just distribute all in current clusters.
+		// just distribute all in current clusters, preferring the loaded ones.
 		for toDistributeMin > 0 {
-			for _, replicas := range scheduled {
+			for _, cluster := range clustersByLoad(currentObjs, scheduled) {
+				replicas := scheduled[cluster]
 				if replicas == nil {
 					// We did not get max here so this cluster
 					// remains without hpa
@@ -826,6 +829,37 @@ func (a *HpaAdapter) distributeMinReplicas(toDistributeMin int32, lists hpaLists
 	return toDistributeMin
 }
 
+// clustersByLoad returns the cluster names from scheduled, ordered by
+// decreasing observed cpu utilization of the local hpa (clusters for which
+// no utilization has been reported yet sort last). Distributing the remaining
+// replicas in this order makes the spread proportional to the observed load
+// and, as a side effect, deterministic across reconciliations.
+func clustersByLoad(currentObjs map[string]pkgruntime.Object, scheduled map[string]*replicaNums) []string {
+	names := make([]string, 0, len(scheduled))
+	for cluster := range scheduled {
+		names = append(names, cluster)
+	}
+	utilization := func(cluster string) int32 {
+		obj, exists := currentObjs[cluster]
+		if !exists || obj == nil {
+			return -1
+		}
+		hpa := obj.(*autoscalingv1.HorizontalPodAutoscaler)
+		if hpa.Status.CurrentCPUUtilizationPercentage == nil {
+			return -1
+		}
+		return *hpa.Status.CurrentCPUUtilizationPercentage
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		ui, uj := utilization(names[i]), utilization(names[j])
+		if ui != uj {
+			return ui > uj
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
 // finaliseScheduleState ensures that the minReplica count is made to 1
 // for those clusters which got max, but did not get min. This is because
 // k8s hpa does not accept hpas with 0 min replicas.
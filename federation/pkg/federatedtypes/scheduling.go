@@ -157,6 +157,13 @@ func (a *replicaSchedulingAdapter) GetSchedule(obj pkgruntime.Object, key string
 	objectGetter := func(clusterName, key string) (interface{}, bool, error) {
 		return informer.GetTargetStore().GetByKey(clusterName, key)
 	}
+	nodesGetter := func(clusterName string) (*apiv1.NodeList, error) {
+		clientset, err := informer.GetClientsetForCluster(clusterName)
+		if err != nil {
+			return nil, err
+		}
+		return clientset.Core().Nodes().List(metav1.ListOptions{})
+	}
 	podsGetter := func(clusterName string, obj pkgruntime.Object) (*apiv1.PodList, error) {
 		clientset, err := informer.GetClientsetForCluster(clusterName)
 		if err != nil {
@@ -207,6 +214,17 @@ func (a *replicaSchedulingAdapter) GetSchedule(obj pkgruntime.Object, key string
 		}
 	}
 
+	// Re-weight the preferences based on the allocatable capacity reported by
+	// each cluster. GetSchedule is invoked on every reconciliation of the
+	// federated object, so the distribution converges as capacity and
+	// failed-scheduling signals (estimatedCapacity) change over time.
+	allocatableCapacity, err := clustersAllocatableCapacity(clusterNames, nodesGetter)
+	if err != nil {
+		glog.Infof("Failed to read allocatable capacity of clusters, falling back to static weights. object: %v, err: %v", key, err)
+	} else {
+		fedPref = reweightByCapacity(fedPref, allocatableCapacity)
+	}
+
 	plnr := planner.NewPlanner(fedPref)
 
 	return &ReplicaSchedulingInfo{
@@ -354,3 +372,53 @@ func clustersReplicaState(
 	}
 	return currentReplicasPerCluster, estimatedCapacity, nil
 }
+
+// clustersAllocatableCapacity returns the sum of allocatable CPU (in millicores) reported
+// by the schedulable nodes of each federated cluster. Clusters whose nodes cannot be listed
+// are left out of the result so that scheduling can still proceed for the remaining clusters.
+func clustersAllocatableCapacity(clusterNames []string, nodesGetter func(clusterName string) (*apiv1.NodeList, error)) (map[string]int64, error) {
+	allocatable := make(map[string]int64)
+	for _, clusterName := range clusterNames {
+		nodes, err := nodesGetter(clusterName)
+		if err != nil {
+			glog.Infof("Failed to list nodes of cluster %s: %v", clusterName, err)
+			continue
+		}
+		capacity := int64(0)
+		for _, node := range nodes.Items {
+			if node.Spec.Unschedulable {
+				continue
+			}
+			cpu := node.Status.Allocatable[apiv1.ResourceCPU]
+			capacity += cpu.MilliValue()
+		}
+		allocatable[clusterName] = capacity
+	}
+	return allocatable, nil
+}
+
+// reweightByCapacity derives per-cluster weights proportional to the allocatable capacity
+// reported by each cluster. Explicit per-cluster preferences provided by the user always
+// win - the capacity based weights are applied only when the preferences consist of a
+// single wildcard entry (which includes the default preferences).
+func reweightByCapacity(pref *fedapi.ReplicaAllocationPreferences, allocatableCapacity map[string]int64) *fedapi.ReplicaAllocationPreferences {
+	wildcard, found := pref.Clusters["*"]
+	if !found || len(pref.Clusters) > 1 {
+		return pref
+	}
+	reweighted := &fedapi.ReplicaAllocationPreferences{
+		Rebalance: pref.Rebalance,
+		Clusters:  map[string]fedapi.ClusterPreferences{"*": wildcard},
+	}
+	for clusterName, capacity := range allocatableCapacity {
+		if capacity <= 0 {
+			continue
+		}
+		reweighted.Clusters[clusterName] = fedapi.ClusterPreferences{
+			MinReplicas: wildcard.MinReplicas,
+			MaxReplicas: wildcard.MaxReplicas,
+			Weight:      capacity,
+		}
+	}
+	return reweighted
+}
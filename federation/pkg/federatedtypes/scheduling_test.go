@@ -23,8 +23,10 @@ import (
 
 	apiv1 "k8s.io/api/core/v1"
 	extensionsv1 "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgruntime "k8s.io/apimachinery/pkg/runtime"
+	fedapi "k8s.io/kubernetes/federation/apis/federation"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -161,3 +163,74 @@ func newPod(name string) *apiv1.Pod {
 		},
 	}
 }
+
+func TestReweightByCapacity(t *testing.T) {
+	two := int64(2)
+	wildcardOnly := &fedapi.ReplicaAllocationPreferences{
+		Clusters: map[string]fedapi.ClusterPreferences{
+			"*": {Weight: 1, MinReplicas: 1, MaxReplicas: &two},
+		},
+	}
+	explicit := &fedapi.ReplicaAllocationPreferences{
+		Clusters: map[string]fedapi.ClusterPreferences{
+			"one": {Weight: 3},
+			"*":   {Weight: 1},
+		},
+	}
+	capacity := map[string]int64{"one": 4000, "two": 2000, "three": 0}
+
+	reweighted := reweightByCapacity(wildcardOnly, capacity)
+	assert.Equal(t, int64(4000), reweighted.Clusters["one"].Weight)
+	assert.Equal(t, int64(2000), reweighted.Clusters["two"].Weight)
+	// Clusters without reported capacity keep falling through to the wildcard entry.
+	_, found := reweighted.Clusters["three"]
+	assert.False(t, found)
+	assert.Equal(t, wildcardOnly.Clusters["*"], reweighted.Clusters["*"])
+	assert.Equal(t, int64(1), reweighted.Clusters["one"].MinReplicas)
+	assert.Equal(t, &two, reweighted.Clusters["one"].MaxReplicas)
+
+	// Explicit per-cluster preferences are left untouched.
+	assert.Equal(t, explicit, reweightByCapacity(explicit, capacity))
+}
+
+func TestClustersAllocatableCapacity(t *testing.T) {
+	nodesByCluster := map[string]*apiv1.NodeList{
+		"one": {Items: []apiv1.Node{
+			*newNode("a", 2000, false),
+			*newNode("b", 1000, false),
+			*newNode("c", 4000, true),
+		}},
+		"two": {Items: []apiv1.Node{
+			*newNode("d", 500, false),
+		}},
+	}
+	nodesGetter := func(clusterName string) (*apiv1.NodeList, error) {
+		nodes, ok := nodesByCluster[clusterName]
+		if !ok {
+			return nil, fmt.Errorf("Not found")
+		}
+		return nodes, nil
+	}
+
+	capacity, err := clustersAllocatableCapacity([]string{"one", "two", "three"}, nodesGetter)
+	assert.Nil(t, err)
+	// Unschedulable nodes do not count towards capacity and unreachable
+	// clusters are left out of the result.
+	assert.Equal(t, map[string]int64{"one": 3000, "two": 500}, capacity)
+}
+
+func newNode(name string, milliCPU int64, unschedulable bool) *apiv1.Node {
+	return &apiv1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: apiv1.NodeSpec{
+			Unschedulable: unschedulable,
+		},
+		Status: apiv1.NodeStatus{
+			Allocatable: apiv1.ResourceList{
+				apiv1.ResourceCPU: *resource.NewMilliQuantity(milliCPU, resource.DecimalSI),
+			},
+		},
+	}
+}
@@ -44,8 +44,8 @@ import (
 	fedutil "k8s.io/kubernetes/federation/pkg/federation-controller/util"
 	"k8s.io/kubernetes/federation/pkg/federation-controller/util/deletionhelper"
 	"k8s.io/kubernetes/federation/pkg/federation-controller/util/eventsink"
+	"k8s.io/kubernetes/federation/pkg/federation-controller/util/placement"
 	"k8s.io/kubernetes/federation/pkg/federation-controller/util/planner"
-	"k8s.io/kubernetes/federation/pkg/federation-controller/util/replicapreferences"
 	"k8s.io/kubernetes/pkg/api"
 	kubeclientset "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
 	"k8s.io/kubernetes/pkg/controller"
@@ -155,7 +155,10 @@ func NewDeploymentController(federationClient fedclientset.Interface) *Deploymen
 			),
 		)
 	}
-	fdc.fedPodInformer = fedutil.NewFederatedInformer(federationClient, podFedInformerFactory, &fedutil.ClusterLifecycleHandlerFuncs{})
+	// Pod caches are only consulted while scheduling, so their informers are
+	// started lazily to keep the per-cluster memory footprint down.
+	fdc.fedPodInformer = fedutil.NewFederatedInformerWithOptions(federationClient, podFedInformerFactory, &fedutil.ClusterLifecycleHandlerFuncs{},
+		fedutil.FederatedInformerOptions{LazyStart: true})
 
 	fdc.deploymentStore, fdc.deploymentController = cache.NewInformer(
 		&cache.ListWatch{
@@ -413,7 +416,7 @@ func (fdc *DeploymentController) schedule(fd *extensionsv1.Deployment, clusters
 	// TODO: integrate real scheduler
 
 	plannerToBeUsed := fdc.defaultPlanner
-	fdPref, err := replicapreferences.GetAllocationPreferences(fd, FedDeploymentPreferencesAnnotation)
+	fdPref, err := placement.GetPreferences(fdc.fedClient, fd, "Deployment", FedDeploymentPreferencesAnnotation)
 	if err != nil {
 		glog.Info("Invalid Deployment specific preference, use default. deployment: %v, err: %v", fd.Name, err)
 	}
@@ -428,19 +431,8 @@ func (fdc *DeploymentController) schedule(fd *extensionsv1.Deployment, clusters
 	for _, cluster := range clusters {
 		clusterNames = append(clusterNames, cluster.Name)
 	}
-	scheduleResult, overflow := plannerToBeUsed.Plan(replicas, clusterNames, current, estimatedCapacity,
+	result, scheduleResult, overflow := placement.Plan(plannerToBeUsed, replicas, clusterNames, current, estimatedCapacity,
 		fd.Namespace+"/"+fd.Name)
-	// make sure the result contains all clusters that currently have some replicas.
-	result := make(map[string]int64)
-	for clusterName := range current {
-		result[clusterName] = 0
-	}
-	for clusterName, replicas := range scheduleResult {
-		result[clusterName] = replicas
-	}
-	for clusterName, replicas := range overflow {
-		result[clusterName] += replicas
-	}
 	if glog.V(4) {
 		buf := bytes.NewBufferString(fmt.Sprintf("Schedule - Deployment: %s/%s\n", fd.Namespace, fd.Name))
 		sort.Strings(clusterNames)
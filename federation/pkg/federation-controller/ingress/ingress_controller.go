@@ -90,6 +90,9 @@ type IngressController struct {
 	ingressFederatedInformer util.FederatedInformer
 	// Contains ingress controller configmaps present in members of federation.
 	configMapFederatedInformer util.FederatedInformer
+	// Contains endpoints present in members of federation, used to derive per-cluster
+	// backend weights for federated ingresses.
+	endpointFederatedInformer util.FederatedInformer
 	// For updating ingresses in members of federation.
 	federatedIngressUpdater util.FederatedUpdater
 	// For updating configmaps in members of federation.
@@ -230,6 +233,39 @@ func NewIngressController(client federationclientset.Interface) *IngressControll
 		},
 	)
 
+	// Federated informer on endpoints in members of the federation, used to derive
+	// per-cluster backend weights for federated ingresses.
+	ic.endpointFederatedInformer = util.NewFederatedInformer(
+		client,
+		func(cluster *federationapi.Cluster, targetClient kubeclientset.Interface) (cache.Store, cache.Controller) {
+			return cache.NewInformer(
+				&cache.ListWatch{
+					ListFunc: func(options metav1.ListOptions) (pkgruntime.Object, error) {
+						return targetClient.Core().Endpoints(metav1.NamespaceAll).List(options)
+					},
+					WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+						return targetClient.Core().Endpoints(metav1.NamespaceAll).Watch(options)
+					},
+				},
+				&v1.Endpoints{},
+				controller.NoResyncPeriodFunc(),
+				// Trigger reconciliation of the ingresses backed by the service whenever
+				// its endpoints in a federated cluster change, so the per-cluster weights
+				// track the healthy backends.
+				util.NewTriggerOnAllChanges(
+					func(obj pkgruntime.Object) {
+						ic.deliverIngressesForEndpoints(obj)
+					},
+				))
+		},
+
+		&util.ClusterLifecycleHandlerFuncs{
+			ClusterAvailable: func(cluster *federationapi.Cluster) {
+				ic.clusterDeliverer.DeliverAfter(cluster.Name, cluster, ic.clusterAvailableDelay)
+			},
+		},
+	)
+
 	// Federated ingress updater along with Create/Update/Delete operations.
 	ic.federatedIngressUpdater = util.NewFederatedUpdater(ic.ingressFederatedInformer, "ingress", ic.updateTimeout, ic.eventRecorder,
 		func(client kubeclientset.Interface, obj pkgruntime.Object) error {
@@ -318,12 +354,16 @@ func (ic *IngressController) Run(stopChan <-chan struct{}) {
 	ic.ingressFederatedInformer.Start()
 	glog.Infof("... Starting ConfigMap Federated Informer")
 	ic.configMapFederatedInformer.Start()
+	glog.Infof("... Starting Endpoint Federated Informer")
+	ic.endpointFederatedInformer.Start()
 	go func() {
 		<-stopChan
 		glog.Infof("Stopping Ingress Federated Informer")
 		ic.ingressFederatedInformer.Stop()
 		glog.Infof("Stopping ConfigMap Federated Informer")
 		ic.configMapFederatedInformer.Stop()
+		glog.Infof("Stopping Endpoint Federated Informer")
+		ic.endpointFederatedInformer.Stop()
 		glog.Infof("Stopping ingress deliverer")
 		ic.ingressDeliverer.Stop()
 		glog.Infof("Stopping configmap deliverer")
@@ -725,6 +765,13 @@ func (ic *IngressController) reconcileIngress(ingress types.NamespacedName) {
 		glog.V(4).Infof("Found %d ready clusters across which to reconcile ingress %q", len(clusters), ingress)
 	}
 
+	// Keep the per-cluster backend weights in step with the healthy endpoints in each
+	// cluster, so global DNS can be programmed proportionally. The annotation update
+	// triggers redelivery of the ingress.
+	if ic.reconcileIngressWeights(baseIngress, clusters) {
+		return
+	}
+
 	operations := make([]util.FederatedOperation, 0)
 
 	for _, cluster := range clusters {
@@ -0,0 +1,184 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"encoding/json"
+	"sort"
+
+	"k8s.io/api/core/v1"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	pkgruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	federationapi "k8s.io/kubernetes/federation/apis/federation/v1beta1"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// Annotation on the federated ingress in which the controller records the desired
+	// per-cluster backend weights, as a JSON map from cluster name to weight. Global
+	// DNS programmers consume it to split traffic in proportion to each cluster's
+	// healthy backend capacity.
+	ingressWeightsAnnotation = "ingress.federation.kubernetes.io/cluster-weights"
+	// The value the per-cluster weights add up to.
+	totalIngressWeight = 100
+)
+
+// ingressBackendServices returns the names of all services referenced by the ingress,
+// including the default backend and all rule backends, without duplicates.
+func ingressBackendServices(ingress *extensionsv1beta1.Ingress) []string {
+	seen := make(map[string]bool)
+	services := []string{}
+	addService := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			services = append(services, name)
+		}
+	}
+	if ingress.Spec.Backend != nil {
+		addService(ingress.Spec.Backend.ServiceName)
+	}
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			addService(path.Backend.ServiceName)
+		}
+	}
+	return services
+}
+
+// healthyEndpointCount returns the number of ready addresses in endpoints.
+func healthyEndpointCount(endpoints *v1.Endpoints) int {
+	count := 0
+	for _, subset := range endpoints.Subsets {
+		count += len(subset.Addresses)
+	}
+	return count
+}
+
+// computeClusterWeights converts per-cluster healthy endpoint counts into integer
+// weights that add up to totalIngressWeight. Clusters without healthy endpoints get
+// weight zero, so no traffic is routed to them. Rounding remainders are distributed
+// over the clusters in lexicographic order, so the result is deterministic.
+func computeClusterWeights(healthyEndpoints map[string]int) map[string]int {
+	weights := make(map[string]int, len(healthyEndpoints))
+	total := 0
+	for _, count := range healthyEndpoints {
+		total += count
+	}
+	if total == 0 {
+		for cluster := range healthyEndpoints {
+			weights[cluster] = 0
+		}
+		return weights
+	}
+	names := make([]string, 0, len(healthyEndpoints))
+	for cluster := range healthyEndpoints {
+		names = append(names, cluster)
+	}
+	sort.Strings(names)
+	assigned := 0
+	for _, cluster := range names {
+		weight := healthyEndpoints[cluster] * totalIngressWeight / total
+		weights[cluster] = weight
+		assigned += weight
+	}
+	for remainder := totalIngressWeight - assigned; remainder > 0; {
+		for _, cluster := range names {
+			if remainder == 0 {
+				break
+			}
+			if healthyEndpoints[cluster] > 0 {
+				weights[cluster]++
+				remainder--
+			}
+		}
+	}
+	return weights
+}
+
+// deliverIngressesForEndpoints triggers reconciliation of every federated ingress that
+// references the service backing the given endpoints object.
+func (ic *IngressController) deliverIngressesForEndpoints(obj pkgruntime.Object) {
+	endpoints := obj.(*v1.Endpoints)
+	for _, ingressObj := range ic.ingressInformerStore.List() {
+		ingress := ingressObj.(*extensionsv1beta1.Ingress)
+		if ingress.Namespace != endpoints.Namespace {
+			continue
+		}
+		for _, service := range ingressBackendServices(ingress) {
+			if service == endpoints.Name {
+				ic.deliverIngress(types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}, ic.ingressReviewDelay, false)
+				break
+			}
+		}
+	}
+}
+
+// clusterHealthyEndpoints returns, for each of the given clusters, the number of ready
+// endpoint addresses backing the services referenced by the ingress.
+func (ic *IngressController) clusterHealthyEndpoints(ingress *extensionsv1beta1.Ingress, clusters []*federationapi.Cluster) (map[string]int, error) {
+	services := ingressBackendServices(ingress)
+	result := make(map[string]int, len(clusters))
+	for _, cluster := range clusters {
+		count := 0
+		for _, service := range services {
+			key := types.NamespacedName{Namespace: ingress.Namespace, Name: service}.String()
+			endpointsObj, found, err := ic.endpointFederatedInformer.GetTargetStore().GetByKey(cluster.Name, key)
+			if err != nil {
+				return nil, err
+			}
+			if !found {
+				continue
+			}
+			count += healthyEndpointCount(endpointsObj.(*v1.Endpoints))
+		}
+		result[cluster.Name] = count
+	}
+	return result, nil
+}
+
+// reconcileIngressWeights updates the per-cluster weight annotation on the federated
+// ingress if the healthy backend distribution changed. It returns true if an update was
+// issued, in which case the caller should return and wait for redelivery.
+func (ic *IngressController) reconcileIngressWeights(baseIngress *extensionsv1beta1.Ingress, clusters []*federationapi.Cluster) bool {
+	// Weights are best effort: don't touch the annotation until the endpoint stores
+	// are synced, to avoid recording transient all-zero weights at startup.
+	if !ic.endpointFederatedInformer.GetTargetStore().ClustersSynced(clusters) {
+		glog.V(4).Infof("Endpoint stores not synced, skipping weight reconciliation for ingress %s/%s", baseIngress.Namespace, baseIngress.Name)
+		return false
+	}
+	healthyEndpoints, err := ic.clusterHealthyEndpoints(baseIngress, clusters)
+	if err != nil {
+		glog.Errorf("Failed to count healthy endpoints for ingress %s/%s: %v", baseIngress.Namespace, baseIngress.Name, err)
+		return false
+	}
+	weights := computeClusterWeights(healthyEndpoints)
+	weightsJSON, err := json.Marshal(weights)
+	if err != nil {
+		glog.Errorf("Failed to marshal cluster weights for ingress %s/%s: %v", baseIngress.Namespace, baseIngress.Name, err)
+		return false
+	}
+	if baseIngress.ObjectMeta.Annotations[ingressWeightsAnnotation] == string(weightsJSON) {
+		return false
+	}
+	ic.updateAnnotationOnIngress(baseIngress, ingressWeightsAnnotation, string(weightsJSON))
+	return true
+}
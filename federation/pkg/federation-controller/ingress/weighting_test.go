@@ -0,0 +1,120 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"testing"
+
+	"k8s.io/api/core/v1"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIngressBackendServices(t *testing.T) {
+	ingress := &extensionsv1beta1.Ingress{
+		Spec: extensionsv1beta1.IngressSpec{
+			Backend: &extensionsv1beta1.IngressBackend{ServiceName: "default-svc"},
+			Rules: []extensionsv1beta1.IngressRule{
+				{
+					IngressRuleValue: extensionsv1beta1.IngressRuleValue{
+						HTTP: &extensionsv1beta1.HTTPIngressRuleValue{
+							Paths: []extensionsv1beta1.HTTPIngressPath{
+								{Backend: extensionsv1beta1.IngressBackend{ServiceName: "rule-svc"}},
+								{Backend: extensionsv1beta1.IngressBackend{ServiceName: "default-svc"}},
+							},
+						},
+					},
+				},
+				{
+					// Rules without HTTP values should be skipped.
+					IngressRuleValue: extensionsv1beta1.IngressRuleValue{},
+				},
+			},
+		},
+	}
+	assert.Equal(t, []string{"default-svc", "rule-svc"}, ingressBackendServices(ingress))
+
+	empty := &extensionsv1beta1.Ingress{}
+	assert.Equal(t, []string{}, ingressBackendServices(empty))
+}
+
+func TestHealthyEndpointCount(t *testing.T) {
+	endpoints := &v1.Endpoints{
+		Subsets: []v1.EndpointSubset{
+			{
+				Addresses:         []v1.EndpointAddress{{IP: "10.0.0.1"}, {IP: "10.0.0.2"}},
+				NotReadyAddresses: []v1.EndpointAddress{{IP: "10.0.0.3"}},
+			},
+			{
+				Addresses: []v1.EndpointAddress{{IP: "10.0.1.1"}},
+			},
+		},
+	}
+	assert.Equal(t, 3, healthyEndpointCount(endpoints))
+	assert.Equal(t, 0, healthyEndpointCount(&v1.Endpoints{}))
+}
+
+func TestComputeClusterWeights(t *testing.T) {
+	tests := []struct {
+		name             string
+		healthyEndpoints map[string]int
+		expected         map[string]int
+	}{
+		{
+			name:             "even split",
+			healthyEndpoints: map[string]int{"cluster1": 5, "cluster2": 5},
+			expected:         map[string]int{"cluster1": 50, "cluster2": 50},
+		},
+		{
+			name:             "proportional split",
+			healthyEndpoints: map[string]int{"cluster1": 3, "cluster2": 1},
+			expected:         map[string]int{"cluster1": 75, "cluster2": 25},
+		},
+		{
+			name:             "remainder goes to lexicographically first clusters with endpoints",
+			healthyEndpoints: map[string]int{"cluster1": 1, "cluster2": 1, "cluster3": 1},
+			expected:         map[string]int{"cluster1": 34, "cluster2": 33, "cluster3": 33},
+		},
+		{
+			name:             "cluster without endpoints gets zero",
+			healthyEndpoints: map[string]int{"cluster1": 2, "cluster2": 0},
+			expected:         map[string]int{"cluster1": 100, "cluster2": 0},
+		},
+		{
+			name:             "no healthy endpoints anywhere",
+			healthyEndpoints: map[string]int{"cluster1": 0, "cluster2": 0},
+			expected:         map[string]int{"cluster1": 0, "cluster2": 0},
+		},
+		{
+			name:             "no clusters",
+			healthyEndpoints: map[string]int{},
+			expected:         map[string]int{},
+		},
+	}
+	for _, test := range tests {
+		weights := computeClusterWeights(test.healthyEndpoints)
+		assert.Equal(t, test.expected, weights, "Test %q", test.name)
+		total := 0
+		for _, weight := range weights {
+			total += weight
+		}
+		if total != 0 {
+			assert.Equal(t, totalIngressWeight, total, "Test %q: weights should add up to the total", test.name)
+		}
+	}
+}
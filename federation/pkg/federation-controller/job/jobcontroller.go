@@ -466,16 +466,17 @@ func (fjc *FederationJobController) reconcileJob(key string) (reconciliationStat
 			}
 
 			// collect local job status
-			for _, condition := range currentLjob.Status.Conditions {
+			for i := range currentLjob.Status.Conditions {
+				condition := &currentLjob.Status.Conditions[i]
 				if condition.Type == batchv1.JobComplete {
 					if fedStatusCompleteCondition == nil ||
 						fedStatusCompleteCondition.LastTransitionTime.Before(&condition.LastTransitionTime) {
-						fedStatusCompleteCondition = &condition
+						fedStatusCompleteCondition = condition
 					}
 				} else if condition.Type == batchv1.JobFailed {
 					if fedStatusFailedCondition == nil ||
 						fedStatusFailedCondition.LastTransitionTime.Before(&condition.LastTransitionTime) {
-						fedStatusFailedCondition = &condition
+						fedStatusFailedCondition = condition
 					}
 				}
 			}
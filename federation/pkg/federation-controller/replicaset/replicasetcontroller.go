@@ -41,9 +41,9 @@ import (
 	fedutil "k8s.io/kubernetes/federation/pkg/federation-controller/util"
 	"k8s.io/kubernetes/federation/pkg/federation-controller/util/deletionhelper"
 	"k8s.io/kubernetes/federation/pkg/federation-controller/util/eventsink"
+	"k8s.io/kubernetes/federation/pkg/federation-controller/util/placement"
 	"k8s.io/kubernetes/federation/pkg/federation-controller/util/planner"
 	"k8s.io/kubernetes/federation/pkg/federation-controller/util/podanalyzer"
-	"k8s.io/kubernetes/federation/pkg/federation-controller/util/replicapreferences"
 	"k8s.io/kubernetes/pkg/api"
 	apiv1 "k8s.io/kubernetes/pkg/api/v1"
 	extensionsv1 "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
@@ -157,7 +157,10 @@ func NewReplicaSetController(federationClient fedclientset.Interface) *ReplicaSe
 			),
 		)
 	}
-	frsc.fedPodInformer = fedutil.NewFederatedInformer(federationClient, podFedInformerFactory, &fedutil.ClusterLifecycleHandlerFuncs{})
+	// Pod caches are only consulted while scheduling, so their informers are
+	// started lazily to keep the per-cluster memory footprint down.
+	frsc.fedPodInformer = fedutil.NewFederatedInformerWithOptions(federationClient, podFedInformerFactory, &fedutil.ClusterLifecycleHandlerFuncs{},
+		fedutil.FederatedInformerOptions{LazyStart: true})
 	frsc.replicaSetStore, frsc.replicaSetController = cache.NewInformer(
 		&cache.ListWatch{
 			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
@@ -337,7 +340,7 @@ func (frsc *ReplicaSetController) schedule(frs *extensionsv1.ReplicaSet, cluster
 	// TODO: integrate real scheduler
 
 	plnr := frsc.defaultPlanner
-	frsPref, err := replicapreferences.GetAllocationPreferences(frs, FedReplicaSetPreferencesAnnotation)
+	frsPref, err := placement.GetPreferences(frsc.fedClient, frs, "ReplicaSet", FedReplicaSetPreferencesAnnotation)
 	if err != nil {
 		glog.Info("Invalid ReplicaSet specific preference, use default. rs: %v, err: %v", frs, err)
 	}
@@ -350,19 +353,8 @@ func (frsc *ReplicaSetController) schedule(frs *extensionsv1.ReplicaSet, cluster
 	for _, cluster := range clusters {
 		clusterNames = append(clusterNames, cluster.Name)
 	}
-	scheduleResult, overflow := plnr.Plan(replicas, clusterNames, current, estimatedCapacity,
+	result, scheduleResult, overflow := placement.Plan(plnr, replicas, clusterNames, current, estimatedCapacity,
 		frs.Namespace+"/"+frs.Name)
-	// make sure the return contains clusters need to zero the replicas
-	result := make(map[string]int64)
-	for clusterName := range current {
-		result[clusterName] = 0
-	}
-	for clusterName, replicas := range scheduleResult {
-		result[clusterName] = replicas
-	}
-	for clusterName, replicas := range overflow {
-		result[clusterName] += replicas
-	}
 	if glog.V(4) {
 		buf := bytes.NewBufferString(fmt.Sprintf("Schedule - ReplicaSet: %s/%s\n", frs.Namespace, frs.Name))
 		sort.Strings(clusterNames)
@@ -25,6 +25,7 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	kubeclientset "k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
@@ -33,13 +34,28 @@ import (
 	federationclientset "k8s.io/kubernetes/federation/client/clientset_generated/federation_clientset"
 
 	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 const (
-	clusterSyncPeriod = 10 * time.Minute
-	userAgentName     = "federation-controller"
+	clusterSyncPeriod  = 10 * time.Minute
+	cacheMetricsPeriod = 1 * time.Minute
+	userAgentName      = "federation-controller"
 )
 
+// clusterCacheObjectCount reports the number of objects held in the target
+// informer cache for each member cluster, as a cheap proxy for the memory
+// the cache consumes.
+var clusterCacheObjectCount = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "federation_informer_cluster_cache_object_count",
+		Help: "Number of objects held in the target informer cache of a member cluster.",
+	},
+	[]string{"cluster"},
+)
+
+var registerCacheMetricsOnce sync.Once
+
 // An object with an origin information.
 type FederatedObject struct {
 	Object      interface{}
@@ -131,14 +147,47 @@ type ClusterLifecycleHandlerFuncs struct {
 	ClusterUnavailable func(*federationapi.Cluster, []interface{})
 }
 
+// FederatedInformerOptions tune how a FederatedInformer runs its informers.
+type FederatedInformerOptions struct {
+	// ClusterSyncPeriod overrides the resync period of the informer watching
+	// the federated cluster list. Zero means the default of 10 minutes.
+	ClusterSyncPeriod time.Duration
+
+	// LazyStart delays creating the target informer of each cluster until the
+	// target store is accessed for the first time. Controllers that rarely look
+	// at member cluster state do not pay the memory cost of the caches until
+	// they actually need them.
+	LazyStart bool
+}
+
 // Builds a FederatedInformer for the given federation client and factory.
 func NewFederatedInformer(
 	federationClient federationclientset.Interface,
 	targetInformerFactory TargetInformerFactory,
 	clusterLifecycle *ClusterLifecycleHandlerFuncs) FederatedInformer {
+	return NewFederatedInformerWithOptions(federationClient, targetInformerFactory, clusterLifecycle, FederatedInformerOptions{})
+}
+
+// Builds a FederatedInformer for the given federation client and factory with
+// the given options.
+func NewFederatedInformerWithOptions(
+	federationClient federationclientset.Interface,
+	targetInformerFactory TargetInformerFactory,
+	clusterLifecycle *ClusterLifecycleHandlerFuncs,
+	options FederatedInformerOptions) FederatedInformer {
+
+	registerCacheMetricsOnce.Do(func() {
+		prometheus.MustRegister(clusterCacheObjectCount)
+	})
+
+	syncPeriod := options.ClusterSyncPeriod
+	if syncPeriod == 0 {
+		syncPeriod = clusterSyncPeriod
+	}
 
 	federatedInformer := &federatedInformerImpl{
 		targetInformerFactory: targetInformerFactory,
+		lazyStart:             options.LazyStart,
 		clientFactory: func(cluster *federationapi.Cluster) (kubeclientset.Interface, error) {
 			clusterConfig, err := BuildClusterConfig(cluster)
 			if err == nil && clusterConfig != nil {
@@ -148,6 +197,7 @@ func NewFederatedInformer(
 			return nil, err
 		},
 		targetInformers: make(map[string]informer),
+		pendingClusters: make(map[string]*federationapi.Cluster),
 	}
 
 	getClusterData := func(name string) []interface{} {
@@ -169,7 +219,7 @@ func NewFederatedInformer(
 			},
 		},
 		&federationapi.Cluster{},
-		clusterSyncPeriod,
+		syncPeriod,
 		cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(old interface{}) {
 				oldCluster, ok := old.(*federationapi.Cluster)
@@ -260,6 +310,13 @@ type federatedInformerImpl struct {
 	// Structures returned by targetInformerFactory
 	targetInformers map[string]informer
 
+	// Whether target informers are started only on first target store access.
+	lazyStart bool
+
+	// Ready clusters whose target informer has not been started yet because
+	// of lazyStart.
+	pendingClusters map[string]*federationapi.Cluster
+
 	// A function to build clients.
 	clientFactory func(*federationapi.Cluster) (kubeclientset.Interface, error)
 }
@@ -278,6 +335,7 @@ func (f *federatedInformerImpl) Stop() {
 
 	glog.V(4).Infof("... Closing cluster informer channel.")
 	close(f.clusterInformer.stopChan)
+	f.pendingClusters = make(map[string]*federationapi.Cluster)
 	for key, informer := range f.targetInformers {
 		glog.V(4).Infof("... Closing informer channel for %q.", key)
 		close(informer.stopChan)
@@ -294,6 +352,17 @@ func (f *federatedInformerImpl) Start() {
 
 	f.clusterInformer.stopChan = make(chan struct{})
 	go f.clusterInformer.controller.Run(f.clusterInformer.stopChan)
+	go wait.Until(f.updateCacheMetrics, cacheMetricsPeriod, f.clusterInformer.stopChan)
+}
+
+// updateCacheMetrics publishes the current object count of every running
+// target informer cache.
+func (f *federatedInformerImpl) updateCacheMetrics() {
+	f.Lock()
+	defer f.Unlock()
+	for name, targetInformer := range f.targetInformers {
+		clusterCacheObjectCount.WithLabelValues(name).Set(float64(len(targetInformer.store.ListKeys())))
+	}
 }
 
 func (f *federatedInformerImpl) SetClientFactory(clientFactory func(*federationapi.Cluster) (kubeclientset.Interface, error)) {
@@ -393,6 +462,16 @@ func (f *federatedInformerImpl) ClustersSynced() bool {
 func (f *federatedInformerImpl) addCluster(cluster *federationapi.Cluster) {
 	f.Lock()
 	defer f.Unlock()
+	if f.lazyStart {
+		// The target informer is built on the first target store access.
+		f.pendingClusters[cluster.Name] = cluster
+		return
+	}
+	f.startTargetInformerUnlocked(cluster)
+}
+
+// Builds and runs the target informer for the given cluster.
+func (f *federatedInformerImpl) startTargetInformerUnlocked(cluster *federationapi.Cluster) {
 	name := cluster.Name
 	if client, err := f.getClientsetForClusterUnlocked(name); err == nil {
 		store, controller := f.targetInformerFactory(cluster, client)
@@ -409,15 +488,35 @@ func (f *federatedInformerImpl) addCluster(cluster *federationapi.Cluster) {
 	}
 }
 
+// Starts the target informer for the given cluster if its start was delayed
+// by the LazyStart option.
+func (f *federatedInformerImpl) ensureTargetInformerUnlocked(clusterName string) {
+	if cluster, found := f.pendingClusters[clusterName]; found {
+		f.startTargetInformerUnlocked(cluster)
+		delete(f.pendingClusters, clusterName)
+	}
+}
+
+// Starts the target informers of all clusters whose start was delayed by the
+// LazyStart option.
+func (f *federatedInformerImpl) ensureAllTargetInformersUnlocked() {
+	for name, cluster := range f.pendingClusters {
+		f.startTargetInformerUnlocked(cluster)
+		delete(f.pendingClusters, name)
+	}
+}
+
 // Removes the cluster from federated informer.
 func (f *federatedInformerImpl) deleteCluster(cluster *federationapi.Cluster) {
 	f.Lock()
 	defer f.Unlock()
 	name := cluster.Name
+	delete(f.pendingClusters, name)
 	if targetInformer, found := f.targetInformers[name]; found {
 		close(targetInformer.stopChan)
 	}
 	delete(f.targetInformers, name)
+	clusterCacheObjectCount.DeleteLabelValues(name)
 }
 
 // Returns a store created over all stores from target informers.
@@ -431,6 +530,7 @@ func (f *federatedInformerImpl) GetTargetStore() FederatedReadOnlyStore {
 func (fs *federatedStoreImpl) List() ([]FederatedObject, error) {
 	fs.federatedInformer.Lock()
 	defer fs.federatedInformer.Unlock()
+	fs.federatedInformer.ensureAllTargetInformersUnlocked()
 
 	result := make([]FederatedObject, 0)
 	for clusterName, targetInformer := range fs.federatedInformer.targetInformers {
@@ -445,6 +545,7 @@ func (fs *federatedStoreImpl) List() ([]FederatedObject, error) {
 func (fs *federatedStoreImpl) ListFromCluster(clusterName string) ([]interface{}, error) {
 	fs.federatedInformer.Lock()
 	defer fs.federatedInformer.Unlock()
+	fs.federatedInformer.ensureTargetInformerUnlocked(clusterName)
 
 	result := make([]interface{}, 0)
 	if targetInformer, found := fs.federatedInformer.targetInformers[clusterName]; found {
@@ -458,6 +559,7 @@ func (fs *federatedStoreImpl) ListFromCluster(clusterName string) ([]interface{}
 func (fs *federatedStoreImpl) GetByKey(clusterName string, key string) (interface{}, bool, error) {
 	fs.federatedInformer.Lock()
 	defer fs.federatedInformer.Unlock()
+	fs.federatedInformer.ensureTargetInformerUnlocked(clusterName)
 	if targetInformer, found := fs.federatedInformer.targetInformers[clusterName]; found {
 		return targetInformer.store.GetByKey(key)
 	}
@@ -468,6 +570,7 @@ func (fs *federatedStoreImpl) GetByKey(clusterName string, key string) (interfac
 func (fs *federatedStoreImpl) GetFromAllClusters(key string) ([]FederatedObject, error) {
 	fs.federatedInformer.Lock()
 	defer fs.federatedInformer.Unlock()
+	fs.federatedInformer.ensureAllTargetInformersUnlocked()
 
 	result := make([]FederatedObject, 0)
 	for clusterName, targetInformer := range fs.federatedInformer.targetInformers {
@@ -497,6 +600,7 @@ func (fs *federatedStoreImpl) ClustersSynced(clusters []*federationapi.Cluster)
 	okSoFar, informersToCheck := func() (bool, []informer) {
 		fs.federatedInformer.Lock()
 		defer fs.federatedInformer.Unlock()
+		fs.federatedInformer.ensureAllTargetInformersUnlocked()
 
 		if len(fs.federatedInformer.targetInformers) != len(clusters) {
 			return false, []informer{}
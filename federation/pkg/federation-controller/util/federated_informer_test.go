@@ -148,3 +148,86 @@ func TestFederatedInformer(t *testing.T) {
 	// Test complete.
 	informer.Stop()
 }
+
+// Checks that with LazyStart the target informer of a ready cluster is only
+// created once the target store is accessed.
+func TestFederatedInformerLazyStart(t *testing.T) {
+	fakeFederationClient := &fakefederationclientset.Clientset{}
+
+	cluster := federationapi.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "mycluster",
+		},
+		Status: federationapi.ClusterStatus{
+			Conditions: []federationapi.ClusterCondition{
+				{Type: federationapi.ClusterReady, Status: apiv1.ConditionTrue},
+			},
+		},
+	}
+	fakeFederationClient.AddReactor("list", "clusters", func(action core.Action) (bool, runtime.Object, error) {
+		return true, &federationapi.ClusterList{Items: []federationapi.Cluster{cluster}}, nil
+	})
+	fakeFederationClient.AddWatchReactor("clusters", func(action core.Action) (bool, watch.Interface, error) {
+		return true, watch.NewFake(), nil
+	})
+
+	fakeKubeClient := &fakekubeclientset.Clientset{}
+	service := apiv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns1",
+			Name:      "s1",
+		},
+	}
+	fakeKubeClient.AddReactor("list", "services", func(action core.Action) (bool, runtime.Object, error) {
+		return true, &apiv1.ServiceList{Items: []apiv1.Service{service}}, nil
+	})
+	fakeKubeClient.AddWatchReactor("services", func(action core.Action) (bool, watch.Interface, error) {
+		return true, watch.NewFake(), nil
+	})
+
+	targetInformerFactory := func(cluster *federationapi.Cluster, clientset kubeclientset.Interface) (cache.Store, cache.Controller) {
+		return cache.NewInformer(
+			&cache.ListWatch{
+				ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+					return clientset.Core().Services(metav1.NamespaceAll).List(options)
+				},
+				WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+					return clientset.Core().Services(metav1.NamespaceAll).Watch(options)
+				},
+			},
+			&apiv1.Service{},
+			10*time.Second,
+			cache.ResourceEventHandlerFuncs{})
+	}
+
+	informer := NewFederatedInformerWithOptions(fakeFederationClient, targetInformerFactory, &ClusterLifecycleHandlerFuncs{},
+		FederatedInformerOptions{LazyStart: true}).(*federatedInformerImpl)
+	informer.clientFactory = func(cluster *federationapi.Cluster) (kubeclientset.Interface, error) {
+		return fakeKubeClient, nil
+	}
+	informer.Start()
+	defer informer.Stop()
+
+	// Wait until the cluster is ready, the target informer should still be pending.
+	for {
+		readyClusters, err := informer.GetReadyClusters()
+		assert.NoError(t, err)
+		if len(readyClusters) > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+	informer.Lock()
+	assert.Empty(t, informer.targetInformers)
+	assert.Contains(t, informer.pendingClusters, "mycluster")
+	informer.Unlock()
+
+	// The first target store access starts the informer.
+	for !informer.GetTargetStore().ClustersSynced([]*federationapi.Cluster{&cluster}) {
+		time.Sleep(time.Millisecond * 100)
+	}
+	service1, found, err := informer.GetTargetStore().GetByKey("mycluster", "ns1/s1")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.EqualValues(t, &service, service1)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package placement computes per-cluster replica placements for federated workloads. It
+// resolves placement preferences from ReplicaSchedulingPreference API objects, falling
+// back to the legacy per-type annotations, and turns them into replica distributions
+// over the ready clusters.
+package placement
+
+import (
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fed "k8s.io/kubernetes/federation/apis/federation"
+	fedv1beta1 "k8s.io/kubernetes/federation/apis/federation/v1beta1"
+	fedclientset "k8s.io/kubernetes/federation/client/clientset_generated/federation_clientset"
+	"k8s.io/kubernetes/federation/pkg/federation-controller/util/planner"
+	"k8s.io/kubernetes/federation/pkg/federation-controller/util/replicapreferences"
+)
+
+// GetPreferences returns the replica allocation preferences for the given federated
+// workload. A ReplicaSchedulingPreference object with the same name and namespace as the
+// workload and a matching target kind takes precedence; the legacy per-type annotation
+// is used as a fallback so that existing workloads keep scheduling as before. Returns
+// nil if neither is present.
+func GetPreferences(fedClient fedclientset.Interface, obj runtime.Object, targetKind, annotationKey string) (*fed.ReplicaAllocationPreferences, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := fedClient.Federation().ReplicaSchedulingPreferences(accessor.GetNamespace()).Get(accessor.GetName(), metav1.GetOptions{})
+	if err == nil {
+		if rsp.Spec.TargetKind == targetKind {
+			return PreferencesFromSpec(&rsp.Spec), nil
+		}
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	return replicapreferences.GetAllocationPreferences(obj, annotationKey)
+}
+
+// PreferencesFromSpec converts a ReplicaSchedulingPreference spec into the allocation
+// preferences consumed by the planner.
+func PreferencesFromSpec(spec *fedv1beta1.ReplicaSchedulingPreferenceSpec) *fed.ReplicaAllocationPreferences {
+	pref := &fed.ReplicaAllocationPreferences{
+		Rebalance: spec.Rebalance,
+	}
+	if spec.Clusters != nil {
+		pref.Clusters = make(map[string]fed.ClusterPreferences, len(spec.Clusters))
+		for cluster, clusterPref := range spec.Clusters {
+			pref.Clusters[cluster] = fed.ClusterPreferences{
+				MinReplicas: clusterPref.MinReplicas,
+				MaxReplicas: clusterPref.MaxReplicas,
+				Weight:      clusterPref.Weight,
+			}
+		}
+	}
+	return pref
+}
+
+// Plan distributes the replicas of a federated workload over the given (ready) clusters.
+// Clusters that currently run replicas but are not in clusterNames - typically because
+// they are no longer ready - get an explicit zero in the result, so their replicas are
+// rescheduled onto the remaining clusters. Returns the final distribution together with
+// the raw planner result and overflow, which callers may use for logging.
+func Plan(plnr *planner.Planner, replicas int64, clusterNames []string, current, estimatedCapacity map[string]int64,
+	key string) (map[string]int64, map[string]int64, map[string]int64) {
+	scheduleResult, overflow := plnr.Plan(replicas, clusterNames, current, estimatedCapacity, key)
+	result := make(map[string]int64)
+	for clusterName := range current {
+		result[clusterName] = 0
+	}
+	for clusterName, replicas := range scheduleResult {
+		result[clusterName] = replicas
+	}
+	for clusterName, replicas := range overflow {
+		result[clusterName] += replicas
+	}
+	return result, scheduleResult, overflow
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"testing"
+
+	extensionsv1 "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fed "k8s.io/kubernetes/federation/apis/federation"
+	fedv1beta1 "k8s.io/kubernetes/federation/apis/federation/v1beta1"
+	fakefedclientset "k8s.io/kubernetes/federation/client/clientset_generated/federation_clientset/fake"
+	"k8s.io/kubernetes/federation/pkg/federation-controller/util/planner"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAnnotation = "federation.kubernetes.io/replica-set-preferences"
+
+func newReplicaSet(name, namespace string, annotations map[string]string) *extensionsv1.ReplicaSet {
+	return &extensionsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Annotations: annotations,
+		},
+	}
+}
+
+func TestGetPreferencesFromAPIObject(t *testing.T) {
+	rsp := &fedv1beta1.ReplicaSchedulingPreference{
+		ObjectMeta: metav1.ObjectMeta{Name: "rs", Namespace: "ns"},
+		Spec: fedv1beta1.ReplicaSchedulingPreferenceSpec{
+			TargetKind: "ReplicaSet",
+			Rebalance:  true,
+			Clusters: map[string]fedv1beta1.ClusterPreferences{
+				"cluster1": {MinReplicas: 2, Weight: 3},
+			},
+		},
+	}
+	fedClient := fakefedclientset.NewSimpleClientset(rsp)
+
+	// The API object wins even when the annotation is present.
+	frs := newReplicaSet("rs", "ns", map[string]string{testAnnotation: `{"clusters": {"cluster2": {"weight": 1}}}`})
+	pref, err := GetPreferences(fedClient, frs, "ReplicaSet", testAnnotation)
+	require.NoError(t, err)
+	require.NotNil(t, pref)
+	assert.True(t, pref.Rebalance)
+	assert.Equal(t, int64(3), pref.Clusters["cluster1"].Weight)
+	assert.NotContains(t, pref.Clusters, "cluster2")
+}
+
+func TestGetPreferencesTargetKindMismatch(t *testing.T) {
+	rsp := &fedv1beta1.ReplicaSchedulingPreference{
+		ObjectMeta: metav1.ObjectMeta{Name: "rs", Namespace: "ns"},
+		Spec: fedv1beta1.ReplicaSchedulingPreferenceSpec{
+			TargetKind: "Deployment",
+		},
+	}
+	fedClient := fakefedclientset.NewSimpleClientset(rsp)
+
+	// A preference for another kind is ignored and the annotation is used.
+	frs := newReplicaSet("rs", "ns", map[string]string{testAnnotation: `{"clusters": {"cluster2": {"weight": 1}}}`})
+	pref, err := GetPreferences(fedClient, frs, "ReplicaSet", testAnnotation)
+	require.NoError(t, err)
+	require.NotNil(t, pref)
+	assert.Equal(t, int64(1), pref.Clusters["cluster2"].Weight)
+}
+
+func TestGetPreferencesAnnotationFallback(t *testing.T) {
+	fedClient := fakefedclientset.NewSimpleClientset()
+
+	frs := newReplicaSet("rs", "ns", map[string]string{testAnnotation: `{"rebalance": true}`})
+	pref, err := GetPreferences(fedClient, frs, "ReplicaSet", testAnnotation)
+	require.NoError(t, err)
+	require.NotNil(t, pref)
+	assert.True(t, pref.Rebalance)
+
+	// Neither preference object nor annotation.
+	pref, err = GetPreferences(fedClient, newReplicaSet("rs", "ns", nil), "ReplicaSet", testAnnotation)
+	require.NoError(t, err)
+	assert.Nil(t, pref)
+}
+
+func TestPreferencesFromSpec(t *testing.T) {
+	maxReplicas := int64(5)
+	spec := &fedv1beta1.ReplicaSchedulingPreferenceSpec{
+		TargetKind: "ReplicaSet",
+		Rebalance:  true,
+		Clusters: map[string]fedv1beta1.ClusterPreferences{
+			"cluster1": {MinReplicas: 1, MaxReplicas: &maxReplicas, Weight: 2},
+		},
+	}
+	pref := PreferencesFromSpec(spec)
+	assert.Equal(t, &fed.ReplicaAllocationPreferences{
+		Rebalance: true,
+		Clusters: map[string]fed.ClusterPreferences{
+			"cluster1": {MinReplicas: 1, MaxReplicas: &maxReplicas, Weight: 2},
+		},
+	}, pref)
+}
+
+func TestPlanZeroesUnavailableClusters(t *testing.T) {
+	plnr := planner.NewPlanner(&fed.ReplicaAllocationPreferences{
+		Clusters: map[string]fed.ClusterPreferences{
+			"*": {Weight: 1},
+		},
+	})
+	// cluster2 runs replicas but is no longer among the available clusters, so its
+	// replicas must be explicitly zeroed and rescheduled.
+	current := map[string]int64{"cluster1": 4, "cluster2": 4}
+	result, _, _ := Plan(plnr, 8, []string{"cluster1", "cluster3"}, current, nil, "ns/rs")
+
+	total := int64(0)
+	for _, replicas := range result {
+		total += replicas
+	}
+	assert.Equal(t, int64(8), total)
+	assert.Equal(t, int64(0), result["cluster2"])
+}
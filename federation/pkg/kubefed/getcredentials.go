@@ -0,0 +1,193 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubefed
+
+import (
+	"fmt"
+	"io"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	federationapi "k8s.io/kubernetes/federation/apis/federation/v1beta1"
+	"k8s.io/kubernetes/federation/pkg/kubefed/util"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var (
+	getCredentials_long = templates.LongDesc(`
+		Export the credentials of a cluster joined to a federation
+		as a kubeconfig written to standard output.
+
+        Current context is assumed to be a federation API
+        server. Please use the --context flag otherwise.`)
+	getCredentials_example = templates.Examples(`
+		# Export the read-only credentials of the cluster named
+		# foo for use by audit tooling. The read-only credentials
+		# exist only if the cluster was joined with
+		# --read-only-credentials.
+		kubefed get-credentials foo --host-cluster-context=bar --read-only`)
+)
+
+type getCredentials struct {
+	commonOptions util.SubcommandOptions
+	options       getCredentialsOptions
+}
+
+type getCredentialsOptions struct {
+	readOnly bool
+}
+
+func (o *getCredentialsOptions) Bind(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.readOnly, "read-only", false, "Export the read-only credentials created by `kubefed join --read-only-credentials` instead of the credentials used by the federation control plane.")
+}
+
+// NewCmdGetCredentials defines the `get-credentials` command that
+// exports the credentials of a joined cluster as a kubeconfig.
+func NewCmdGetCredentials(f cmdutil.Factory, cmdOut io.Writer, config util.AdminConfig) *cobra.Command {
+	opts := &getCredentials{}
+
+	cmd := &cobra.Command{
+		Use:     "get-credentials CLUSTER_NAME --host-cluster-context=HOST_CONTEXT",
+		Short:   "Export the credentials of a joined cluster",
+		Long:    getCredentials_long,
+		Example: getCredentials_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(opts.commonOptions.SetName(cmd, args))
+			cmdutil.CheckErr(opts.Run(f, cmdOut, config))
+		},
+	}
+
+	flags := cmd.Flags()
+	opts.commonOptions.Bind(flags)
+	opts.options.Bind(flags)
+
+	return cmd
+}
+
+// Run is the implementation of the `get-credentials` command.
+func (g *getCredentials) Run(f cmdutil.Factory, cmdOut io.Writer, config util.AdminConfig) error {
+	cluster, err := getCluster(f, g.commonOptions.Name)
+	if err != nil {
+		return err
+	}
+
+	// We want a separate client factory to communicate with the
+	// federation host cluster. See join_federation.go for details.
+	hostFactory := config.ClusterFactory(g.commonOptions.Host, g.commonOptions.Kubeconfig)
+	hostClientset, err := hostFactory.ClientSet()
+	if err != nil {
+		return err
+	}
+
+	secretName := cluster.Spec.SecretRef.Name
+	if g.options.readOnly {
+		secretName = util.ClusterReadOnlySecretName(g.commonOptions.Name)
+	}
+	secret, err := hostClientset.Core().Secrets(g.commonOptions.FederationSystemNamespace).Get(secretName, metav1.GetOptions{})
+	if isNotFound(err) {
+		if g.options.readOnly {
+			return fmt.Errorf("read-only credentials secret %q not found in the host cluster; was the cluster joined with --read-only-credentials?", secretName)
+		}
+		return fmt.Errorf("credentials secret %q not found in the host cluster", secretName)
+	} else if err != nil {
+		return err
+	}
+
+	serverAddress, err := util.GetServerAddress(cluster)
+	if err != nil {
+		return err
+	}
+
+	data, err := kubeconfigFromSecret(secret, g.commonOptions.Name, serverAddress)
+	if err != nil {
+		return err
+	}
+	_, err = cmdOut.Write(data)
+	return err
+}
+
+// kubeconfigFromSecret serializes the credentials held in a cluster
+// credentials secret into a kubeconfig. Pre-1.7 secrets hold a serialized
+// kubeconfig which is returned as is; newer secrets hold service account
+// credentials from which a kubeconfig is built.
+func kubeconfigFromSecret(secret *api.Secret, clusterName, serverAddress string) ([]byte, error) {
+	if data, ok := secret.Data[util.KubeconfigSecretDataKey]; ok {
+		return data, nil
+	}
+
+	token, tokenFound := secret.Data["token"]
+	ca, caFound := secret.Data["ca.crt"]
+	if !tokenFound || !caFound {
+		return nil, fmt.Errorf("secret %q has values for neither %q nor both 'ca.crt' and 'token' in its data", secret.Name, util.KubeconfigSecretDataKey)
+	}
+
+	kubeconfig := clientcmdapi.NewConfig()
+	kubeconfig.Clusters[clusterName] = &clientcmdapi.Cluster{
+		Server:                   serverAddress,
+		CertificateAuthorityData: ca,
+	}
+	kubeconfig.AuthInfos[clusterName] = &clientcmdapi.AuthInfo{
+		Token: string(token),
+	}
+	kubeconfig.Contexts[clusterName] = &clientcmdapi.Context{
+		Cluster:  clusterName,
+		AuthInfo: clusterName,
+	}
+	kubeconfig.CurrentContext = clusterName
+
+	return clientcmd.Write(*kubeconfig)
+}
+
+// getCluster fetches the cluster object with the given name from the
+// federation API server.
+func getCluster(f cmdutil.Factory, name string) (*federationapi.Cluster, error) {
+	mapper, typer := f.Object()
+	gvks, _, err := typer.ObjectKinds(&federationapi.Cluster{})
+	if err != nil {
+		return nil, err
+	}
+	gvk := gvks[0]
+	mapping, err := mapper.RESTMapping(schema.GroupKind{Group: gvk.Group, Kind: gvk.Kind}, gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+	client, err := f.ClientForMapping(mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	rh := resource.NewHelper(client, mapping)
+	obj, err := rh.Get("", name, false)
+	if isNotFound(err) {
+		return nil, fmt.Errorf("cluster %q not found in federation", name)
+	} else if err != nil {
+		return nil, err
+	}
+	cluster, ok := obj.(*federationapi.Cluster)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type: expected \"federation/v1beta1.Cluster\", got %T: obj: %#v", obj, obj)
+	}
+	return cluster, nil
+}
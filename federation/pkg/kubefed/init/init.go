@@ -47,6 +47,7 @@ import (
 	"k8s.io/kubernetes/federation/pkg/kubefed/util"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/apis/networking"
 	"k8s.io/kubernetes/pkg/apis/rbac"
 	client "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
@@ -158,6 +159,7 @@ type initFederationOptions struct {
 	apiServerEnableTokenAuth         bool
 	nodeSelector                     map[string]string
 	nodeSelectorString               string
+	harden                           bool
 }
 
 func (o *initFederationOptions) Bind(flags *pflag.FlagSet, defaultServerImage, defaultEtcdImage string) {
@@ -180,6 +182,7 @@ func (o *initFederationOptions) Bind(flags *pflag.FlagSet, defaultServerImage, d
 	flags.BoolVar(&o.apiServerEnableHTTPBasicAuth, "apiserver-enable-basic-auth", false, "Enables HTTP Basic authentication for the federation-apiserver. Defaults to false.")
 	flags.BoolVar(&o.apiServerEnableTokenAuth, "apiserver-enable-token-auth", false, "Enables token authentication for the federation-apiserver. Defaults to false.")
 	flags.StringVar(&o.nodeSelectorString, "node-selector", "", "comma separated list of nodeSelector arguments: Example \"arg1=value1,arg2=value2...\"")
+	flags.BoolVar(&o.harden, "harden", false, "Create a default-deny NetworkPolicy for the federation namespace and run the federation control plane containers with restrictive security contexts. Defaults to false.")
 }
 
 // NewCmdInit defines the `init` command that bootstraps a federation
@@ -224,6 +227,18 @@ func (i *initFederation) Complete(cmd *cobra.Command, args []string) error {
 	if len(i.options.dnsProvider) == 0 {
 		return fmt.Errorf("--dns-provider is mandatory")
 	}
+	switch i.options.dnsProvider {
+	case util.FedDNSProviderCoreDNS:
+		// The coredns provider programs records through an etcd backend and
+		// cannot guess its endpoints; a config file is required.
+		if i.options.dnsProviderConfig == "" {
+			return fmt.Errorf("--dns-provider-config is mandatory with --dns-provider=%s", util.FedDNSProviderCoreDNS)
+		}
+	case util.FedDNSProviderGoogleCloudDNS, util.FedDNSProviderAWSRoute53:
+	default:
+		return fmt.Errorf("invalid --dns-provider: %s, should be one of %q, %q or %q",
+			i.options.dnsProvider, util.FedDNSProviderCoreDNS, util.FedDNSProviderGoogleCloudDNS, util.FedDNSProviderAWSRoute53)
+	}
 
 	err := i.commonOptions.SetName(cmd, args)
 	if err != nil {
@@ -372,7 +387,7 @@ func (i *initFederation) Run(cmdOut io.Writer, config util.AdminConfig) error {
 
 	fmt.Fprint(cmdOut, "Creating federation component deployments...")
 	glog.V(4).Info("Creating federation control plane components")
-	_, err = createAPIServer(hostClientset, i.commonOptions.FederationSystemNamespace, serverName, i.commonOptions.Name, i.options.serverImage, i.options.etcdImage, advertiseAddress, serverCredName, i.options.apiServerEnableHTTPBasicAuth, i.options.apiServerEnableTokenAuth, i.options.apiServerOverrides, pvc, i.options.dryRun, i.options.nodeSelector, i.options.imagePullPolicy, i.options.imagePullSecrets)
+	_, err = createAPIServer(hostClientset, i.commonOptions.FederationSystemNamespace, serverName, i.commonOptions.Name, i.options.serverImage, i.options.etcdImage, advertiseAddress, serverCredName, i.options.apiServerEnableHTTPBasicAuth, i.options.apiServerEnableTokenAuth, i.options.apiServerOverrides, pvc, i.options.dryRun, i.options.nodeSelector, i.options.imagePullPolicy, i.options.imagePullSecrets, i.options.harden)
 	if err != nil {
 		return err
 	}
@@ -407,11 +422,20 @@ func (i *initFederation) Run(cmdOut io.Writer, config util.AdminConfig) error {
 
 	glog.V(4).Info("Creating federation controller manager deployment")
 
-	_, err = createControllerManager(hostClientset, i.commonOptions.FederationSystemNamespace, i.commonOptions.Name, svc.Name, cmName, i.options.serverImage, cmKubeconfigName, i.options.dnsZoneName, i.options.dnsProvider, i.options.dnsProviderConfig, sa.Name, dnsProviderSecret, i.options.controllerManagerOverrides, i.options.dryRun, i.options.nodeSelector, i.options.imagePullPolicy, i.options.imagePullSecrets)
+	_, err = createControllerManager(hostClientset, i.commonOptions.FederationSystemNamespace, i.commonOptions.Name, svc.Name, cmName, i.options.serverImage, cmKubeconfigName, i.options.dnsZoneName, i.options.dnsProvider, i.options.dnsProviderConfig, sa.Name, dnsProviderSecret, i.options.controllerManagerOverrides, i.options.dryRun, i.options.nodeSelector, i.options.imagePullPolicy, i.options.imagePullSecrets, i.options.harden)
 	if err != nil {
 		return err
 	}
 	glog.V(4).Info("Successfully created federation controller manager deployment")
+
+	if i.options.harden {
+		glog.V(4).Info("Creating NetworkPolicies for the federation control plane")
+		err = createNetworkPolicies(hostClientset, i.commonOptions.FederationSystemNamespace, i.commonOptions.Name, i.options.dryRun)
+		if err != nil {
+			return err
+		}
+		glog.V(4).Info("Successfully created NetworkPolicies for the federation control plane")
+	}
 	fmt.Fprintln(cmdOut, " done")
 
 	fmt.Fprint(cmdOut, "Updating kubeconfig...")
@@ -713,7 +737,7 @@ func createPVC(clientset client.Interface, namespace, svcName, federationName, e
 	return clientset.Core().PersistentVolumeClaims(namespace).Create(pvc)
 }
 
-func createAPIServer(clientset client.Interface, namespace, name, federationName, serverImage, etcdImage, advertiseAddress, credentialsName string, hasHTTPBasicAuthFile, hasTokenAuthFile bool, argOverrides map[string]string, pvc *api.PersistentVolumeClaim, dryRun bool, nodeSelector map[string]string, imagePullPolicy, imagePullSecrets string) (*extensions.Deployment, error) {
+func createAPIServer(clientset client.Interface, namespace, name, federationName, serverImage, etcdImage, advertiseAddress, credentialsName string, hasHTTPBasicAuthFile, hasTokenAuthFile bool, argOverrides map[string]string, pvc *api.PersistentVolumeClaim, dryRun bool, nodeSelector map[string]string, imagePullPolicy, imagePullSecrets string, harden bool) (*extensions.Deployment, error) {
 	command := []string{
 		"/hyperkube",
 		"federation-apiserver",
@@ -835,6 +859,10 @@ func createAPIServer(clientset client.Interface, namespace, name, federationName
 		}
 	}
 
+	if harden {
+		hardenPodSpec(&dep.Spec.Template.Spec)
+	}
+
 	if dryRun {
 		return dep, nil
 	}
@@ -843,6 +871,78 @@ func createAPIServer(clientset client.Interface, namespace, name, federationName
 	return createdDep, err
 }
 
+// hardenPodSpec applies a restrictive security context to all the containers in
+// the given pod spec. It is used for the federation control plane components
+// when "kubefed init" is invoked with --harden.
+func hardenPodSpec(spec *api.PodSpec) {
+	runAsNonRoot := true
+	allowPrivilegeEscalation := false
+	for i := range spec.Containers {
+		spec.Containers[i].SecurityContext = &api.SecurityContext{
+			AllowPrivilegeEscalation: &allowPrivilegeEscalation,
+			RunAsNonRoot:             &runAsNonRoot,
+			Capabilities: &api.Capabilities{
+				Drop: []api.Capability{"ALL"},
+			},
+		}
+	}
+}
+
+// createNetworkPolicies isolates the federation namespace with a default-deny
+// NetworkPolicy and only allows traffic between the federation control plane
+// pods (API server/etcd and controller manager).
+func createNetworkPolicies(clientset client.Interface, namespace, federationName string, dryRun bool) error {
+	controlPlaneSelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{"app": "federated-cluster"},
+	}
+	policies := []networking.NetworkPolicy{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "federation-default-deny",
+				Namespace:   namespace,
+				Labels:      componentLabel,
+				Annotations: map[string]string{federation.FederationNameAnnotation: federationName},
+			},
+			Spec: networking.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{},
+				PolicyTypes: []networking.PolicyType{networking.PolicyTypeIngress},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "federation-control-plane",
+				Namespace:   namespace,
+				Labels:      componentLabel,
+				Annotations: map[string]string{federation.FederationNameAnnotation: federationName},
+			},
+			Spec: networking.NetworkPolicySpec{
+				PodSelector: controlPlaneSelector,
+				Ingress: []networking.NetworkPolicyIngressRule{
+					{
+						From: []networking.NetworkPolicyPeer{
+							{
+								PodSelector: &controlPlaneSelector,
+							},
+						},
+					},
+				},
+				PolicyTypes: []networking.PolicyType{networking.PolicyTypeIngress},
+			},
+		},
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	for i := range policies {
+		if _, err := clientset.Networking().NetworkPolicies(namespace).Create(&policies[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func createControllerManagerSA(clientset client.Interface, namespace, federationName string, dryRun bool) (*api.ServiceAccount, error) {
 	sa := &api.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
@@ -894,7 +994,7 @@ func createRoleBindings(clientset client.Interface, namespace, saName, federatio
 	return newRole, newRolebinding, err
 }
 
-func createControllerManager(clientset client.Interface, namespace, name, svcName, cmName, image, kubeconfigName, dnsZoneName, dnsProvider, dnsProviderConfig, saName string, dnsProviderSecret *api.Secret, argOverrides map[string]string, dryRun bool, nodeSelector map[string]string, imagePullPolicy, imagePullSecrets string) (*extensions.Deployment, error) {
+func createControllerManager(clientset client.Interface, namespace, name, svcName, cmName, image, kubeconfigName, dnsZoneName, dnsProvider, dnsProviderConfig, saName string, dnsProviderSecret *api.Secret, argOverrides map[string]string, dryRun bool, nodeSelector map[string]string, imagePullPolicy, imagePullSecrets string, harden bool) (*extensions.Deployment, error) {
 	command := []string{
 		"/hyperkube",
 		"federation-controller-manager",
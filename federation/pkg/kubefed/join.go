@@ -76,15 +76,17 @@ type joinFederation struct {
 }
 
 type joinFederationOptions struct {
-	clusterContext string
-	secretName     string
-	dryRun         bool
+	clusterContext      string
+	secretName          string
+	readOnlyCredentials bool
+	dryRun              bool
 }
 
 func (o *joinFederationOptions) Bind(flags *pflag.FlagSet) {
 	flags.StringVar(&o.clusterContext, "cluster-context", "", "Name of the cluster's context in the local kubeconfig. Defaults to cluster name if unspecified.")
 	flags.StringVar(&o.secretName, "secret-name", "", "Name of the secret where the cluster's credentials will be stored in the host cluster. This name should be a valid RFC 1035 label. Defaults to cluster name if unspecified.")
 	flags.MarkDeprecated("secret-name", "kubefed now generates a secret name, and this flag will be removed in a future release.")
+	flags.BoolVar(&o.readOnlyCredentials, "read-only-credentials", false, "Also create a read-only service account bound to the \"view\" ClusterRole in the joining cluster and store its credentials as a secret in the host cluster, for use by audit tooling. Requires RBAC in the joining cluster.")
 }
 
 // NewCmdJoin defines the `join` command that joins a cluster to a
@@ -234,8 +236,19 @@ func (j *joinFederation) Run(f cmdutil.Factory, cmdOut io.Writer, config util.Ad
 			glog.V(2).Infof("Could not create cluster credentials secret: %v", err)
 			return err
 		}
+		if j.options.readOnlyCredentials {
+			glog.V(2).Info("Creating read-only cluster credentials secret")
+			if _, err := createReadOnlyRBACSecret(hostClientset, rbacClientset, federationNamespace, federationName, joiningClusterName, host, dryRun); err != nil {
+				glog.V(2).Infof("Could not create read-only cluster credentials secret: %v", err)
+				return err
+			}
+			glog.V(2).Info("Created read-only cluster credentials secret")
+		}
 	} else {
 		if _, ok := err.(*util.NoRBACAPIError); ok {
+			if j.options.readOnlyCredentials {
+				return fmt.Errorf("cannot create read-only credentials: RBAC is not available in the joining cluster")
+			}
 
 			// We are not using the `kubectl create secret` machinery through
 			// `RunCreateSubcommand` as we do to the cluster resource below
@@ -561,6 +574,54 @@ func createRBACSecret(hostClusterClientset, joiningClusterClientset internalclie
 	return secret, saName, crb.Name, nil
 }
 
+// createReadOnlyRBACSecret creates a service account in the joining cluster
+// bound to the built-in "view" ClusterRole, and populates its credentials into
+// the host cluster as a secret with a deterministic name, so audit tooling can
+// access the joining cluster without admin certs.
+func createReadOnlyRBACSecret(hostClusterClientset, joiningClusterClientset internalclientset.Interface, namespace, federationName, joiningClusterName, hostClusterContext string, dryRun bool) (*api.Secret, error) {
+	saName := util.ClusterReadOnlyServiceAccountName(joiningClusterName, hostClusterContext)
+	sa := &api.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      saName,
+			Namespace: namespace,
+			Annotations: map[string]string{
+				federation.FederationNameAnnotation: federationName,
+				federation.ClusterNameAnnotation:    joiningClusterName,
+			},
+		},
+	}
+
+	if !dryRun {
+		if _, err := joiningClusterClientset.Core().ServiceAccounts(namespace).Create(sa); err != nil {
+			glog.V(2).Infof("Could not create read-only service account in joining cluster: %v", err)
+			return nil, err
+		}
+	}
+
+	// Bind the service account to the built-in "view" ClusterRole rather
+	// than creating a new role, so that the credentials stay read-only as
+	// the set of API resources in the cluster evolves.
+	rolebinding, err := rbac.NewClusterBinding("view").SAs(namespace, saName).Binding()
+	if err != nil {
+		glog.V(2).Infof("Could not create role binding for read-only service account: %v", err)
+		return nil, err
+	}
+	rolebinding.ObjectMeta.Name = util.ClusterReadOnlyRoleBindingName(federationName, saName)
+	rolebinding.ObjectMeta.Annotations = map[string]string{
+		federation.FederationNameAnnotation: federationName,
+		federation.ClusterNameAnnotation:    joiningClusterName,
+	}
+
+	if !dryRun {
+		if _, err := joiningClusterClientset.Rbac().ClusterRoleBindings().Create(&rolebinding); err != nil {
+			glog.V(2).Infof("Could not create role binding for read-only service account in joining cluster: %v", err)
+			return nil, err
+		}
+	}
+
+	return populateSecretInHostCluster(joiningClusterClientset, hostClusterClientset, saName, namespace, federationName, joiningClusterName, util.ClusterReadOnlySecretName(joiningClusterName), dryRun)
+}
+
 // createServiceAccount creates a service account in the cluster associated with clusterClientset with
 // credentials that will be used by the host cluster to access its API server.
 func createServiceAccount(clusterClientset internalclientset.Interface, namespace, federationName, joiningClusterName, hostContext string, dryRun bool) (string, error) {
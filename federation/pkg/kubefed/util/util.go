@@ -57,6 +57,11 @@ const (
 	FedDNSProviderCoreDNS = "coredns"
 	KubeDnsStubDomains    = "stubDomains"
 
+	// Names of the dns providers that can be passed to kubefed init
+	// via the --dns-provider flag.
+	FedDNSProviderGoogleCloudDNS = "google-clouddns"
+	FedDNSProviderAWSRoute53     = "aws-route53"
+
 	// DefaultFederationSystemNamespace is the namespace in which
 	// federation system components are hosted.
 	DefaultFederationSystemNamespace = "federation-system"
@@ -345,3 +350,25 @@ func ClusterServiceAccountName(joiningClusterName, hostContext string) string {
 func ClusterRoleName(federationName, serviceAccountName string) string {
 	return fmt.Sprintf("federation-controller-manager:%s-%s", federationName, serviceAccountName)
 }
+
+// ClusterReadOnlyServiceAccountName returns the name of the service
+// account whose read-only credentials can be handed out to audit
+// tooling for the client cluster.
+func ClusterReadOnlyServiceAccountName(joiningClusterName, hostContext string) string {
+	return fmt.Sprintf("%s-%s-read-only", joiningClusterName, hostContext)
+}
+
+// ClusterReadOnlyRoleBindingName returns the name of the
+// ClusterRoleBinding that binds the read-only service account to the
+// "view" ClusterRole on the cluster.
+func ClusterReadOnlyRoleBindingName(federationName, serviceAccountName string) string {
+	return fmt.Sprintf("federation-auditor:%s-%s", federationName, serviceAccountName)
+}
+
+// ClusterReadOnlySecretName returns the name of the secret in the host
+// cluster that holds the read-only credentials for the given cluster.
+// Unlike the admin credentials secret, the name is deterministic so the
+// secret can be located by `kubefed get-credentials`.
+func ClusterReadOnlySecretName(joiningClusterName string) string {
+	return fmt.Sprintf("%s-read-only", joiningClusterName)
+}
@@ -86,6 +86,29 @@ const (
 	Fail FailurePolicyType = "Fail"
 )
 
+// MatchPolicyType specifies the type of match policy
+type MatchPolicyType string
+
+const (
+	// Exact means requests should only be sent to the webhook if they exactly match a given rule
+	Exact MatchPolicyType = "Exact"
+	// Equivalent means requests should be sent to the webhook if they modify a resource listed in rules via another API group or version.
+	Equivalent MatchPolicyType = "Equivalent"
+)
+
+// ReinvocationPolicyType specifies what type of policy the admission hook uses.
+type ReinvocationPolicyType string
+
+const (
+	// NeverReinvocationPolicy indicates that the webhook must not be called more than once in a
+	// single admission evaluation.
+	NeverReinvocationPolicy ReinvocationPolicyType = "Never"
+	// IfNeededReinvocationPolicy indicates that the webhook may be called at least one
+	// additional time as part of the admission evaluation if the object being admitted is
+	// modified by other admission plugins after the initial webhook call.
+	IfNeededReinvocationPolicy ReinvocationPolicyType = "IfNeeded"
+)
+
 // SideEffectClass denotes the type of side effects resulting from calling the webhook
 type SideEffectClass string
 
@@ -177,6 +200,23 @@ type Webhook struct {
 	// +optional
 	FailurePolicy *FailurePolicyType
 
+	// matchPolicy defines how the "rules" list is used to match incoming requests.
+	// Allowed values are Exact or Equivalent. An Equivalent webhook is also
+	// called for requests that modify a resource listed in rules via another
+	// API group or version; the request object is converted to a version the
+	// webhook registered for before dispatch.
+	// Defaults to Exact.
+	// +optional
+	MatchPolicy *MatchPolicyType
+
+	// reinvocationPolicy indicates whether a mutating webhook should be called
+	// again if the object is modified by other admission plugins after the
+	// initial call. Allowed values are Never or IfNeeded; webhooks using
+	// IfNeeded must be idempotent. Only honored by mutating webhooks.
+	// Defaults to Never.
+	// +optional
+	ReinvocationPolicy *ReinvocationPolicyType
+
 	// NamespaceSelector decides whether to run the webhook on an object based
 	// on whether the namespace for that object matches the selector. If the
 	// object itself is a namespace, the matching is performed on
@@ -223,6 +263,20 @@ type Webhook struct {
 	// +optional
 	NamespaceSelector *metav1.LabelSelector
 
+	// ObjectSelector decides whether to run the webhook based on if the
+	// object has matching labels. objectSelector is evaluated against both
+	// the oldObject and newObject that would be sent to the webhook, and
+	// is considered to match if either object matches the selector. A null
+	// object (oldObject in the case of create, or newObject in the case of
+	// delete) or an object that cannot have labels (like a
+	// DeploymentRollback or a PodProxyOptions object) is not considered to
+	// match.
+	// Use the object selector only if the webhook is opt-in, because end
+	// users may skip the admission webhook by setting the labels.
+	// Default to the empty LabelSelector, which matches everything.
+	// +optional
+	ObjectSelector *metav1.LabelSelector
+
 	// SideEffects states whether this webhookk has side effects.
 	// Acceptable values are: Unknown, None, Some, NoneOnDryRun
 	// Webhooks with side effects MUST implement a reconciliation system, since a request may be
@@ -344,6 +344,34 @@ func TestValidateValidatingWebhookConfiguration(t *testing.T) {
 			}, true),
 			expectedError: `webhooks[0].failurePolicy: Unsupported value: "other": supported values: "Fail", "Ignore"`,
 		},
+		{
+			name: "MatchPolicy can only be \"Exact\" or \"Equivalent\"",
+			config: newValidatingWebhookConfiguration([]admissionregistration.Webhook{
+				{
+					Name:         "webhook.k8s.io",
+					ClientConfig: validClientConfig,
+					MatchPolicy: func() *admissionregistration.MatchPolicyType {
+						r := admissionregistration.MatchPolicyType("other")
+						return &r
+					}(),
+				},
+			}, true),
+			expectedError: `webhooks[0].matchPolicy: Unsupported value: "other": supported values: "Equivalent", "Exact"`,
+		},
+		{
+			name: "ReinvocationPolicy can only be \"Never\" or \"IfNeeded\"",
+			config: newValidatingWebhookConfiguration([]admissionregistration.Webhook{
+				{
+					Name:         "webhook.k8s.io",
+					ClientConfig: validClientConfig,
+					ReinvocationPolicy: func() *admissionregistration.ReinvocationPolicyType {
+						r := admissionregistration.ReinvocationPolicyType("other")
+						return &r
+					}(),
+				},
+			}, true),
+			expectedError: `webhooks[0].reinvocationPolicy: Unsupported value: "other": supported values: "IfNeeded", "Never"`,
+		},
 		{
 			name: "SideEffects can only be \"Unknown\", \"None\", \"Some\", or \"NoneOnDryRun\"",
 			config: newValidatingWebhookConfiguration([]admissionregistration.Webhook{
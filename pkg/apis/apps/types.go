@@ -573,6 +573,11 @@ type DaemonSetSpec struct {
 	// Defaults to 10.
 	// +optional
 	RevisionHistoryLimit *int32
+
+	// Indicates that the daemon set is paused. While paused the controller
+	// does not create or delete any daemon pods.
+	// +optional
+	Paused bool
 }
 
 // DaemonSetStatus represents the current status of a daemon set.
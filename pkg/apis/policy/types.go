@@ -77,6 +77,46 @@ type PodDisruptionBudgetStatus struct {
 
 	// total number of pods counted by this disruption budget
 	ExpectedPods int32
+
+	// Conditions contains conditions for the PDB. The disruption controller
+	// sets the DisruptionAllowed condition, whose reason and message explain
+	// why disruptions are currently blocked.
+	// +optional
+	Conditions []PodDisruptionBudgetCondition
+}
+
+// PodDisruptionBudgetConditionType is a valid value for the type of a PodDisruptionBudgetCondition.
+type PodDisruptionBudgetConditionType string
+
+const (
+	// DisruptionAllowed indicates whether the PDB currently allows any disruption.
+	// When it is false, the reason and message explain what is blocking evictions.
+	DisruptionAllowed PodDisruptionBudgetConditionType = "DisruptionAllowed"
+)
+
+// Reasons for PDB conditions.
+const (
+	// SyncFailedReason indicates the controller was unable to compute the
+	// number of allowed disruptions, so no disruptions are allowed.
+	SyncFailedReason = "SyncFailed"
+	// SufficientPodsReason means enough pods are healthy to allow disruptions.
+	SufficientPodsReason = "SufficientPods"
+	// InsufficientPodsReason means too few pods are healthy to allow disruptions.
+	InsufficientPodsReason = "InsufficientHealthyPods"
+)
+
+// PodDisruptionBudgetCondition describes the state of a PodDisruptionBudget at a certain point.
+type PodDisruptionBudgetCondition struct {
+	// Type of PodDisruptionBudget condition.
+	Type PodDisruptionBudgetConditionType
+	// Status of the condition, one of True, False, Unknown.
+	Status api.ConditionStatus
+	// Last time the condition transitioned from one status to another.
+	LastTransitionTime metav1.Time
+	// The reason for the condition's last transition.
+	Reason string
+	// A human readable message indicating details about the transition.
+	Message string
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
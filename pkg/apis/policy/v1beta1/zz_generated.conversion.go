@@ -120,6 +120,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.PodDisruptionBudgetCondition)(nil), (*policy.PodDisruptionBudgetCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PodDisruptionBudgetCondition_To_policy_PodDisruptionBudgetCondition(a.(*v1beta1.PodDisruptionBudgetCondition), b.(*policy.PodDisruptionBudgetCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*policy.PodDisruptionBudgetCondition)(nil), (*v1beta1.PodDisruptionBudgetCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_policy_PodDisruptionBudgetCondition_To_v1beta1_PodDisruptionBudgetCondition(a.(*policy.PodDisruptionBudgetCondition), b.(*v1beta1.PodDisruptionBudgetCondition), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.PodDisruptionBudgetList)(nil), (*policy.PodDisruptionBudgetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_PodDisruptionBudgetList_To_policy_PodDisruptionBudgetList(a.(*v1beta1.PodDisruptionBudgetList), b.(*policy.PodDisruptionBudgetList), scope)
 	}); err != nil {
@@ -415,6 +425,34 @@ func Convert_policy_PodDisruptionBudget_To_v1beta1_PodDisruptionBudget(in *polic
 	return autoConvert_policy_PodDisruptionBudget_To_v1beta1_PodDisruptionBudget(in, out, s)
 }
 
+func autoConvert_v1beta1_PodDisruptionBudgetCondition_To_policy_PodDisruptionBudgetCondition(in *v1beta1.PodDisruptionBudgetCondition, out *policy.PodDisruptionBudgetCondition, s conversion.Scope) error {
+	out.Type = policy.PodDisruptionBudgetConditionType(in.Type)
+	out.Status = core.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_PodDisruptionBudgetCondition_To_policy_PodDisruptionBudgetCondition is an autogenerated conversion function.
+func Convert_v1beta1_PodDisruptionBudgetCondition_To_policy_PodDisruptionBudgetCondition(in *v1beta1.PodDisruptionBudgetCondition, out *policy.PodDisruptionBudgetCondition, s conversion.Scope) error {
+	return autoConvert_v1beta1_PodDisruptionBudgetCondition_To_policy_PodDisruptionBudgetCondition(in, out, s)
+}
+
+func autoConvert_policy_PodDisruptionBudgetCondition_To_v1beta1_PodDisruptionBudgetCondition(in *policy.PodDisruptionBudgetCondition, out *v1beta1.PodDisruptionBudgetCondition, s conversion.Scope) error {
+	out.Type = v1beta1.PodDisruptionBudgetConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_policy_PodDisruptionBudgetCondition_To_v1beta1_PodDisruptionBudgetCondition is an autogenerated conversion function.
+func Convert_policy_PodDisruptionBudgetCondition_To_v1beta1_PodDisruptionBudgetCondition(in *policy.PodDisruptionBudgetCondition, out *v1beta1.PodDisruptionBudgetCondition, s conversion.Scope) error {
+	return autoConvert_policy_PodDisruptionBudgetCondition_To_v1beta1_PodDisruptionBudgetCondition(in, out, s)
+}
+
 func autoConvert_v1beta1_PodDisruptionBudgetList_To_policy_PodDisruptionBudgetList(in *v1beta1.PodDisruptionBudgetList, out *policy.PodDisruptionBudgetList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]policy.PodDisruptionBudget)(unsafe.Pointer(&in.Items))
@@ -468,6 +506,7 @@ func autoConvert_v1beta1_PodDisruptionBudgetStatus_To_policy_PodDisruptionBudget
 	out.CurrentHealthy = in.CurrentHealthy
 	out.DesiredHealthy = in.DesiredHealthy
 	out.ExpectedPods = in.ExpectedPods
+	out.Conditions = *(*[]policy.PodDisruptionBudgetCondition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -483,6 +522,7 @@ func autoConvert_policy_PodDisruptionBudgetStatus_To_v1beta1_PodDisruptionBudget
 	out.CurrentHealthy = in.CurrentHealthy
 	out.DesiredHealthy = in.DesiredHealthy
 	out.ExpectedPods = in.ExpectedPods
+	out.Conditions = *(*[]v1beta1.PodDisruptionBudgetCondition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
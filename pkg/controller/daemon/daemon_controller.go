@@ -51,6 +51,7 @@ import (
 	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
 	"k8s.io/kubernetes/pkg/controller"
 	"k8s.io/kubernetes/pkg/controller/daemon/util"
+	"k8s.io/kubernetes/pkg/controller/history"
 	"k8s.io/kubernetes/pkg/features"
 	kubelettypes "k8s.io/kubernetes/pkg/kubelet/types"
 	"k8s.io/kubernetes/pkg/scheduler/algorithm/predicates"
@@ -98,6 +99,9 @@ type DaemonSetsController struct {
 
 	// To allow injection of syncDaemonSet for testing.
 	syncHandler func(dsKey string) error
+	// syncMonitor flags the controller when syncs exceed their deadline or
+	// the queue keeps growing, e.g. when history cleanup wedges a sync.
+	syncMonitor *controller.SyncMonitor
 	// used for unit testing
 	enqueueDaemonSet            func(ds *apps.DaemonSet)
 	enqueueDaemonSetRateLimited func(ds *apps.DaemonSet)
@@ -110,6 +114,8 @@ type DaemonSetsController struct {
 	dsStoreSynced cache.InformerSynced
 	// historyLister get list/get history from the shared informers's store
 	historyLister appslisters.ControllerRevisionLister
+	// controllerHistory manages the DaemonSet's ControllerRevision history
+	controllerHistory history.Interface
 	// historyStoreSynced returns true if the history store has been synced at least once.
 	// Added as a member to the struct to allow injection for testing.
 	historyStoreSynced cache.InformerSynced
@@ -195,6 +201,7 @@ func NewDaemonSetsController(
 	})
 	dsc.historyLister = historyInformer.Lister()
 	dsc.historyStoreSynced = historyInformer.Informer().HasSynced
+	dsc.controllerHistory = history.NewHistory(kubeClient, historyInformer.Lister())
 
 	// Watch for creation/deletion of pods. The reason we watch is that we don't want a daemon set to create/delete
 	// more pods until all the effects (expectations) of a daemon set's create/delete have been observed.
@@ -223,6 +230,7 @@ func NewDaemonSetsController(
 	dsc.syncHandler = dsc.syncDaemonSet
 	dsc.enqueueDaemonSet = dsc.enqueue
 	dsc.enqueueDaemonSetRateLimited = dsc.enqueueRateLimited
+	dsc.syncMonitor = controller.NewSyncMonitor("daemonset", controller.DefaultSyncDeadline)
 
 	dsc.failedPodsBackoff = failedPodsBackoff
 
@@ -276,6 +284,7 @@ func (dsc *DaemonSetsController) Run(workers int, stopCh <-chan struct{}) {
 	}
 
 	go wait.Until(dsc.failedPodsBackoff.GC, BackoffGCInterval, stopCh)
+	go wait.Until(dsc.syncMonitor.LogIfStuck, time.Minute, stopCh)
 
 	<-stopCh
 }
@@ -293,7 +302,10 @@ func (dsc *DaemonSetsController) processNextWorkItem() bool {
 	}
 	defer dsc.queue.Done(dsKey)
 
+	dsc.syncMonitor.ObserveQueueDepth(dsc.queue.Len())
+	syncFinished := dsc.syncMonitor.SyncStarted(dsKey.(string))
 	err := dsc.syncHandler(dsKey.(string))
+	syncFinished()
 	if err == nil {
 		dsc.queue.Forget(dsKey)
 		return true
@@ -1113,7 +1125,8 @@ func (dsc *DaemonSetsController) syncNodes(ds *apps.DaemonSet, podsToDelete, nod
 	return utilerrors.NewAggregate(errors)
 }
 
-func storeDaemonSetStatus(dsClient unversionedapps.DaemonSetInterface, ds *apps.DaemonSet, desiredNumberScheduled, currentNumberScheduled, numberMisscheduled, numberReady, updatedNumberScheduled, numberAvailable, numberUnavailable int, updateObservedGen bool) error {
+func storeDaemonSetStatus(dsClient unversionedapps.DaemonSetInterface, ds *apps.DaemonSet, desiredNumberScheduled, currentNumberScheduled, numberMisscheduled, numberReady, updatedNumberScheduled, numberAvailable, numberUnavailable int, progressingCondition *apps.DaemonSetCondition, updateObservedGen bool) error {
+	currentCondition := util.GetDaemonSetCondition(ds.Status, util.DaemonSetProgressing)
 	if int(ds.Status.DesiredNumberScheduled) == desiredNumberScheduled &&
 		int(ds.Status.CurrentNumberScheduled) == currentNumberScheduled &&
 		int(ds.Status.NumberMisscheduled) == numberMisscheduled &&
@@ -1121,6 +1134,10 @@ func storeDaemonSetStatus(dsClient unversionedapps.DaemonSetInterface, ds *apps.
 		int(ds.Status.UpdatedNumberScheduled) == updatedNumberScheduled &&
 		int(ds.Status.NumberAvailable) == numberAvailable &&
 		int(ds.Status.NumberUnavailable) == numberUnavailable &&
+		currentCondition != nil &&
+		currentCondition.Status == progressingCondition.Status &&
+		currentCondition.Reason == progressingCondition.Reason &&
+		currentCondition.Message == progressingCondition.Message &&
 		ds.Status.ObservedGeneration >= ds.Generation {
 		return nil
 	}
@@ -1139,6 +1156,7 @@ func storeDaemonSetStatus(dsClient unversionedapps.DaemonSetInterface, ds *apps.
 		toUpdate.Status.UpdatedNumberScheduled = int32(updatedNumberScheduled)
 		toUpdate.Status.NumberAvailable = int32(numberAvailable)
 		toUpdate.Status.NumberUnavailable = int32(numberUnavailable)
+		util.SetDaemonSetCondition(&toUpdate.Status, *progressingCondition)
 
 		if _, updateErr = dsClient.UpdateStatus(toUpdate); updateErr == nil {
 			return nil
@@ -1202,11 +1220,19 @@ func (dsc *DaemonSetsController) updateDaemonSetStatus(ds *apps.DaemonSet, nodeL
 	}
 	numberUnavailable := desiredNumberScheduled - numberAvailable
 
-	err = storeDaemonSetStatus(dsc.kubeClient.AppsV1().DaemonSets(ds.Namespace), ds, desiredNumberScheduled, currentNumberScheduled, numberMisscheduled, numberReady, updatedNumberScheduled, numberAvailable, numberUnavailable, updateObservedGen)
+	progressingCondition := dsc.progressingCondition(ds, desiredNumberScheduled, updatedNumberScheduled, numberAvailable)
+
+	err = storeDaemonSetStatus(dsc.kubeClient.AppsV1().DaemonSets(ds.Namespace), ds, desiredNumberScheduled, currentNumberScheduled, numberMisscheduled, numberReady, updatedNumberScheduled, numberAvailable, numberUnavailable, progressingCondition, updateObservedGen)
 	if err != nil {
 		return fmt.Errorf("error storing status for daemon set %#v: %v", ds, err)
 	}
 
+	// Recheck the rollout once its progress deadline can expire so a stuck
+	// rollout is reported even when no pod or node events occur.
+	if deadline, ok := util.GetProgressDeadline(ds); ok && progressingCondition.Reason == util.DaemonSetUpdatedReason {
+		dsc.enqueueDaemonSetAfter(ds, deadline)
+	}
+
 	// Resync the DaemonSet after MinReadySeconds as a last line of defense to guard against clock-skew.
 	if ds.Spec.MinReadySeconds > 0 && numberReady != numberAvailable {
 		dsc.enqueueDaemonSetAfter(ds, time.Duration(ds.Spec.MinReadySeconds)*time.Second)
@@ -1214,6 +1240,38 @@ func (dsc *DaemonSetsController) updateDaemonSetStatus(ds *apps.DaemonSet, nodeL
 	return nil
 }
 
+// progressingCondition computes the Progressing condition for the observed
+// rollout counts. A rollout is complete once all pods of the latest template
+// are scheduled and available; while it is incomplete the condition stays True
+// with reason DaemonSetUpdated unless no progress was made for longer than the
+// progress deadline, in which case the condition becomes False with reason
+// ProgressDeadlineExceeded.
+func (dsc *DaemonSetsController) progressingCondition(ds *apps.DaemonSet, desiredNumberScheduled, updatedNumberScheduled, numberAvailable int) *apps.DaemonSetCondition {
+	if updatedNumberScheduled == desiredNumberScheduled && numberAvailable == desiredNumberScheduled {
+		msg := fmt.Sprintf("DaemonSet %q has successfully progressed: %d of %d updated pods are available.", ds.Name, numberAvailable, desiredNumberScheduled)
+		return util.NewDaemonSetCondition(util.DaemonSetProgressing, v1.ConditionTrue, util.DaemonSetAvailableReason, msg)
+	}
+
+	msg := fmt.Sprintf("DaemonSet %q is progressing: %d of %d pods are updated, %d are available.", ds.Name, updatedNumberScheduled, desiredNumberScheduled, numberAvailable)
+	// The message encodes the observed counts, so SetDaemonSetCondition resets
+	// LastTransitionTime whenever the rollout makes progress. A condition with
+	// the same message that is older than the progress deadline means the
+	// rollout is stuck.
+	if deadline, ok := util.GetProgressDeadline(ds); ok {
+		currentCondition := util.GetDaemonSetCondition(ds.Status, util.DaemonSetProgressing)
+		timedOutMsg := fmt.Sprintf("DaemonSet %q has timed out progressing: %d of %d pods are updated, %d are available.", ds.Name, updatedNumberScheduled, desiredNumberScheduled, numberAvailable)
+		switch {
+		case currentCondition != nil && currentCondition.Reason == util.TimedOutReason && currentCondition.Message == timedOutMsg:
+			// Still stuck at the same counts; stay timed out.
+			return currentCondition
+		case currentCondition != nil && currentCondition.Reason == util.DaemonSetUpdatedReason && currentCondition.Message == msg &&
+			metav1.Now().After(currentCondition.LastTransitionTime.Add(deadline)):
+			return util.NewDaemonSetCondition(util.DaemonSetProgressing, v1.ConditionFalse, util.TimedOutReason, timedOutMsg)
+		}
+	}
+	return util.NewDaemonSetCondition(util.DaemonSetProgressing, v1.ConditionTrue, util.DaemonSetUpdatedReason, msg)
+}
+
 func (dsc *DaemonSetsController) syncDaemonSet(key string) error {
 	startTime := time.Now()
 	defer func() {
@@ -1277,6 +1335,12 @@ func (dsc *DaemonSetsController) syncDaemonSet(key string) error {
 		return dsc.updateDaemonSetStatus(ds, nodeList, hash, false)
 	}
 
+	// While the daemon set is paused don't create or delete any daemon pods;
+	// only refresh the status so the pause is reflected in observedGeneration.
+	if ds.Spec.Paused {
+		return dsc.updateDaemonSetStatus(ds, nodeList, hash, true)
+	}
+
 	err = dsc.manage(ds, nodeList, hash)
 	if err != nil {
 		return err
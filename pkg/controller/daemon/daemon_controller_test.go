@@ -27,6 +27,7 @@ import (
 
 	apps "k8s.io/api/apps/v1"
 	"k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -305,6 +306,22 @@ func (f *fakePodControl) CreatePodsWithControllerRef(namespace string, template
 	return nil
 }
 
+// deletePodViaEviction mirrors DeletePod for pods removed through the
+// Eviction subresource, so tests validate evictions and plain deletions
+// uniformly.
+func (f *fakePodControl) deletePodViaEviction(namespace string, podID string) error {
+	f.Lock()
+	defer f.Unlock()
+	f.FakePodControl.DeletePodName = append(f.FakePodControl.DeletePodName, podID)
+	pod, ok := f.podIDMap[podID]
+	if !ok {
+		return fmt.Errorf("pod %q does not exist", podID)
+	}
+	f.podStore.Delete(pod)
+	delete(f.podIDMap, podID)
+	return nil
+}
+
 func (f *fakePodControl) DeletePod(namespace string, podID string, object runtime.Object) error {
 	f.Lock()
 	defer f.Unlock()
@@ -362,6 +379,14 @@ func newTestController(initialObjects ...runtime.Object) (*daemonSetsController,
 	dsc.podControl = podControl
 	podControl.podStore = informerFactory.Core().V1().Pods().Informer().GetStore()
 
+	clientset.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		eviction := action.(core.CreateAction).GetObject().(*policy.Eviction)
+		return true, nil, podControl.deletePodViaEviction(eviction.Namespace, eviction.Name)
+	})
+
 	newDsc := &daemonSetsController{
 		dsc,
 		informerFactory.Apps().V1().DaemonSets().Informer().GetStore(),
@@ -484,6 +509,27 @@ func TestSimpleDaemonSetLaunchesPods(t *testing.T) {
 	}
 }
 
+// Paused DaemonSets should not create any pods.
+func TestPausedDaemonSetDoesNotLaunchPods(t *testing.T) {
+	for _, strategy := range updateStrategies() {
+		ds := newDaemonSet("foo")
+		ds.Spec.UpdateStrategy = *strategy
+		ds.Spec.Paused = true
+		manager, podControl, _, err := newTestController(ds)
+		if err != nil {
+			t.Fatalf("error creating DaemonSets controller: %v", err)
+		}
+		addNodes(manager.nodeStore, 0, 5, nil)
+		manager.dsStore.Add(ds)
+		syncAndValidateDaemonSets(t, manager, ds, podControl, 0, 0, 0)
+
+		// Resuming the daemon set brings the nodes up to date.
+		ds.Spec.Paused = false
+		manager.dsStore.Update(ds)
+		syncAndValidateDaemonSets(t, manager, ds, podControl, 5, 0, 0)
+	}
+}
+
 // When ScheduleDaemonSetPods is enabled, DaemonSets without node selectors should
 // launch pods on every node by NodeAffinity.
 func TestSimpleDaemonSetScheduleDaemonSetPodsLaunchesPods(t *testing.T) {
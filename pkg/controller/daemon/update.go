@@ -20,21 +20,24 @@ import (
 	"bytes"
 	"fmt"
 	"reflect"
-	"sort"
+	"time"
 
 	"k8s.io/klog"
 
 	apps "k8s.io/api/apps/v1"
 	"k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	intstrutil "k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/json"
 	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
 	"k8s.io/kubernetes/pkg/controller"
 	"k8s.io/kubernetes/pkg/controller/daemon/util"
+	"k8s.io/kubernetes/pkg/controller/history"
 	labelsutil "k8s.io/kubernetes/pkg/util/labels"
 )
 
@@ -51,7 +54,7 @@ func (dsc *DaemonSetsController) rollingUpdate(ds *apps.DaemonSet, nodeList []*v
 	if err != nil {
 		return fmt.Errorf("Couldn't get unavailable numbers: %v", err)
 	}
-	oldAvailablePods, oldUnavailablePods := util.SplitByAvailablePods(ds.Spec.MinReadySeconds, oldPods)
+	oldAvailablePods, oldUnavailablePods := util.SplitByAvailablePods(ds.Spec.MinReadySeconds, util.GetStartupGraceSeconds(ds), oldPods)
 
 	// for oldPods delete all not running pods
 	var oldPodsToDelete []string
@@ -65,17 +68,50 @@ func (dsc *DaemonSetsController) rollingUpdate(ds *apps.DaemonSet, nodeList []*v
 		oldPodsToDelete = append(oldPodsToDelete, pod.Name)
 	}
 
-	klog.V(4).Infof("Marking old pods for deletion")
+	klog.V(4).Infof("Marking old pods for eviction")
+	var oldPodsToEvict []*v1.Pod
 	for _, pod := range oldAvailablePods {
 		if numUnavailable >= maxUnavailable {
 			klog.V(4).Infof("Number of unavailable DaemonSet pods: %d, is equal to or exceeds allowed maximum: %d", numUnavailable, maxUnavailable)
 			break
 		}
-		klog.V(4).Infof("Marking pod %s/%s for deletion", ds.Name, pod.Name)
-		oldPodsToDelete = append(oldPodsToDelete, pod.Name)
+		klog.V(4).Infof("Marking pod %s/%s for eviction", ds.Name, pod.Name)
+		oldPodsToEvict = append(oldPodsToEvict, pod)
 		numUnavailable++
 	}
-	return dsc.syncNodes(ds, oldPodsToDelete, []string{}, hash)
+
+	// Available pods are removed through the Eviction subresource so the
+	// deletions are coordinated with any PodDisruptionBudgets covering them.
+	// Unavailable pods do not count as disruptions and are deleted directly.
+	evictionErr := dsc.evictOldPods(ds, oldPodsToEvict)
+	syncErr := dsc.syncNodes(ds, oldPodsToDelete, []string{}, hash)
+	if evictionErr != nil {
+		return evictionErr
+	}
+	return syncErr
+}
+
+// evictOldPods evicts the given old pods using the Eviction subresource, so
+// the API server refuses deletions that would violate a PodDisruptionBudget.
+// Pods whose eviction is currently blocked by a budget are skipped and the
+// DaemonSet is requeued; the rolling update resumes once the budget allows
+// further disruptions.
+func (dsc *DaemonSetsController) evictOldPods(ds *apps.DaemonSet, pods []*v1.Pod) error {
+	var evictionErrors []error
+	for _, pod := range pods {
+		err := dsc.kubeClient.PolicyV1beta1().Evictions(pod.Namespace).Evict(&policy.Eviction{
+			ObjectMeta: metav1.ObjectMeta{Namespace: pod.Namespace, Name: pod.Name},
+		})
+		switch {
+		case err == nil || errors.IsNotFound(err):
+		case errors.IsTooManyRequests(err):
+			klog.V(2).Infof("Eviction of pod %s/%s blocked by a PodDisruptionBudget, retrying: %v", pod.Namespace, pod.Name, err)
+			dsc.enqueueDaemonSetAfter(ds, 15*time.Second)
+		default:
+			evictionErrors = append(evictionErrors, fmt.Errorf("unable to evict pod %s/%s: %v", pod.Namespace, pod.Name, err))
+		}
+	}
+	return utilerrors.NewAggregate(evictionErrors)
 }
 
 // constructHistory finds all histories controlled by the given DaemonSet, and
@@ -144,12 +180,6 @@ func (dsc *DaemonSetsController) cleanupHistory(ds *apps.DaemonSet, old []*apps.
 		return fmt.Errorf("couldn't get node to daemon pod mapping for daemon set %q: %v", ds.Name, err)
 	}
 
-	toKeep := int(*ds.Spec.RevisionHistoryLimit)
-	toKill := len(old) - toKeep
-	if toKill <= 0 {
-		return nil
-	}
-
 	// Find all hashes of live pods
 	liveHashes := make(map[string]bool)
 	for _, pods := range nodesToDaemonPods {
@@ -160,31 +190,16 @@ func (dsc *DaemonSetsController) cleanupHistory(ds *apps.DaemonSet, old []*apps.
 		}
 	}
 
-	// Find all live history with the above hashes
-	liveHistory := make(map[string]bool)
-	for _, history := range old {
-		if hash := history.Labels[apps.DefaultDaemonSetUniqueLabelKey]; liveHashes[hash] {
-			liveHistory[history.Name] = true
+	// Mark all live history with the above hashes
+	live := make(map[string]bool)
+	for _, h := range old {
+		if hash := h.Labels[apps.DefaultDaemonSetUniqueLabelKey]; liveHashes[hash] {
+			live[h.Name] = true
 		}
 	}
 
 	// Clean up old history from smallest to highest revision (from oldest to newest)
-	sort.Sort(historiesByRevision(old))
-	for _, history := range old {
-		if toKill <= 0 {
-			break
-		}
-		if liveHistory[history.Name] {
-			continue
-		}
-		// Clean up
-		err := dsc.kubeClient.AppsV1().ControllerRevisions(ds.Namespace).Delete(history.Name, nil)
-		if err != nil {
-			return err
-		}
-		toKill--
-	}
-	return nil
+	return history.TruncateHistory(dsc.controllerHistory, controllerKind, old, live, int(*ds.Spec.RevisionHistoryLimit))
 }
 
 // maxRevision returns the max revision number of the given list of histories
@@ -429,11 +444,3 @@ func (dsc *DaemonSetsController) getUnavailableNumbers(ds *apps.DaemonSet, nodeL
 	klog.V(4).Infof(" DaemonSet %s/%s, maxUnavailable: %d, numUnavailable: %d", ds.Namespace, ds.Name, maxUnavailable, numUnavailable)
 	return maxUnavailable, numUnavailable, nil
 }
-
-type historiesByRevision []*apps.ControllerRevision
-
-func (h historiesByRevision) Len() int      { return len(h) }
-func (h historiesByRevision) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
-func (h historiesByRevision) Less(i, j int) bool {
-	return h[i].Revision < h[j].Revision
-}
@@ -19,6 +19,7 @@ package util
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	apps "k8s.io/api/apps/v1"
 	"k8s.io/api/core/v1"
@@ -132,12 +133,35 @@ func IsPodUpdated(pod *v1.Pod, hash string, dsTemplateGeneration *int64) bool {
 	return hashMatches || templateMatches
 }
 
-// SplitByAvailablePods splits provided daemon set pods by availability
-func SplitByAvailablePods(minReadySeconds int32, pods []*v1.Pod) ([]*v1.Pod, []*v1.Pod) {
+// StartupGraceSecondsAnnotation is the annotation on a DaemonSet that declares how many
+// seconds a newly started daemon pod may take to become ready before it is counted
+// unavailable during rollouts.
+const StartupGraceSecondsAnnotation = "daemonset.kubernetes.io/startup-grace-seconds"
+
+// GetStartupGraceSeconds returns the startup grace period declared by ds's annotations,
+// or 0 if the annotation is absent or malformed.
+func GetStartupGraceSeconds(ds *apps.DaemonSet) int32 {
+	annotation, found := ds.Annotations[StartupGraceSecondsAnnotation]
+	if !found {
+		return 0
+	}
+	seconds, err := strconv.ParseInt(annotation, 10, 32)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return int32(seconds)
+}
+
+// SplitByAvailablePods splits provided daemon set pods by availability. A running pod
+// that is not available yet is still counted available while it is within
+// startupGraceSeconds of its start time, so slow-starting daemons and pods waiting on
+// readiness gates do not stall rollouts artificially.
+func SplitByAvailablePods(minReadySeconds int32, startupGraceSeconds int32, pods []*v1.Pod) ([]*v1.Pod, []*v1.Pod) {
 	unavailablePods := []*v1.Pod{}
 	availablePods := []*v1.Pod{}
+	now := metav1.Now()
 	for _, pod := range pods {
-		if podutil.IsPodAvailable(pod, minReadySeconds, metav1.Now()) {
+		if podutil.IsPodAvailable(pod, minReadySeconds, now) || withinStartupGrace(pod, startupGraceSeconds, now) {
 			availablePods = append(availablePods, pod)
 		} else {
 			unavailablePods = append(unavailablePods, pod)
@@ -146,6 +170,38 @@ func SplitByAvailablePods(minReadySeconds int32, pods []*v1.Pod) ([]*v1.Pod, []*
 	return availablePods, unavailablePods
 }
 
+// withinStartupGrace returns true if pod is a running pod that is still starting up and
+// started less than startupGraceSeconds ago. The grace only covers pods whose containers
+// have not passed their readiness probes yet, or whose readiness gates have not been
+// satisfied by their external controllers; pods that became unhealthy after being ready
+// have an old start time and fall outside the window.
+func withinStartupGrace(pod *v1.Pod, startupGraceSeconds int32, now metav1.Time) bool {
+	if startupGraceSeconds <= 0 {
+		return false
+	}
+	if pod.Status.Phase != v1.PodRunning || pod.Status.StartTime == nil {
+		return false
+	}
+	if !now.Time.Before(pod.Status.StartTime.Add(time.Duration(startupGraceSeconds) * time.Second)) {
+		return false
+	}
+	if _, c := podutil.GetPodCondition(&pod.Status, v1.ContainersReady); c == nil || c.Status != v1.ConditionTrue {
+		return true
+	}
+	return hasPendingReadinessGates(pod)
+}
+
+// hasPendingReadinessGates returns true if any readiness gate declared by pod does not
+// have a matching pod condition with status True.
+func hasPendingReadinessGates(pod *v1.Pod) bool {
+	for _, gate := range pod.Spec.ReadinessGates {
+		if _, c := podutil.GetPodCondition(&pod.Status, gate.ConditionType); c == nil || c.Status != v1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
 // ReplaceDaemonSetPodNodeNameNodeAffinity replaces the RequiredDuringSchedulingIgnoredDuringExecution
 // NodeAffinity of the given affinity with a new NodeAffinity that selects the given nodeName.
 // Note that this function assumes that no NodeAffinity conflicts with the selected nodeName.
@@ -234,3 +290,94 @@ func GetTargetNodeName(pod *v1.Pod) (string, error) {
 
 	return "", fmt.Errorf("no node name found for pod %s/%s", pod.Namespace, pod.Name)
 }
+
+const (
+	// DaemonSetProgressing means the DaemonSet is progressing. Progress for a
+	// DaemonSet is considered when new pods are created or updated pods become
+	// available. Progress is not estimated for paused DaemonSets or when a
+	// progress deadline is exceeded.
+	DaemonSetProgressing apps.DaemonSetConditionType = "Progressing"
+
+	// DaemonSetAvailableReason is added to the Progressing condition when all
+	// the pods of the latest template are scheduled and available.
+	DaemonSetAvailableReason = "DaemonSetAvailable"
+	// DaemonSetUpdatedReason is added to the Progressing condition while a
+	// rolling update is still replacing or making pods available.
+	DaemonSetUpdatedReason = "DaemonSetUpdated"
+	// TimedOutReason is added to the Progressing condition when the rollout
+	// made no progress for longer than the progress deadline.
+	TimedOutReason = "ProgressDeadlineExceeded"
+
+	// ProgressDeadlineAnnotation is the equivalent of the Deployment
+	// progressDeadlineSeconds field for DaemonSets: the number of seconds a
+	// rollout may fail to progress before the Progressing condition is marked
+	// False with reason ProgressDeadlineExceeded. No deadline is enforced when
+	// the annotation is absent or unparseable.
+	ProgressDeadlineAnnotation = "daemonset.kubernetes.io/progress-deadline-seconds"
+)
+
+// GetProgressDeadline returns the progress deadline configured via the
+// ProgressDeadlineAnnotation, and whether one is configured.
+func GetProgressDeadline(ds *apps.DaemonSet) (time.Duration, bool) {
+	annotation, found := ds.Annotations[ProgressDeadlineAnnotation]
+	if !found {
+		return 0, false
+	}
+	seconds, err := strconv.ParseInt(annotation, 10, 32)
+	if err != nil || seconds <= 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// NewDaemonSetCondition creates a new DaemonSet condition.
+func NewDaemonSetCondition(condType apps.DaemonSetConditionType, status v1.ConditionStatus, reason, message string) *apps.DaemonSetCondition {
+	return &apps.DaemonSetCondition{
+		Type:               condType,
+		Status:             status,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// GetDaemonSetCondition returns the condition with the provided type, if it exists.
+func GetDaemonSetCondition(status apps.DaemonSetStatus, condType apps.DaemonSetConditionType) *apps.DaemonSetCondition {
+	for i := range status.Conditions {
+		c := status.Conditions[i]
+		if c.Type == condType {
+			return &c
+		}
+	}
+	return nil
+}
+
+// SetDaemonSetCondition updates the DaemonSet status to include the provided
+// condition, replacing any condition of the same type. LastTransitionTime is
+// preserved when neither the status nor the message of the condition changes;
+// message changes count as transitions so that the Progressing condition's
+// LastTransitionTime reflects the last time the rollout made progress.
+func SetDaemonSetCondition(status *apps.DaemonSetStatus, condition apps.DaemonSetCondition) {
+	currentCond := GetDaemonSetCondition(*status, condition.Type)
+	if currentCond != nil && currentCond.Status == condition.Status && currentCond.Reason == condition.Reason && currentCond.Message == condition.Message {
+		return
+	}
+	if currentCond != nil && currentCond.Status == condition.Status && currentCond.Message == condition.Message {
+		condition.LastTransitionTime = currentCond.LastTransitionTime
+	}
+	newConditions := filterOutCondition(status.Conditions, condition.Type)
+	status.Conditions = append(newConditions, condition)
+}
+
+// filterOutCondition returns a new slice of DaemonSet conditions without the
+// conditions with the provided type.
+func filterOutCondition(conditions []apps.DaemonSetCondition, condType apps.DaemonSetConditionType) []apps.DaemonSetCondition {
+	var newConditions []apps.DaemonSetCondition
+	for _, c := range conditions {
+		if c.Type == condType {
+			continue
+		}
+		newConditions = append(newConditions, c)
+	}
+	return newConditions
+}
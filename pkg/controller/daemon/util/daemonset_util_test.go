@@ -20,7 +20,9 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
+	apps "k8s.io/api/apps/v1"
 	"k8s.io/api/core/v1"
 	extensions "k8s.io/api/extensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -588,3 +590,100 @@ func TestGetTargetNodeName(t *testing.T) {
 
 	forEachFeatureGate(t, testFun, features.ScheduleDaemonSetPods)
 }
+
+func TestSplitByAvailablePods(t *testing.T) {
+	now := metav1.Now()
+	makePod := func(name string, phase v1.PodPhase, startedAgo time.Duration, conditions []v1.PodCondition, gates []v1.PodReadinessGate) *v1.Pod {
+		pod := newPod(name, "node-0", nil)
+		pod.Spec.ReadinessGates = gates
+		pod.Status.Phase = phase
+		pod.Status.Conditions = conditions
+		started := metav1.NewTime(now.Add(-startedAgo))
+		pod.Status.StartTime = &started
+		return pod
+	}
+	readyConditions := []v1.PodCondition{
+		{Type: v1.ContainersReady, Status: v1.ConditionTrue},
+		{Type: v1.PodReady, Status: v1.ConditionTrue},
+	}
+	startingConditions := []v1.PodCondition{
+		{Type: v1.ContainersReady, Status: v1.ConditionFalse},
+		{Type: v1.PodReady, Status: v1.ConditionFalse},
+	}
+	gatedConditions := []v1.PodCondition{
+		{Type: v1.ContainersReady, Status: v1.ConditionTrue},
+		{Type: v1.PodReady, Status: v1.ConditionFalse},
+	}
+	gates := []v1.PodReadinessGate{{ConditionType: "example.com/ready"}}
+
+	tests := []struct {
+		name                string
+		startupGraceSeconds int32
+		pod                 *v1.Pod
+		wantAvailable       bool
+	}{
+		{
+			name:          "ready pod is available",
+			pod:           makePod("ready", v1.PodRunning, time.Minute, readyConditions, nil),
+			wantAvailable: true,
+		},
+		{
+			name:          "starting pod without grace is unavailable",
+			pod:           makePod("starting", v1.PodRunning, 10*time.Second, startingConditions, nil),
+			wantAvailable: false,
+		},
+		{
+			name:                "starting pod within grace is available",
+			startupGraceSeconds: 60,
+			pod:                 makePod("starting", v1.PodRunning, 10*time.Second, startingConditions, nil),
+			wantAvailable:       true,
+		},
+		{
+			name:                "starting pod past grace is unavailable",
+			startupGraceSeconds: 60,
+			pod:                 makePod("starting", v1.PodRunning, 2*time.Minute, startingConditions, nil),
+			wantAvailable:       false,
+		},
+		{
+			name:                "pod waiting on a readiness gate within grace is available",
+			startupGraceSeconds: 60,
+			pod:                 makePod("gated", v1.PodRunning, 10*time.Second, gatedConditions, gates),
+			wantAvailable:       true,
+		},
+		{
+			name:                "pending pod within grace is unavailable",
+			startupGraceSeconds: 60,
+			pod:                 makePod("pending", v1.PodPending, 10*time.Second, startingConditions, nil),
+			wantAvailable:       false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			available, unavailable := SplitByAvailablePods(0, test.startupGraceSeconds, []*v1.Pod{test.pod})
+			if gotAvailable := len(available) == 1; gotAvailable != test.wantAvailable {
+				t.Errorf("available = %v, unavailable = %v, want available %v", len(available), len(unavailable), test.wantAvailable)
+			}
+		})
+	}
+}
+
+func TestGetStartupGraceSeconds(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        int32
+	}{
+		{name: "no annotation", annotations: nil, want: 0},
+		{name: "valid annotation", annotations: map[string]string{StartupGraceSecondsAnnotation: "90"}, want: 90},
+		{name: "malformed annotation", annotations: map[string]string{StartupGraceSecondsAnnotation: "ninety"}, want: 0},
+		{name: "negative annotation", annotations: map[string]string{StartupGraceSecondsAnnotation: "-5"}, want: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ds := &apps.DaemonSet{ObjectMeta: metav1.ObjectMeta{Annotations: test.annotations}}
+			if got := GetStartupGraceSeconds(ds); got != test.want {
+				t.Errorf("got %d, want %d", got, test.want)
+			}
+		})
+	}
+}
@@ -26,7 +26,9 @@ import (
 	policy "k8s.io/api/policy/v1beta1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -41,6 +43,7 @@ import (
 	appsv1listers "k8s.io/client-go/listers/apps/v1"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	policylisters "k8s.io/client-go/listers/policy/v1beta1"
+	scaleclient "k8s.io/client-go/scale"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
@@ -86,6 +89,11 @@ type DisruptionController struct {
 	ssLister       appsv1listers.StatefulSetLister
 	ssListerSynced cache.InformerSynced
 
+	// restMapper and scaleNamespacer are used to resolve controllers that are
+	// not one of the built-in workload kinds through the /scale subresource.
+	restMapper      apimeta.RESTMapper
+	scaleNamespacer scaleclient.ScalesGetter
+
 	// PodDisruptionBudget keys that need to be synced.
 	queue        workqueue.RateLimitingInterface
 	recheckQueue workqueue.DelayingInterface
@@ -94,6 +102,11 @@ type DisruptionController struct {
 	recorder    record.EventRecorder
 
 	getUpdater func() updater
+
+	// stalePodDisruptionTimeout is how long an entry may stay in
+	// pdb.Status.DisruptedPods after the corresponding pod was expected to be
+	// deleted before the entry is considered stale and expired.
+	stalePodDisruptionTimeout time.Duration
 }
 
 // controllerAndScale is used to return (controller, scale) pairs from the
@@ -115,12 +128,35 @@ func NewDisruptionController(
 	dInformer appsv1informers.DeploymentInformer,
 	ssInformer appsv1informers.StatefulSetInformer,
 	kubeClient clientset.Interface,
+	restMapper apimeta.RESTMapper,
+	scaleNamespacer scaleclient.ScalesGetter,
+) *DisruptionController {
+	return NewDisruptionControllerWithTimeout(podInformer, pdbInformer, rcInformer, rsInformer, dInformer, ssInformer, kubeClient, restMapper, scaleNamespacer, DeletionTimeout)
+}
+
+// NewDisruptionControllerWithTimeout is NewDisruptionController with a
+// configurable timeout after which entries in pdb.Status.DisruptedPods are
+// considered stale and expired.
+func NewDisruptionControllerWithTimeout(
+	podInformer coreinformers.PodInformer,
+	pdbInformer policyinformers.PodDisruptionBudgetInformer,
+	rcInformer coreinformers.ReplicationControllerInformer,
+	rsInformer appsv1informers.ReplicaSetInformer,
+	dInformer appsv1informers.DeploymentInformer,
+	ssInformer appsv1informers.StatefulSetInformer,
+	kubeClient clientset.Interface,
+	restMapper apimeta.RESTMapper,
+	scaleNamespacer scaleclient.ScalesGetter,
+	stalePodDisruptionTimeout time.Duration,
 ) *DisruptionController {
 	dc := &DisruptionController{
-		kubeClient:   kubeClient,
-		queue:        workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "disruption"),
-		recheckQueue: workqueue.NewNamedDelayingQueue("disruption_recheck"),
-		broadcaster:  record.NewBroadcaster(),
+		kubeClient:                kubeClient,
+		queue:                     workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "disruption"),
+		recheckQueue:              workqueue.NewNamedDelayingQueue("disruption_recheck"),
+		broadcaster:               record.NewBroadcaster(),
+		restMapper:                restMapper,
+		scaleNamespacer:           scaleNamespacer,
+		stalePodDisruptionTimeout: stalePodDisruptionTimeout,
 	}
 	dc.recorder = dc.broadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: "controllermanager"})
 
@@ -169,7 +205,7 @@ func NewDisruptionController(
 // subresources.
 func (dc *DisruptionController) finders() []podControllerFinder {
 	return []podControllerFinder{dc.getPodReplicationController, dc.getPodDeployment, dc.getPodReplicaSet,
-		dc.getPodStatefulSet}
+		dc.getPodStatefulSet, dc.getPodScaleController}
 }
 
 var (
@@ -179,6 +215,46 @@ var (
 	controllerKindDep = v1beta1.SchemeGroupVersion.WithKind("Deployment")
 )
 
+// getPodScaleController resolves controllers that are not one of the built-in
+// workload kinds (e.g. pods owned by a custom resource) through the /scale
+// subresource, so that PDBs with maxUnavailable or a percentage minAvailable
+// work for operator-managed workloads.
+func (dc *DisruptionController) getPodScaleController(pod *v1.Pod) (*controllerAndScale, error) {
+	controllerRef := metav1.GetControllerOf(pod)
+	if controllerRef == nil {
+		return nil, nil
+	}
+	switch controllerRef.Kind {
+	case controllerKindRS.Kind, controllerKindSS.Kind, controllerKindRC.Kind, controllerKindDep.Kind:
+		// Handled by one of the lister-backed finders.
+		return nil, nil
+	}
+	if dc.restMapper == nil || dc.scaleNamespacer == nil {
+		return nil, nil
+	}
+	gv, err := schema.ParseGroupVersion(controllerRef.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	mapping, err := dc.restMapper.RESTMapping(schema.GroupKind{Group: gv.Group, Kind: controllerRef.Kind}, gv.Version)
+	if err != nil {
+		// The only possible error is a no-match error, which is ok here.
+		return nil, nil
+	}
+	gr := mapping.Resource.GroupResource()
+	scale, err := dc.scaleNamespacer.Scales(pod.Namespace).Get(gr, controllerRef.Name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if scale.UID != controllerRef.UID {
+		return nil, nil
+	}
+	return &controllerAndScale{scale.UID, scale.Spec.Replicas}, nil
+}
+
 // getPodReplicaSet finds a replicaset which has no matching deployments.
 func (dc *DisruptionController) getPodReplicaSet(pod *v1.Pod) (*controllerAndScale, error) {
 	controllerRef := metav1.GetControllerOf(pod)
@@ -652,7 +728,7 @@ func (dc *DisruptionController) buildDisruptedPodMap(pods []*v1.Pod, pdb *policy
 			// Pod not on the list.
 			continue
 		}
-		expectedDeletion := disruptionTime.Time.Add(DeletionTimeout)
+		expectedDeletion := disruptionTime.Time.Add(dc.stalePodDisruptionTimeout)
 		if expectedDeletion.Before(currentTime) {
 			klog.V(1).Infof("Pod %s/%s was expected to be deleted at %s but it wasn't, updating pdb %s/%s",
 				pod.Namespace, pod.Name, disruptionTime.String(), pdb.Namespace, pdb.Name)
@@ -676,9 +752,41 @@ func (dc *DisruptionController) buildDisruptedPodMap(pods []*v1.Pod, pdb *policy
 func (dc *DisruptionController) failSafe(pdb *policy.PodDisruptionBudget) error {
 	newPdb := pdb.DeepCopy()
 	newPdb.Status.PodDisruptionsAllowed = 0
+	newPdb.Status.Conditions = []policy.PodDisruptionBudgetCondition{{
+		Type:               policy.DisruptionAllowed,
+		Status:             v1.ConditionFalse,
+		Reason:             policy.SyncFailedReason,
+		Message:            "The controller failed to compute the number of allowed disruptions, so no disruptions are allowed.",
+		LastTransitionTime: metav1.Now(),
+	}}
 	return dc.getUpdater()(newPdb)
 }
 
+// buildDisruptionAllowedCondition returns the DisruptionAllowed condition for a
+// PDB permitting the given number of disruptions. The transition time of the
+// previous condition is kept when its status did not change, so consumers can
+// tell how long disruptions have been blocked.
+func buildDisruptionAllowedCondition(pdb *policy.PodDisruptionBudget, disruptionsAllowed int32) policy.PodDisruptionBudgetCondition {
+	cond := policy.PodDisruptionBudgetCondition{
+		Type:               policy.DisruptionAllowed,
+		Status:             v1.ConditionTrue,
+		Reason:             policy.SufficientPodsReason,
+		Message:            fmt.Sprintf("%d pods may be disrupted", disruptionsAllowed),
+		LastTransitionTime: metav1.Now(),
+	}
+	if disruptionsAllowed <= 0 {
+		cond.Status = v1.ConditionFalse
+		cond.Reason = policy.InsufficientPodsReason
+		cond.Message = "The number of healthy pods is at or below the desired healthy count; evicting any pod would violate the budget."
+	}
+	for _, old := range pdb.Status.Conditions {
+		if old.Type == cond.Type && old.Status == cond.Status {
+			cond.LastTransitionTime = old.LastTransitionTime
+		}
+	}
+	return cond
+}
+
 func (dc *DisruptionController) updatePdbStatus(pdb *policy.PodDisruptionBudget, currentHealthy, desiredHealthy, expectedCount int32,
 	disruptedPods map[string]metav1.Time) error {
 
@@ -691,11 +799,14 @@ func (dc *DisruptionController) updatePdbStatus(pdb *policy.PodDisruptionBudget,
 		disruptionsAllowed = 0
 	}
 
+	conditions := []policy.PodDisruptionBudgetCondition{buildDisruptionAllowedCondition(pdb, disruptionsAllowed)}
+
 	if pdb.Status.CurrentHealthy == currentHealthy &&
 		pdb.Status.DesiredHealthy == desiredHealthy &&
 		pdb.Status.ExpectedPods == expectedCount &&
 		pdb.Status.PodDisruptionsAllowed == disruptionsAllowed &&
 		apiequality.Semantic.DeepEqual(pdb.Status.DisruptedPods, disruptedPods) &&
+		apiequality.Semantic.DeepEqual(pdb.Status.Conditions, conditions) &&
 		pdb.Status.ObservedGeneration == pdb.Generation {
 		return nil
 	}
@@ -708,6 +819,7 @@ func (dc *DisruptionController) updatePdbStatus(pdb *policy.PodDisruptionBudget,
 		PodDisruptionsAllowed: disruptionsAllowed,
 		DisruptedPods:         disruptedPods,
 		ObservedGeneration:    pdb.Generation,
+		Conditions:            conditions,
 	}
 
 	return dc.getUpdater()(newPdb)
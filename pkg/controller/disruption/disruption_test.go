@@ -23,13 +23,20 @@ import (
 	"time"
 
 	apps "k8s.io/api/apps/v1"
+	autoscalingapi "k8s.io/api/autoscaling/v1"
 	"k8s.io/api/core/v1"
 	policy "k8s.io/api/policy/v1beta1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/client-go/informers"
+	scalefake "k8s.io/client-go/scale/fake"
+	core "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	_ "k8s.io/kubernetes/pkg/apis/core/install"
@@ -67,10 +74,25 @@ func (ps *pdbStates) VerifyPdbStatus(t *testing.T, key string, disruptionsAllowe
 		ObservedGeneration:    actualPDB.Generation,
 	}
 	actualStatus := actualPDB.Status
+	actualConditions := actualStatus.Conditions
+	actualStatus.Conditions = nil
 	if !apiequality.Semantic.DeepEqual(actualStatus, expectedStatus) {
 		debug.PrintStack()
 		t.Fatalf("PDB %q status mismatch.  Expected %+v but got %+v.", key, expectedStatus, actualStatus)
 	}
+
+	expectedConditionStatus := v1.ConditionTrue
+	if disruptionsAllowed <= 0 {
+		expectedConditionStatus = v1.ConditionFalse
+	}
+	if len(actualConditions) != 1 || actualConditions[0].Type != policy.DisruptionAllowed {
+		debug.PrintStack()
+		t.Fatalf("PDB %q expected a single DisruptionAllowed condition, got %+v.", key, actualConditions)
+	}
+	if actualConditions[0].Status != expectedConditionStatus {
+		debug.PrintStack()
+		t.Fatalf("PDB %q DisruptionAllowed condition mismatch.  Expected %v but got %+v.", key, expectedConditionStatus, actualConditions[0])
+	}
 }
 
 func (ps *pdbStates) VerifyDisruptionAllowed(t *testing.T, key string, disruptionsAllowed int32) {
@@ -90,6 +112,14 @@ type disruptionController struct {
 	rsStore  cache.Store
 	dStore   cache.Store
 	ssStore  cache.Store
+
+	scaleClient *scalefake.FakeScaleClient
+}
+
+var customGVK = schema.GroupVersionKind{
+	Group:   "custom.k8s.io",
+	Version: "v1",
+	Kind:    "customresource",
 }
 
 func newFakeDisruptionController() (*disruptionController, *pdbStates) {
@@ -97,6 +127,10 @@ func newFakeDisruptionController() (*disruptionController, *pdbStates) {
 
 	informerFactory := informers.NewSharedInformerFactory(nil, controller.NoResyncPeriodFunc())
 
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypeWithName(customGVK, &v1.Service{})
+	fakeScaleClient := &scalefake.FakeScaleClient{}
+
 	dc := NewDisruptionController(
 		informerFactory.Core().V1().Pods(),
 		informerFactory.Policy().V1beta1().PodDisruptionBudgets(),
@@ -105,6 +139,8 @@ func newFakeDisruptionController() (*disruptionController, *pdbStates) {
 		informerFactory.Apps().V1().Deployments(),
 		informerFactory.Apps().V1().StatefulSets(),
 		nil,
+		testrestmapper.TestOnlyStaticRESTMapper(scheme),
+		fakeScaleClient,
 	)
 	dc.getUpdater = func() updater { return ps.Set }
 	dc.podListerSynced = alwaysReady
@@ -122,6 +158,7 @@ func newFakeDisruptionController() (*disruptionController, *pdbStates) {
 		informerFactory.Apps().V1().ReplicaSets().Informer().GetStore(),
 		informerFactory.Apps().V1().Deployments().Informer().GetStore(),
 		informerFactory.Apps().V1().StatefulSets().Informer().GetStore(),
+		fakeScaleClient,
 	}, ps
 }
 
@@ -206,6 +243,13 @@ func updatePodOwnerToSs(t *testing.T, pod *v1.Pod, ss *apps.StatefulSet) {
 	pod.OwnerReferences = append(pod.OwnerReferences, controllerReference)
 }
 
+func updatePodOwnerToScaleController(t *testing.T, pod *v1.Pod, name string, uid types.UID) {
+	var controllerReference metav1.OwnerReference
+	var trueVar = true
+	controllerReference = metav1.OwnerReference{UID: uid, APIVersion: customGVK.GroupVersion().String(), Kind: customGVK.Kind, Name: name, Controller: &trueVar}
+	pod.OwnerReferences = append(pod.OwnerReferences, controllerReference)
+}
+
 func newPod(t *testing.T, name string) (*v1.Pod, string) {
 	pod := &v1.Pod{
 		TypeMeta: metav1.TypeMeta{APIVersion: "v1"},
@@ -616,6 +660,44 @@ func TestStatefulSetController(t *testing.T) {
 	}
 }
 
+func TestScaleResource(t *testing.T) {
+	customResourceUID := uuid.NewUUID()
+	replicas := int32(10)
+	pods := int32(4)
+	maxUnavailable := int32(5)
+
+	dc, ps := newFakeDisruptionController()
+
+	dc.scaleClient.AddReactor("get", "customresources", func(action core.Action) (handled bool, ret runtime.Object, err error) {
+		obj := &autoscalingapi.Scale{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				UID:       customResourceUID,
+			},
+			Spec: autoscalingapi.ScaleSpec{
+				Replicas: replicas,
+			},
+		}
+		return true, obj, nil
+	})
+
+	pdb, pdbName := newMaxUnavailablePodDisruptionBudget(t, intstr.FromInt(int(maxUnavailable)))
+	add(t, dc.pdbStore, pdb)
+
+	for i := int32(0); i < pods; i++ {
+		pod, _ := newPod(t, fmt.Sprintf("pod-%d", i))
+		updatePodOwnerToScaleController(t, pod, "customresource", customResourceUID)
+		add(t, dc.podStore, pod)
+	}
+
+	dc.sync(pdbName)
+	disruptionsAllowed := int32(0)
+	if replicas-pods < maxUnavailable {
+		disruptionsAllowed = maxUnavailable - (replicas - pods)
+	}
+	ps.VerifyPdbStatus(t, pdbName, disruptionsAllowed, pods, replicas-maxUnavailable, replicas, map[string]metav1.Time{})
+}
+
 func TestTwoControllers(t *testing.T) {
 	// Most of this test is in verifying intermediate cases as we define the
 	// three controllers and create the pods.
@@ -144,6 +144,40 @@ func FindEqualRevisions(revisions []*apps.ControllerRevision, needle *apps.Contr
 	return eq
 }
 
+// TruncateHistory deletes non-live ControllerRevisions in revisions, from oldest to newest, until at most limit
+// non-live revisions remain. A revision is considered live if its name is a key in live. Live revisions are never
+// deleted and do not count against limit, so that the revisions backing the current state of a controller are always
+// retained. h is used to perform the deletions, and parentKind identifies the kind of the owning controller for
+// metrics. If the returned error is nil, the truncation was successful.
+func TruncateHistory(
+	h Interface,
+	parentKind schema.GroupVersionKind,
+	revisions []*apps.ControllerRevision,
+	live map[string]bool,
+	limit int) error {
+	history := make([]*apps.ControllerRevision, 0, len(revisions))
+	// collect non-live revisions
+	for i := range revisions {
+		if !live[revisions[i].Name] {
+			history = append(history, revisions[i])
+		}
+	}
+	historyLen := len(history)
+	if historyLen <= limit {
+		return nil
+	}
+	// delete any non-live history, from oldest to newest, to maintain the revision limit.
+	SortControllerRevisions(history)
+	history = history[:(historyLen - limit)]
+	for i := 0; i < len(history); i++ {
+		if err := h.DeleteControllerRevision(history[i]); err != nil {
+			return err
+		}
+		revisionsPruned.WithLabelValues(parentKind.Kind).Inc()
+	}
+	return nil
+}
+
 // byRevision implements sort.Interface to allow ControllerRevisions to be sorted by Revision.
 type byRevision []*apps.ControllerRevision
 
@@ -202,6 +236,7 @@ type Interface interface {
 // NewHistory returns an instance of Interface that uses client to communicate with the API Server and lister to list
 // ControllerRevisions. This method should be used to create an Interface for all scenarios other than testing.
 func NewHistory(client clientset.Interface, lister appslisters.ControllerRevisionLister) Interface {
+	RegisterMetrics()
 	return &realHistory{client, lister}
 }
 
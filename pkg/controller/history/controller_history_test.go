@@ -1621,6 +1621,91 @@ func TestSortControllerRevisions(t *testing.T) {
 	}
 }
 
+func TestTruncateHistory(t *testing.T) {
+	type testcase struct {
+		name      string
+		revisions []*apps.ControllerRevision
+		live      map[string]bool
+		limit     int
+		want      map[string]bool
+	}
+	ss1 := newStatefulSet(3, "ss1", types.UID("ss1"), map[string]string{"foo": "bar"})
+	ss1.Status.CollisionCount = new(int32)
+	testFn := func(test *testcase, t *testing.T) {
+		t.Run(test.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
+
+			stop := make(chan struct{})
+			defer close(stop)
+			informerFactory.Start(stop)
+			informer := informerFactory.Apps().V1().ControllerRevisions()
+			informerFactory.WaitForCacheSync(stop)
+			history := NewFakeHistory(informer)
+			var collisionCount int32
+			for i := range test.revisions {
+				if _, err := history.CreateControllerRevision(ss1, test.revisions[i], &collisionCount); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := TruncateHistory(history, parentKind, test.revisions, test.live, test.limit); err != nil {
+				t.Errorf("%s: %s", test.name, err)
+			}
+			remaining, err := informer.Lister().List(labels.Everything())
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make(map[string]bool)
+			for i := range remaining {
+				got[remaining[i].Name] = true
+			}
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s: want %v got %v", test.name, test.want, got)
+			}
+		})
+	}
+	makeRevision := func(revision int64) *apps.ControllerRevision {
+		template := ss1.Spec.Template.DeepCopy()
+		template.Labels["revision"] = fmt.Sprintf("%d", revision)
+		cr, err := NewControllerRevision(ss1, parentKind, template.Labels, rawTemplate(template), revision, ss1.Status.CollisionCount)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cr.Namespace = ss1.Namespace
+		return cr
+	}
+	ss1Rev1 := makeRevision(1)
+	ss1Rev2 := makeRevision(2)
+	ss1Rev3 := makeRevision(3)
+	ss1Rev4 := makeRevision(4)
+	tests := []testcase{
+		{
+			name:      "does not delete at or below the limit",
+			revisions: []*apps.ControllerRevision{ss1Rev1, ss1Rev2, ss1Rev3},
+			live:      map[string]bool{},
+			limit:     3,
+			want:      map[string]bool{ss1Rev1.Name: true, ss1Rev2.Name: true, ss1Rev3.Name: true},
+		},
+		{
+			name:      "deletes the oldest non-live revisions first",
+			revisions: []*apps.ControllerRevision{ss1Rev1, ss1Rev2, ss1Rev3, ss1Rev4},
+			live:      map[string]bool{ss1Rev4.Name: true},
+			limit:     1,
+			want:      map[string]bool{ss1Rev3.Name: true, ss1Rev4.Name: true},
+		},
+		{
+			name:      "never deletes live revisions",
+			revisions: []*apps.ControllerRevision{ss1Rev1, ss1Rev2, ss1Rev3},
+			live:      map[string]bool{ss1Rev2.Name: true},
+			limit:     0,
+			want:      map[string]bool{ss1Rev2.Name: true},
+		},
+	}
+	for i := range tests {
+		testFn(&tests[i], t)
+	}
+}
+
 func newStatefulSet(replicas int, name string, uid types.UID, labels map[string]string) *apps.StatefulSet {
 	// Converting all the map-only selectors to set-based selectors.
 	var testMatchExpressions []metav1.LabelSelectorRequirement
@@ -0,0 +1,44 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// HistorySubsystem is the subsystem name used for ControllerRevision history metrics.
+const HistorySubsystem = "controller_history"
+
+var revisionsPruned = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: HistorySubsystem,
+		Name:      "revisions_pruned_total",
+		Help:      "Number of ControllerRevisions deleted to enforce a revision history limit, partitioned by the kind of the owning controller.",
+	},
+	[]string{"parent_kind"},
+)
+
+var registerMetrics sync.Once
+
+// RegisterMetrics registers the ControllerRevision history metrics.
+func RegisterMetrics() {
+	registerMetrics.Do(func() {
+		prometheus.MustRegister(revisionsPruned)
+	})
+}
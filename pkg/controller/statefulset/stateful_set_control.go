@@ -149,31 +149,13 @@ func (ssc *defaultStatefulSetControl) truncateHistory(
 	revisions []*apps.ControllerRevision,
 	current *apps.ControllerRevision,
 	update *apps.ControllerRevision) error {
-	history := make([]*apps.ControllerRevision, 0, len(revisions))
 	// mark all live revisions
 	live := map[string]bool{current.Name: true, update.Name: true}
 	for i := range pods {
 		live[getPodRevision(pods[i])] = true
 	}
-	// collect live revisions and historic revisions
-	for i := range revisions {
-		if !live[revisions[i].Name] {
-			history = append(history, revisions[i])
-		}
-	}
-	historyLen := len(history)
-	historyLimit := int(*set.Spec.RevisionHistoryLimit)
-	if historyLen <= historyLimit {
-		return nil
-	}
 	// delete any non-live history to maintain the revision limit.
-	history = history[:(historyLen - historyLimit)]
-	for i := 0; i < len(history); i++ {
-		if err := ssc.controllerHistory.DeleteControllerRevision(history[i]); err != nil {
-			return err
-		}
-	}
-	return nil
+	return history.TruncateHistory(ssc.controllerHistory, controllerKind, revisions, live, int(*set.Spec.RevisionHistoryLimit))
 }
 
 // getStatefulSetRevisions returns the current and update ControllerRevisions for set. It also
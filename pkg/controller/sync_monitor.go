@@ -0,0 +1,196 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/klog"
+)
+
+const (
+	// DefaultSyncDeadline is how long a single sync may run before the
+	// SyncMonitor considers the controller stuck.
+	DefaultSyncDeadline = 5 * time.Minute
+	// queueDepthGrowthThreshold is how many consecutive observations of
+	// monotonically growing queue depth mark the controller as wedged.
+	queueDepthGrowthThreshold = 10
+)
+
+var (
+	syncDurationMetric = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: "controller",
+			Name:      "sync_duration_seconds",
+			Help:      "Duration of a single controller sync, partitioned by controller name.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 4, 10),
+		},
+		[]string{"controller"},
+	)
+	stuckSyncsMetric = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: "controller",
+			Name:      "stuck_syncs",
+			Help:      "Number of syncs that have been running longer than the controller's sync deadline.",
+		},
+		[]string{"controller"},
+	)
+	queueDepthGrowthMetric = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: "controller",
+			Name:      "queue_depth_growth_streak",
+			Help:      "Number of consecutive observations in which the controller's work queue depth grew.",
+		},
+		[]string{"controller"},
+	)
+)
+
+var registerSyncMonitorMetrics sync.Once
+
+var (
+	syncMonitorsLock sync.Mutex
+	syncMonitors     []*SyncMonitor
+)
+
+// CheckSyncMonitors returns an error if any registered controller appears
+// wedged. It backs the controller manager's healthz so stuck controllers
+// surface in the component's status.
+func CheckSyncMonitors() error {
+	syncMonitorsLock.Lock()
+	monitors := make([]*SyncMonitor, len(syncMonitors))
+	copy(monitors, syncMonitors)
+	syncMonitorsLock.Unlock()
+	for _, m := range monitors {
+		if err := m.Healthy(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SyncMonitor is shared instrumentation for controllers that flags a
+// controller whose work queue depth grows monotonically or whose syncs exceed
+// a deadline, so wedged controllers surface in /metrics and health checks
+// instead of failing silently.
+type SyncMonitor struct {
+	name         string
+	syncDeadline time.Duration
+	clock        clock.Clock
+
+	lock              sync.Mutex
+	lastQueueDepth    int
+	depthGrowthStreak int
+	activeSyncs       map[string]time.Time
+}
+
+// NewSyncMonitor returns a monitor for the named controller. A zero
+// syncDeadline selects DefaultSyncDeadline.
+func NewSyncMonitor(name string, syncDeadline time.Duration) *SyncMonitor {
+	registerSyncMonitorMetrics.Do(func() {
+		prometheus.MustRegister(syncDurationMetric)
+		prometheus.MustRegister(stuckSyncsMetric)
+		prometheus.MustRegister(queueDepthGrowthMetric)
+	})
+	if syncDeadline == 0 {
+		syncDeadline = DefaultSyncDeadline
+	}
+	m := &SyncMonitor{
+		name:         name,
+		syncDeadline: syncDeadline,
+		clock:        clock.RealClock{},
+		activeSyncs:  map[string]time.Time{},
+	}
+	syncMonitorsLock.Lock()
+	syncMonitors = append(syncMonitors, m)
+	syncMonitorsLock.Unlock()
+	return m
+}
+
+// SyncStarted records the start of a sync for the given key and returns a
+// function the worker must call when the sync finishes.
+func (m *SyncMonitor) SyncStarted(key string) func() {
+	start := m.clock.Now()
+	m.lock.Lock()
+	m.activeSyncs[key] = start
+	m.lock.Unlock()
+
+	return func() {
+		m.lock.Lock()
+		delete(m.activeSyncs, key)
+		m.lock.Unlock()
+		syncDurationMetric.WithLabelValues(m.name).Observe(m.clock.Since(start).Seconds())
+	}
+}
+
+// ObserveQueueDepth records the current work queue depth. A depth that keeps
+// growing across observations means the workers cannot keep up or are wedged.
+func (m *SyncMonitor) ObserveQueueDepth(depth int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if depth > m.lastQueueDepth {
+		m.depthGrowthStreak++
+	} else {
+		m.depthGrowthStreak = 0
+	}
+	m.lastQueueDepth = depth
+	queueDepthGrowthMetric.WithLabelValues(m.name).Set(float64(m.depthGrowthStreak))
+}
+
+// stuckSyncs returns the keys of syncs that have been running longer than the
+// sync deadline.
+func (m *SyncMonitor) stuckSyncs() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var stuck []string
+	for key, start := range m.activeSyncs {
+		if m.clock.Since(start) > m.syncDeadline {
+			stuck = append(stuck, key)
+		}
+	}
+	return stuck
+}
+
+// Healthy returns an error if the controller appears wedged, either because a
+// sync has exceeded the deadline or because the queue depth has grown
+// monotonically for too long. It is meant to back a named healthz check so
+// the condition surfaces in the component's status.
+func (m *SyncMonitor) Healthy() error {
+	stuck := m.stuckSyncs()
+	stuckSyncsMetric.WithLabelValues(m.name).Set(float64(len(stuck)))
+	if len(stuck) > 0 {
+		return fmt.Errorf("controller %s has %d syncs exceeding the %v deadline (first: %q)", m.name, len(stuck), m.syncDeadline, stuck[0])
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.depthGrowthStreak >= queueDepthGrowthThreshold {
+		return fmt.Errorf("controller %s work queue depth has grown for %d consecutive observations (depth %d)", m.name, m.depthGrowthStreak, m.lastQueueDepth)
+	}
+	return nil
+}
+
+// LogIfStuck emits a warning for each stuck sync. Controllers typically call
+// this periodically from their Run loop.
+func (m *SyncMonitor) LogIfStuck() {
+	for _, key := range m.stuckSyncs() {
+		klog.Warningf("Controller %s: sync of %q has been running longer than %v; the controller may be wedged", m.name, key, m.syncDeadline)
+	}
+}
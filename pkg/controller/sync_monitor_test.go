@@ -0,0 +1,65 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/clock"
+)
+
+func TestSyncMonitorStuckSync(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Now())
+	monitor := NewSyncMonitor("test-stuck-sync", time.Minute)
+	monitor.clock = fakeClock
+
+	done := monitor.SyncStarted("default/foo")
+	if err := monitor.Healthy(); err != nil {
+		t.Errorf("expected healthy while the sync is within the deadline, got: %v", err)
+	}
+
+	fakeClock.Step(2 * time.Minute)
+	if err := monitor.Healthy(); err == nil {
+		t.Error("expected unhealthy after the sync exceeded the deadline")
+	}
+	if stuck := monitor.stuckSyncs(); len(stuck) != 1 || stuck[0] != "default/foo" {
+		t.Errorf("expected default/foo to be stuck, got %v", stuck)
+	}
+
+	done()
+	if err := monitor.Healthy(); err != nil {
+		t.Errorf("expected healthy after the sync finished, got: %v", err)
+	}
+}
+
+func TestSyncMonitorQueueDepthGrowth(t *testing.T) {
+	monitor := NewSyncMonitor("test-queue-depth", time.Minute)
+
+	for i := 0; i < queueDepthGrowthThreshold; i++ {
+		monitor.ObserveQueueDepth(i + 1)
+	}
+	if err := monitor.Healthy(); err == nil {
+		t.Error("expected unhealthy after the queue depth grew monotonically")
+	}
+
+	// Any observation where the depth does not grow resets the streak.
+	monitor.ObserveQueueDepth(1)
+	if err := monitor.Healthy(); err != nil {
+		t.Errorf("expected healthy after the queue depth stopped growing, got: %v", err)
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package kubeapiserver
 
 import (
+	"fmt"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -80,14 +81,22 @@ type StorageFactoryConfig struct {
 	DefaultStorageMediaType          string
 	Serializer                       runtime.StorageSerializer
 	ResourceEncodingOverrides        []schema.GroupVersionResource
+	StorageVersionOverrides          []string
 	EtcdServersOverrides             []string
+	EtcdServersOverrideCAFile        string
+	EtcdServersOverrideCertFile      string
+	EtcdServersOverrideKeyFile       string
 	EncryptionProviderConfigFilepath string
 }
 
 func (c *StorageFactoryConfig) Complete(etcdOptions *serveroptions.EtcdOptions) (*completedStorageFactoryConfig, error) {
 	c.StorageConfig = etcdOptions.StorageConfig
 	c.DefaultStorageMediaType = etcdOptions.DefaultStorageMediaType
+	c.StorageVersionOverrides = etcdOptions.StorageVersionOverrides
 	c.EtcdServersOverrides = etcdOptions.EtcdServersOverrides
+	c.EtcdServersOverrideCAFile = etcdOptions.EtcdServersOverrideCAFile
+	c.EtcdServersOverrideCertFile = etcdOptions.EtcdServersOverrideCertFile
+	c.EtcdServersOverrideKeyFile = etcdOptions.EtcdServersOverrideKeyFile
 	c.EncryptionProviderConfigFilepath = etcdOptions.EncryptionProviderConfigFilepath
 	return &completedStorageFactoryConfig{c}, nil
 }
@@ -97,7 +106,13 @@ type completedStorageFactoryConfig struct {
 }
 
 func (c *completedStorageFactoryConfig) New() (*serverstorage.DefaultStorageFactory, error) {
-	resourceEncodingConfig := resourceconfig.MergeResourceEncodingConfigs(c.DefaultResourceEncoding, c.ResourceEncodingOverrides)
+	// Apply the configured per-resource storage version pins after the
+	// compiled-in overrides, so that they take precedence.
+	pinnedVersions, err := ParseStorageVersionOverrides(c.StorageVersionOverrides)
+	if err != nil {
+		return nil, err
+	}
+	resourceEncodingConfig := resourceconfig.MergeResourceEncodingConfigs(c.DefaultResourceEncoding, append(c.ResourceEncodingOverrides, pinnedVersions...))
 	storageFactory := serverstorage.NewDefaultStorageFactory(
 		c.StorageConfig,
 		c.DefaultStorageMediaType,
@@ -123,7 +138,22 @@ func (c *completedStorageFactoryConfig) New() (*serverstorage.DefaultStorageFact
 		groupResource := schema.GroupResource{Group: group, Resource: resource}
 
 		servers := strings.Split(tokens[1], ";")
-		storageFactory.SetEtcdLocation(groupResource, servers)
+		if len(c.EtcdServersOverrideCAFile) > 0 || len(c.EtcdServersOverrideCertFile) > 0 || len(c.EtcdServersOverrideKeyFile) > 0 {
+			// The override cluster has its own credentials, so the whole
+			// transport is replaced, not just the server list.
+			transport := c.StorageConfig.Transport
+			transport.ServerList = servers
+			if len(c.EtcdServersOverrideCAFile) > 0 {
+				transport.CAFile = c.EtcdServersOverrideCAFile
+			}
+			if len(c.EtcdServersOverrideCertFile) > 0 {
+				transport.CertFile = c.EtcdServersOverrideCertFile
+				transport.KeyFile = c.EtcdServersOverrideKeyFile
+			}
+			storageFactory.SetEtcdTransport(groupResource, transport)
+		} else {
+			storageFactory.SetEtcdLocation(groupResource, servers)
+		}
 	}
 	if len(c.EncryptionProviderConfigFilepath) != 0 {
 		transformerOverrides, err := encryptionconfig.GetTransformerOverrides(c.EncryptionProviderConfigFilepath)
@@ -136,3 +166,24 @@ func (c *completedStorageFactoryConfig) New() (*serverstorage.DefaultStorageFact
 	}
 	return storageFactory, nil
 }
+
+// ParseStorageVersionOverrides converts --storage-version-overrides values of
+// the form group/resource#version into GroupVersionResources understood by the
+// resource encoding config. The group is empty for the core API.
+func ParseStorageVersionOverrides(overrides []string) ([]schema.GroupVersionResource, error) {
+	parsed := make([]schema.GroupVersionResource, 0, len(overrides))
+	for _, override := range overrides {
+		tokens := strings.Split(override, "#")
+		if len(tokens) != 2 || len(tokens[1]) == 0 {
+			return nil, fmt.Errorf("invalid storage version override %q, must be of format: group/resource#version", override)
+		}
+
+		apiresource := strings.Split(tokens[0], "/")
+		if len(apiresource) != 2 || len(apiresource[1]) == 0 {
+			return nil, fmt.Errorf("invalid storage version override %q, must be of format: group/resource#version", override)
+		}
+
+		parsed = append(parsed, schema.GroupVersionResource{Group: apiresource[0], Resource: apiresource[1], Version: tokens[1]})
+	}
+	return parsed, nil
+}
@@ -208,3 +208,51 @@ func TestParseRuntimeConfig(t *testing.T) {
 		}
 	}
 }
+
+func TestParseStorageVersionOverrides(t *testing.T) {
+	testCases := []struct {
+		overrides []string
+		expected  []schema.GroupVersionResource
+		err       bool
+	}{
+		{
+			overrides: nil,
+			expected:  []schema.GroupVersionResource{},
+		},
+		{
+			overrides: []string{"batch/cronjobs#v1beta1", "/endpoints#v1"},
+			expected: []schema.GroupVersionResource{
+				{Group: "batch", Resource: "cronjobs", Version: "v1beta1"},
+				{Group: "", Resource: "endpoints", Version: "v1"},
+			},
+		},
+		{
+			overrides: []string{"batch/cronjobs"},
+			err:       true,
+		},
+		{
+			overrides: []string{"cronjobs#v1beta1"},
+			err:       true,
+		},
+		{
+			overrides: []string{"batch/#v1beta1"},
+			err:       true,
+		},
+	}
+	for _, test := range testCases {
+		parsed, err := ParseStorageVersionOverrides(test.overrides)
+		if test.err {
+			if err == nil {
+				t.Errorf("expected error parsing %v, got none", test.overrides)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error parsing %v: %v", test.overrides, err)
+			continue
+		}
+		if !reflect.DeepEqual(test.expected, parsed) {
+			t.Errorf("expected %v, got %v", test.expected, parsed)
+		}
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package apply
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -31,25 +32,32 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
 	"k8s.io/apimachinery/pkg/util/mergepatch"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/printers"
 	"k8s.io/cli-runtime/pkg/resource"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
 	"k8s.io/klog"
 	oapi "k8s.io/kube-openapi/pkg/util/proto"
 	"k8s.io/kubernetes/pkg/kubectl"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/delete"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/util/openapi"
+	openapivalidation "k8s.io/kubernetes/pkg/kubectl/cmd/util/openapi/validation"
+	"k8s.io/kubernetes/pkg/kubectl/polymorphichelpers"
 	"k8s.io/kubernetes/pkg/kubectl/scheme"
 	"k8s.io/kubernetes/pkg/kubectl/util/i18n"
 	"k8s.io/kubernetes/pkg/kubectl/util/templates"
@@ -79,6 +87,8 @@ type ApplyOptions struct {
 	Overwrite       bool
 	OpenAPIPatch    bool
 	PruneWhitelist  []string
+	WaitForRollout  bool
+	OfflineSchema   string
 
 	Validator       validation.Schema
 	Builder         *resource.Builder
@@ -122,6 +132,9 @@ var (
 		# Apply the JSON passed into stdin to a pod.
 		cat pod.json | kubectl apply -f -
 
+		# Apply the configuration in manifest.yaml and wait up to five minutes for the rollout of the workloads it contains to complete.
+		kubectl apply -f manifest.yaml --wait-for-rollout --timeout=5m
+
 		# Note: --prune is still in Alpha
 		# Apply the configuration in manifest.yaml that matches label app=nginx and delete all the other resources that are not in the file and match label app=nginx.
 		kubectl apply --prune -f manifest.yaml -l app=nginx
@@ -181,6 +194,8 @@ func NewCmdApply(baseName string, f cmdutil.Factory, ioStreams genericclioptions
 	cmd.Flags().BoolVar(&o.All, "all", o.All, "Select all resources in the namespace of the specified resource types.")
 	cmd.Flags().StringArrayVar(&o.PruneWhitelist, "prune-whitelist", o.PruneWhitelist, "Overwrite the default whitelist with <group/version/kind> for --prune")
 	cmd.Flags().BoolVar(&o.OpenAPIPatch, "openapi-patch", o.OpenAPIPatch, "If true, use openapi to calculate diff when the openapi presents and the resource can be found in the openapi spec. Otherwise, fall back to use baked-in types.")
+	cmd.Flags().BoolVar(&o.WaitForRollout, "wait-for-rollout", o.WaitForRollout, "If true, wait for the rollout of any applied deployments, daemon sets and stateful sets to complete before returning. The total time spent waiting is bounded by --timeout.")
+	cmd.Flags().StringVar(&o.OfflineSchema, "offline-schema", o.OfflineSchema, "If set, validate resources against the openapi schema stored in this directory by 'kubectl openapi fetch' instead of the schema served by the cluster.")
 	cmd.Flags().BoolVar(&o.ServerDryRun, "server-dry-run", o.ServerDryRun, "If true, request will be sent to server with dry-run flag, which means the modifications won't be persisted. This is an alpha feature and flag.")
 	cmd.Flags().Bool("dry-run", false, "If true, only print the object that would be sent, without sending it. Warning: --dry-run cannot accurately output the result of merging the local manifest and the server-side data. Use --server-dry-run to get the merged result instead.")
 	cmdutil.AddIncludeUninitializedFlag(cmd)
@@ -201,11 +216,11 @@ func (o *ApplyOptions) Complete(f cmdutil.Factory, cmd *cobra.Command) error {
 	o.DryRun = cmdutil.GetDryRunFlag(cmd)
 
 	if o.ForceConflicts && !o.ServerSideApply {
-		return fmt.Errorf("--experimental-force-conflicts only works with --experimental-server-side")
+		return fmt.Errorf("--force-conflicts only works with --server-side")
 	}
 
 	if o.DryRun && o.ServerSideApply {
-		return fmt.Errorf("--dry-run doesn't work with --experimental-server-side (did you mean --server-dry-run instead?)")
+		return fmt.Errorf("--dry-run doesn't work with --server-side (did you mean --server-dry-run instead?)")
 	}
 
 	if o.DryRun && o.ServerDryRun {
@@ -248,6 +263,19 @@ func (o *ApplyOptions) Complete(f cmdutil.Factory, cmd *cobra.Command) error {
 
 	o.OpenAPISchema, _ = f.OpenAPISchema()
 	o.Validator, err = f.Validator(cmdutil.GetFlagBool(cmd, "validate"))
+	if err != nil {
+		return err
+	}
+	if len(o.OfflineSchema) > 0 {
+		resources, err := openapi.NewLocalSchemaGetter(o.OfflineSchema).Get()
+		if err != nil {
+			return err
+		}
+		o.Validator = validation.ConjunctiveSchema{
+			openapivalidation.NewSchemaValidation(resources),
+			validation.NoDoubleKeySchema{},
+		}
+	}
 	o.Builder = f.NewBuilder()
 	o.Mapper, err = f.ToRESTMapper()
 	if err != nil {
@@ -366,6 +394,7 @@ func (o *ApplyOptions) Run() error {
 	visitedNamespaces := sets.NewString()
 
 	var objs []runtime.Object
+	var rolloutInfos []*resource.Info
 
 	count := 0
 	err = r.Visit(func(info *resource.Info, err error) error {
@@ -384,6 +413,13 @@ func (o *ApplyOptions) Run() error {
 			visitedNamespaces.Insert(info.Namespace)
 		}
 
+		// Remember workload objects whose rollout we were asked to wait for.
+		if o.WaitForRollout && !o.DryRun && !o.ServerDryRun {
+			if _, err := polymorphichelpers.StatusViewerFn(info.Mapping); err == nil {
+				rolloutInfos = append(rolloutInfos, info)
+			}
+		}
+
 		if err := o.Recorder.Record(info.Object); err != nil {
 			klog.V(4).Infof("error recording current command: %v", err)
 		}
@@ -426,6 +462,22 @@ func (o *ApplyOptions) Run() error {
 				}
 				return printer.PrintObj(info.Object, o.Out)
 			} else if !isIncompatibleServerError(err) {
+				if errors.IsConflict(err) {
+					// The server reports which fields conflict and which
+					// manager owns them, tell the user how to proceed.
+					return fmt.Errorf(`%v
+Please review the fields above--they currently have other managers. Here
+are the ways you can resolve this warning:
+* If you intend to manage all of these fields, please re-run the apply
+  command with the `+"`--force-conflicts`"+` flag.
+* If you do not intend to manage all of the fields, please edit your
+  manifest to remove references to the fields that should keep their
+  current managers.
+* You may co-own fields by updating your manifest to match the existing
+  value; in this case, you'll become the manager if the other manager(s)
+  stop managing the field (remove it from their configuration).
+See http://k8s.io/docs/reference/using-api/api-concepts/#conflicts`, err)
+				}
 				return err
 			}
 			// If we're talking to a server which does not implement server-side apply,
@@ -582,7 +634,7 @@ func (o *ApplyOptions) Run() error {
 	}
 
 	if !o.Prune {
-		return nil
+		return o.waitForRollouts(rolloutInfos)
 	}
 
 	p := pruner{
@@ -620,7 +672,69 @@ func (o *ApplyOptions) Run() error {
 		}
 	}
 
-	return nil
+	return o.waitForRollouts(rolloutInfos)
+}
+
+// waitForRollouts waits until the rollout of every given workload object has
+// completed, watching them one by one the same way 'rollout status' does. The
+// timeout, if any, bounds the total time spent waiting, and every object that
+// did not finish rolling out contributes to the returned aggregate error.
+func (o *ApplyOptions) waitForRollouts(infos []*resource.Info) error {
+	if len(infos) == 0 {
+		return nil
+	}
+
+	ctx, cancel := watchtools.ContextWithOptionalTimeout(context.Background(), o.DeleteOptions.Timeout)
+	defer cancel()
+
+	var errs []error
+	for _, info := range infos {
+		if err := o.waitForRollout(ctx, info); err != nil {
+			errs = append(errs, fmt.Errorf("%s %q rollout failed: %v", info.Mapping.GroupVersionKind.Kind, info.Name, err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// waitForRollout watches a single workload object until its rollout is done,
+// printing status transitions as they happen.
+func (o *ApplyOptions) waitForRollout(ctx context.Context, info *resource.Info) error {
+	statusViewer, err := polymorphichelpers.StatusViewerFn(info.Mapping)
+	if err != nil {
+		return err
+	}
+
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", info.Name).String()
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = fieldSelector
+			return o.DynamicClient.Resource(info.Mapping.Resource).Namespace(info.Namespace).List(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return o.DynamicClient.Resource(info.Mapping.Resource).Namespace(info.Namespace).Watch(options)
+		},
+	}
+
+	_, err = watchtools.UntilWithSync(ctx, lw, &unstructured.Unstructured{}, nil, func(e watch.Event) (bool, error) {
+		switch t := e.Type; t {
+		case watch.Added, watch.Modified:
+			status, done, err := statusViewer.Status(e.Object.(runtime.Unstructured), 0)
+			if err != nil {
+				return false, err
+			}
+			fmt.Fprintf(o.Out, "%s", status)
+			return done, nil
+
+		case watch.Deleted:
+			// We need to abort to avoid cases of recreation and not to silently watch the wrong (new) object
+			return true, fmt.Errorf("object has been deleted")
+
+		default:
+			return true, fmt.Errorf("internal error: unexpected event %#v", e)
+		}
+	})
+	return err
 }
 
 type pruneResource struct {
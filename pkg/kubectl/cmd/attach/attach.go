@@ -152,17 +152,30 @@ type DefaultRemoteAttach struct{}
 
 // Attach executes attach to a running container
 func (*DefaultRemoteAttach) Attach(method string, url *url.URL, config *restclient.Config, stdin io.Reader, stdout, stderr io.Writer, tty bool, terminalSizeQueue remotecommand.TerminalSizeQueue) error {
-	exec, err := remotecommand.NewSPDYExecutor(config, method, url)
+	spdyExec, err := remotecommand.NewSPDYExecutor(config, method, url)
 	if err != nil {
 		return err
 	}
-	return exec.Stream(remotecommand.StreamOptions{
+	streamOptions := remotecommand.StreamOptions{
 		Stdin:             stdin,
 		Stdout:            stdout,
 		Stderr:            stderr,
 		Tty:               tty,
 		TerminalSizeQueue: terminalSizeQueue,
-	})
+	}
+	err = spdyExec.Stream(streamOptions)
+	if err == nil || !exec.IsSPDYUpgradeFailure(err) {
+		return err
+	}
+	// Proxies that do not pass SPDY through reject the upgrade before any
+	// stream is established, so the whole attach can be retried over
+	// WebSockets.
+	klog.V(2).Infof("SPDY upgrade failed (%v), falling back to the WebSocket transport", err)
+	wsExec, wsErr := remotecommand.NewWebSocketExecutor(config, url)
+	if wsErr != nil {
+		return err
+	}
+	return wsExec.Stream(streamOptions)
 }
 
 // Complete verifies command line arguments and loads data from the command environment
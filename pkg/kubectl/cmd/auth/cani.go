@@ -365,7 +365,7 @@ func printAccessHeaders(out io.Writer) error {
 
 func printAccess(out io.Writer, rules []rbacv1.PolicyRule) error {
 	for _, r := range rules {
-		if _, err := fmt.Fprintf(out, "%s\t%v\t%v\t%v\n", describeutil.CombineResourceGroup(r.Resources, r.APIGroups), r.NonResourceURLs, r.ResourceNames, r.Verbs); err != nil {
+		if _, err := fmt.Fprintf(out, "%s\t%s\t%s\t%s\n", describeutil.CombineResourceGroup(r.Resources, r.APIGroups), strings.Join(r.NonResourceURLs, ","), strings.Join(r.ResourceNames, ","), strings.Join(r.Verbs, ",")); err != nil {
 			return err
 		}
 	}
@@ -188,11 +188,11 @@ func TestRunAccessCheck(t *testing.T) {
 func TestRunAccessList(t *testing.T) {
 	t.Run("test access list", func(t *testing.T) {
 		options := &CanIOptions{List: true}
-		expectedOutput := "Resources   Non-Resource URLs   Resource Names    Verbs\n" +
-			"job.*       []                  [test-resource]   [get list]\n" +
-			"pod.*       []                  [test-resource]   [get list]\n" +
-			"            [/apis/*]           []                [get]\n" +
-			"            [/version]          []                [get]\n"
+		expectedOutput := "Resources   Non-Resource URLs   Resource Names   Verbs\n" +
+			"job.*                           test-resource    get,list\n" +
+			"pod.*                           test-resource    get,list\n" +
+			"            /apis/*                              get\n" +
+			"            /version                             get\n"
 
 		tf := cmdtesting.NewTestFactory().WithNamespace("test")
 		defer tf.Cleanup()
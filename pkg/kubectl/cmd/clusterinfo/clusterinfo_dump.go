@@ -17,6 +17,9 @@ limitations under the License.
 package clusterinfo
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"fmt"
 	"io"
 	"os"
@@ -48,8 +51,13 @@ type ClusterInfoDumpOptions struct {
 	PrintObj   printers.ResourcePrinterFunc
 
 	OutputDir     string
+	OutputArchive string
 	AllNamespaces bool
 	Namespaces    []string
+	Redact        bool
+	LogLimitBytes int64
+
+	archive *dumpArchive
 
 	Timeout          time.Duration
 	AppsClient       appsv1client.AppsV1Interface
@@ -84,6 +92,9 @@ func NewCmdClusterInfoDump(f cmdutil.Factory, ioStreams genericclioptions.IOStre
 	cmd.Flags().StringVar(&o.OutputDir, "output-directory", o.OutputDir, i18n.T("Where to output the files.  If empty or '-' uses stdout, otherwise creates a directory hierarchy in that directory"))
 	cmd.Flags().StringSliceVar(&o.Namespaces, "namespaces", o.Namespaces, "A comma separated list of namespaces to dump.")
 	cmd.Flags().BoolVarP(&o.AllNamespaces, "all-namespaces", "A", o.AllNamespaces, "If true, dump all namespaces.  If true, --namespaces is ignored.")
+	cmd.Flags().StringVar(&o.OutputArchive, "output-archive", o.OutputArchive, i18n.T("Path of a tar.gz file to write the dump to. If set, --output-directory is ignored."))
+	cmd.Flags().BoolVar(&o.Redact, "redact", o.Redact, "If true, replace the values of known sensitive annotations on dumped objects with a placeholder.")
+	cmd.Flags().Int64Var(&o.LogLimitBytes, "log-limit-bytes", o.LogLimitBytes, "Maximum number of log bytes to dump per container. Zero means no limit.")
 	cmdutil.AddPodRunningTimeoutFlag(cmd, defaultPodLogsTimeout)
 	return cmd
 }
@@ -109,9 +120,82 @@ var (
     kubectl cluster-info dump --all-namespaces
 
     # Dump a set of namespaces to /path/to/cluster-state
-    kubectl cluster-info dump --namespaces default,kube-system --output-directory=/path/to/cluster-state`))
+    kubectl cluster-info dump --namespaces default,kube-system --output-directory=/path/to/cluster-state
+
+    # Dump all namespaces to a compressed archive, with sensitive annotations redacted
+    kubectl cluster-info dump --all-namespaces --redact --output-archive=/path/to/cluster-state.tar.gz`))
 )
 
+// sensitiveAnnotations lists annotation keys whose values are replaced in the
+// dump when --redact is set. The last-applied-configuration annotation can
+// embed complete objects, including Secret data.
+var sensitiveAnnotations = []string{
+	"kubectl.kubernetes.io/last-applied-configuration",
+	"kubernetes.io/service-account.token",
+}
+
+const redactedPlaceholder = "<redacted>"
+
+func redactAnnotations(annotations map[string]string) {
+	for _, key := range sensitiveAnnotations {
+		if _, ok := annotations[key]; ok {
+			annotations[key] = redactedPlaceholder
+		}
+	}
+}
+
+// dumpArchive buffers dump files in memory and writes them out as a tar.gz
+// archive when the dump completes.
+type dumpArchive struct {
+	names   []string
+	entries map[string]*bytes.Buffer
+}
+
+func newDumpArchive() *dumpArchive {
+	return &dumpArchive{entries: map[string]*bytes.Buffer{}}
+}
+
+func (a *dumpArchive) entry(filename string) io.Writer {
+	if buf, ok := a.entries[filename]; ok {
+		return buf
+	}
+	buf := &bytes.Buffer{}
+	a.names = append(a.names, filename)
+	a.entries[filename] = buf
+	return buf
+}
+
+func (a *dumpArchive) write(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gzWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzWriter)
+	now := time.Now()
+	for _, name := range a.names {
+		buf := a.entries[name]
+		hdr := &tar.Header{
+			Name:    name,
+			Mode:    0644,
+			Size:    int64(buf.Len()),
+			ModTime: now,
+		}
+		if err := tarWriter.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := io.Copy(tarWriter, buf); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzWriter.Close()
+}
+
 func setupOutputWriter(dir string, defaultWriter io.Writer, filename string) io.Writer {
 	if len(dir) == 0 || dir == "-" {
 		return defaultWriter
@@ -164,13 +248,31 @@ func (o *ClusterInfoDumpOptions) Complete(f cmdutil.Factory, cmd *cobra.Command)
 	return nil
 }
 
+// setupWriter returns the writer the given dump file should be written to,
+// honoring the archive output when one was requested.
+func (o *ClusterInfoDumpOptions) setupWriter(filename string) io.Writer {
+	if o.archive != nil {
+		return o.archive.entry(filename)
+	}
+	return setupOutputWriter(o.OutputDir, o.Out, filename)
+}
+
 func (o *ClusterInfoDumpOptions) Run() error {
+	if len(o.OutputArchive) > 0 {
+		o.archive = newDumpArchive()
+	}
+
 	nodes, err := o.CoreClient.Nodes().List(metav1.ListOptions{})
 	if err != nil {
 		return err
 	}
 
-	if err := o.PrintObj(nodes, setupOutputWriter(o.OutputDir, o.Out, "nodes.json")); err != nil {
+	if o.Redact {
+		for ix := range nodes.Items {
+			redactAnnotations(nodes.Items[ix].Annotations)
+		}
+	}
+	if err := o.PrintObj(nodes, o.setupWriter("nodes.json")); err != nil {
 		return err
 	}
 
@@ -183,12 +285,12 @@ func (o *ClusterInfoDumpOptions) Run() error {
 		for ix := range namespaceList.Items {
 			namespaces = append(namespaces, namespaceList.Items[ix].Name)
 		}
+	} else if len(o.Namespaces) > 0 {
+		namespaces = o.Namespaces
 	} else {
-		if len(o.Namespaces) == 0 {
-			namespaces = []string{
-				metav1.NamespaceSystem,
-				o.Namespace,
-			}
+		namespaces = []string{
+			metav1.NamespaceSystem,
+			o.Namespace,
 		}
 	}
 	for _, namespace := range namespaces {
@@ -198,7 +300,7 @@ func (o *ClusterInfoDumpOptions) Run() error {
 		if err != nil {
 			return err
 		}
-		if err := o.PrintObj(events, setupOutputWriter(o.OutputDir, o.Out, path.Join(namespace, "events.json"))); err != nil {
+		if err := o.PrintObj(events, o.setupWriter(path.Join(namespace, "events.json"))); err != nil {
 			return err
 		}
 
@@ -206,7 +308,12 @@ func (o *ClusterInfoDumpOptions) Run() error {
 		if err != nil {
 			return err
 		}
-		if err := o.PrintObj(rcs, setupOutputWriter(o.OutputDir, o.Out, path.Join(namespace, "replication-controllers.json"))); err != nil {
+		if o.Redact {
+			for ix := range rcs.Items {
+				redactAnnotations(rcs.Items[ix].Annotations)
+			}
+		}
+		if err := o.PrintObj(rcs, o.setupWriter(path.Join(namespace, "replication-controllers.json"))); err != nil {
 			return err
 		}
 
@@ -214,7 +321,12 @@ func (o *ClusterInfoDumpOptions) Run() error {
 		if err != nil {
 			return err
 		}
-		if err := o.PrintObj(svcs, setupOutputWriter(o.OutputDir, o.Out, path.Join(namespace, "services.json"))); err != nil {
+		if o.Redact {
+			for ix := range svcs.Items {
+				redactAnnotations(svcs.Items[ix].Annotations)
+			}
+		}
+		if err := o.PrintObj(svcs, o.setupWriter(path.Join(namespace, "services.json"))); err != nil {
 			return err
 		}
 
@@ -222,7 +334,12 @@ func (o *ClusterInfoDumpOptions) Run() error {
 		if err != nil {
 			return err
 		}
-		if err := o.PrintObj(sets, setupOutputWriter(o.OutputDir, o.Out, path.Join(namespace, "daemonsets.json"))); err != nil {
+		if o.Redact {
+			for ix := range sets.Items {
+				redactAnnotations(sets.Items[ix].Annotations)
+			}
+		}
+		if err := o.PrintObj(sets, o.setupWriter(path.Join(namespace, "daemonsets.json"))); err != nil {
 			return err
 		}
 
@@ -230,7 +347,12 @@ func (o *ClusterInfoDumpOptions) Run() error {
 		if err != nil {
 			return err
 		}
-		if err := o.PrintObj(deps, setupOutputWriter(o.OutputDir, o.Out, path.Join(namespace, "deployments.json"))); err != nil {
+		if o.Redact {
+			for ix := range deps.Items {
+				redactAnnotations(deps.Items[ix].Annotations)
+			}
+		}
+		if err := o.PrintObj(deps, o.setupWriter(path.Join(namespace, "deployments.json"))); err != nil {
 			return err
 		}
 
@@ -238,7 +360,12 @@ func (o *ClusterInfoDumpOptions) Run() error {
 		if err != nil {
 			return err
 		}
-		if err := o.PrintObj(rps, setupOutputWriter(o.OutputDir, o.Out, path.Join(namespace, "replicasets.json"))); err != nil {
+		if o.Redact {
+			for ix := range rps.Items {
+				redactAnnotations(rps.Items[ix].Annotations)
+			}
+		}
+		if err := o.PrintObj(rps, o.setupWriter(path.Join(namespace, "replicasets.json"))); err != nil {
 			return err
 		}
 
@@ -247,7 +374,12 @@ func (o *ClusterInfoDumpOptions) Run() error {
 			return err
 		}
 
-		if err := o.PrintObj(pods, setupOutputWriter(o.OutputDir, o.Out, path.Join(namespace, "pods.json"))); err != nil {
+		if o.Redact {
+			for ix := range pods.Items {
+				redactAnnotations(pods.Items[ix].Annotations)
+			}
+		}
+		if err := o.PrintObj(pods, o.setupWriter(path.Join(namespace, "pods.json"))); err != nil {
 			return err
 		}
 
@@ -255,7 +387,11 @@ func (o *ClusterInfoDumpOptions) Run() error {
 			writer.Write([]byte(fmt.Sprintf("==== START logs for container %s of pod %s/%s ====\n", container.Name, pod.Namespace, pod.Name)))
 			defer writer.Write([]byte(fmt.Sprintf("==== END logs for container %s of pod %s/%s ====\n", container.Name, pod.Namespace, pod.Name)))
 
-			requests, err := o.LogsForObject(o.RESTClientGetter, pod, &corev1.PodLogOptions{Container: container.Name}, timeout, false)
+			logOptions := &corev1.PodLogOptions{Container: container.Name}
+			if o.LogLimitBytes > 0 {
+				logOptions.LimitBytes = &o.LogLimitBytes
+			}
+			requests, err := o.LogsForObject(o.RESTClientGetter, pod, logOptions, timeout, false)
 			if err != nil {
 				// Print error and return.
 				writer.Write([]byte(fmt.Sprintf("Create log request error: %s\n", err.Error())))
@@ -276,7 +412,7 @@ func (o *ClusterInfoDumpOptions) Run() error {
 		for ix := range pods.Items {
 			pod := &pods.Items[ix]
 			containers := pod.Spec.Containers
-			writer := setupOutputWriter(o.OutputDir, o.Out, path.Join(namespace, pod.Name, "logs.txt"))
+			writer := o.setupWriter(path.Join(namespace, pod.Name, "logs.txt"))
 
 			for i := range containers {
 				printContainer(writer, containers[i], pod)
@@ -284,6 +420,14 @@ func (o *ClusterInfoDumpOptions) Run() error {
 		}
 	}
 
+	if o.archive != nil {
+		if err := o.archive.write(o.OutputArchive); err != nil {
+			return err
+		}
+		fmt.Fprintf(o.Out, "Cluster info dumped to %s\n", o.OutputArchive)
+		return nil
+	}
+
 	dest := o.OutputDir
 	if len(dest) == 0 {
 		dest = "standard output"
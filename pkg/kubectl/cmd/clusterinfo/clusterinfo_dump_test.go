@@ -17,6 +17,8 @@ limitations under the License.
 package clusterinfo
 
 import (
+	"archive/tar"
+	"compress/gzip"
 	"io/ioutil"
 	"os"
 	"path"
@@ -68,3 +70,64 @@ func TestSetupOutputWriterFile(t *testing.T) {
 		t.Errorf("expected: %v, saw: %v", output, data)
 	}
 }
+
+func TestRedactAnnotations(t *testing.T) {
+	annotations := map[string]string{
+		"kubectl.kubernetes.io/last-applied-configuration": `{"kind":"Secret","data":{"password":"c3dvcmRmaXNo"}}`,
+		"some-other-annotation":                            "value",
+	}
+	redactAnnotations(annotations)
+	if annotations["kubectl.kubernetes.io/last-applied-configuration"] != redactedPlaceholder {
+		t.Errorf("expected sensitive annotation to be redacted, saw: %v", annotations["kubectl.kubernetes.io/last-applied-configuration"])
+	}
+	if annotations["some-other-annotation"] != "value" {
+		t.Errorf("expected non-sensitive annotation to be kept, saw: %v", annotations["some-other-annotation"])
+	}
+}
+
+func TestDumpArchive(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "out")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	archivePath := path.Join(dir, "dump.tar.gz")
+
+	archive := newDumpArchive()
+	archive.entry("nodes.json").Write([]byte("node data"))
+	archive.entry(path.Join("kube-system", "pods.json")).Write([]byte("pod data"))
+	if err := archive.write(archivePath); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	file, err := os.Open(archivePath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer file.Close()
+	gzReader, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tarReader := tar.NewReader(gzReader)
+
+	contents := map[string]string{}
+	for {
+		hdr, err := tarReader.Next()
+		if err != nil {
+			break
+		}
+		data, err := ioutil.ReadAll(tarReader)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		contents[hdr.Name] = string(data)
+	}
+
+	if contents["nodes.json"] != "node data" {
+		t.Errorf("expected nodes.json entry, saw: %v", contents)
+	}
+	if contents[path.Join("kube-system", "pods.json")] != "pod data" {
+		t.Errorf("expected kube-system/pods.json entry, saw: %v", contents)
+	}
+}
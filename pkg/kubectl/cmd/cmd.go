@@ -53,6 +53,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubectl/cmd/get"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/label"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/logs"
+	openapicmd "k8s.io/kubernetes/pkg/kubectl/cmd/openapi"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/options"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/patch"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/plugin"
@@ -420,12 +421,17 @@ func NewKubectlCommand(in io.Reader, out, err io.Writer) *cobra.Command {
             https://kubernetes.io/docs/reference/kubectl/overview/`),
 		Run: runHelp,
 		// Hook before and after Run initialize and write profiles to disk,
-		// respectively.
-		PersistentPreRunE: func(*cobra.Command, []string) error {
-			return initProfiling()
-		},
-		PersistentPostRunE: func(*cobra.Command, []string) error {
-			return flushProfiling()
+		// respectively. The pre-run hook is installed below, once the
+		// kubeconfig flags it depends on exist.
+		PersistentPostRunE: func(cmd *cobra.Command, _ []string) error {
+			if err := flushProfiling(); err != nil {
+				return err
+			}
+			// A failed telemetry hook must not fail the command itself.
+			if err := reportTelemetry(cmd, 0); err != nil {
+				fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+			}
+			return nil
 		},
 		BashCompletionFunction: bashCompletionFunc,
 	}
@@ -438,12 +444,28 @@ func NewKubectlCommand(in io.Reader, out, err io.Writer) *cobra.Command {
 	flags.SetNormalizeFunc(cliflag.WordSepNormalizeFunc)
 
 	addProfilingFlags(flags)
+	addContextLockFlags(flags)
+	addTelemetryFlags(flags)
+	addErrorFormatFlags(flags)
 
 	kubeConfigFlags := genericclioptions.NewConfigFlags(true).WithDeprecatedPasswordFlag()
 	kubeConfigFlags.AddFlags(flags)
 	matchVersionKubeConfigFlags := cmdutil.NewMatchVersionFlags(kubeConfigFlags)
 	matchVersionKubeConfigFlags.AddFlags(cmds.PersistentFlags())
 
+	// The context lock check needs the parsed kubeconfig flags, so the
+	// pre-run hook is installed here rather than in the command literal.
+	cmds.PersistentPreRunE = func(cmd *cobra.Command, _ []string) error {
+		initTelemetry(cmd)
+		if err := initErrorFormat(); err != nil {
+			return err
+		}
+		if err := initProfiling(); err != nil {
+			return err
+		}
+		return checkContextLock(kubeConfigFlags.ToRawKubeConfigLoader())
+	}
+
 	cmds.PersistentFlags().AddGoFlagSet(flag.CommandLine)
 
 	f := cmdutil.NewFactory(matchVersionKubeConfigFlags)
@@ -564,6 +586,7 @@ func NewKubectlCommand(in io.Reader, out, err io.Writer) *cobra.Command {
 	cmds.AddCommand(version.NewCmdVersion(f, ioStreams))
 	cmds.AddCommand(apiresources.NewCmdAPIVersions(f, ioStreams))
 	cmds.AddCommand(apiresources.NewCmdAPIResources(f, ioStreams))
+	cmds.AddCommand(openapicmd.NewCmdOpenAPI(f, ioStreams))
 	cmds.AddCommand(options.NewCmdOptions(ioStreams.Out))
 
 	return cmds
@@ -0,0 +1,81 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+var contextLockFile string
+
+func addContextLockFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&contextLockFile, "context-lock", "", "Path to an advisory context lock file. On first use the file is created with a fingerprint of the current context's cluster endpoint and user; later invocations fail if the current context no longer matches, guarding scripted sequences against context switches")
+}
+
+// checkContextLock asserts that the current kubeconfig context matches the
+// fingerprint stored in the context lock file, creating the file on first
+// use. It is a no-op when --context-lock is not set.
+func checkContextLock(loader clientcmd.ClientConfig) error {
+	if len(contextLockFile) == 0 {
+		return nil
+	}
+	rawConfig, err := loader.RawConfig()
+	if err != nil {
+		return err
+	}
+	fingerprint, err := contextFingerprint(rawConfig)
+	if err != nil {
+		return err
+	}
+	existing, err := ioutil.ReadFile(contextLockFile)
+	if os.IsNotExist(err) {
+		return ioutil.WriteFile(contextLockFile, []byte(fingerprint+"\n"), 0600)
+	}
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(string(existing)) != fingerprint {
+		return fmt.Errorf("current context %q does not match the fingerprint in the context lock file %s: the kubeconfig context changed since the lock was taken", rawConfig.CurrentContext, contextLockFile)
+	}
+	return nil
+}
+
+// contextFingerprint returns a stable fingerprint of the current context's
+// cluster endpoint and user, so a renamed but otherwise identical context
+// still satisfies the lock.
+func contextFingerprint(config clientcmdapi.Config) (string, error) {
+	context, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return "", fmt.Errorf("current context %q is not defined in the kubeconfig", config.CurrentContext)
+	}
+	server := ""
+	if cluster, ok := config.Clusters[context.Cluster]; ok {
+		server = cluster.Server
+	}
+	sum := sha256.Sum256([]byte(server + "\n" + context.AuthInfo))
+	return hex.EncodeToString(sum[:]), nil
+}
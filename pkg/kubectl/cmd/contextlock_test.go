@@ -0,0 +1,124 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func testContextLockConfig() *clientcmdapi.Config {
+	return &clientcmdapi.Config{
+		CurrentContext: "prod",
+		Contexts: map[string]*clientcmdapi.Context{
+			"prod":    {Cluster: "prod-cluster", AuthInfo: "prod-user"},
+			"staging": {Cluster: "staging-cluster", AuthInfo: "staging-user"},
+		},
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"prod-cluster":    {Server: "https://prod.example.com"},
+			"staging-cluster": {Server: "https://staging.example.com"},
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			"prod-user":    {},
+			"staging-user": {},
+		},
+	}
+}
+
+func TestContextFingerprint(t *testing.T) {
+	config := testContextLockConfig()
+	fingerprint, err := contextFingerprint(*config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// renaming the context does not change the fingerprint
+	renamed := testContextLockConfig()
+	renamed.CurrentContext = "production"
+	renamed.Contexts["production"] = renamed.Contexts["prod"]
+	delete(renamed.Contexts, "prod")
+	renamedFingerprint, err := contextFingerprint(*renamed)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if renamedFingerprint != fingerprint {
+		t.Errorf("expected the fingerprint to be stable across context renames")
+	}
+
+	// switching contexts changes the fingerprint
+	switched := testContextLockConfig()
+	switched.CurrentContext = "staging"
+	switchedFingerprint, err := contextFingerprint(*switched)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if switchedFingerprint == fingerprint {
+		t.Errorf("expected different fingerprints for different contexts")
+	}
+
+	// an undefined current context is an error
+	missing := testContextLockConfig()
+	missing.CurrentContext = "gone"
+	if _, err := contextFingerprint(*missing); err == nil {
+		t.Errorf("expected an error for an undefined current context")
+	}
+}
+
+func TestCheckContextLock(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "context-lock")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	defer func(previous string) { contextLockFile = previous }(contextLockFile)
+	contextLockFile = filepath.Join(tmpDir, "lock")
+
+	loader := clientcmd.NewDefaultClientConfig(*testContextLockConfig(), &clientcmd.ConfigOverrides{})
+
+	// the first invocation takes the lock
+	if err := checkContextLock(loader); err != nil {
+		t.Fatalf("unexpected error taking the lock: %v", err)
+	}
+	if _, err := os.Stat(contextLockFile); err != nil {
+		t.Fatalf("expected the lock file to be created: %v", err)
+	}
+
+	// the same context passes the check
+	if err := checkContextLock(loader); err != nil {
+		t.Fatalf("unexpected error rechecking the lock: %v", err)
+	}
+
+	// a switched context fails fast
+	switched := testContextLockConfig()
+	switched.CurrentContext = "staging"
+	switchedLoader := clientcmd.NewDefaultClientConfig(*switched, &clientcmd.ConfigOverrides{})
+	if err := checkContextLock(switchedLoader); err == nil {
+		t.Fatalf("expected an error for a switched context")
+	}
+
+	// without the flag the check is a no-op
+	contextLockFile = ""
+	if err := checkContextLock(switchedLoader); err != nil {
+		t.Fatalf("unexpected error with the lock disabled: %v", err)
+	}
+}
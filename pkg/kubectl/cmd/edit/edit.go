@@ -64,7 +64,10 @@ var (
 		kubectl edit job.v1.batch/myjob -o json
 
 		# Edit the deployment 'mydeployment' in YAML and save the modified config in its annotation:
-		kubectl edit deployment/mydeployment -o yaml --save-config`))
+		kubectl edit deployment/mydeployment -o yaml --save-config
+
+		# Edit the secret 'mysecret' with its data values base64-decoded in the editor:
+		kubectl edit secret/mysecret --decode`))
 )
 
 // NewCmdEdit creates the `edit` command
@@ -96,6 +99,7 @@ func NewCmdEdit(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra
 	cmdutil.AddFilenameOptionFlags(cmd, &o.FilenameOptions, usage)
 	cmdutil.AddValidateOptionFlags(cmd, &o.ValidateOptions)
 	cmd.Flags().BoolVarP(&o.OutputPatch, "output-patch", "", o.OutputPatch, "Output the patch if the resource is edited.")
+	cmd.Flags().BoolVar(&o.Decode, "decode", o.Decode, "If true, base64-decode secret data into the stringData field for editing, and re-encode it on save. Binary values are left encoded. Only has an effect on secrets.")
 	cmd.Flags().BoolVar(&o.WindowsLineEndings, "windows-line-endings", o.WindowsLineEndings,
 		"Defaults to the line ending native to your platform.")
 
@@ -0,0 +1,34 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/pflag"
+
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+)
+
+var errorFormat string
+
+func addErrorFormatFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&errorFormat, "error-format", cmdutil.ErrorFormatText,
+		"Format for fatal errors written to stderr. One of (text|json). With json, errors are emitted as structured objects and commands exit with differentiated codes.")
+}
+
+func initErrorFormat() error {
+	return cmdutil.SetErrorFormat(errorFormat)
+}
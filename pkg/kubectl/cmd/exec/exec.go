@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"strings"
 	"time"
 
 	dockerterm "github.com/docker/docker/pkg/term"
@@ -32,6 +33,7 @@ import (
 	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/klog"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/kubectl/polymorphichelpers"
 	"k8s.io/kubernetes/pkg/kubectl/scheme"
@@ -117,13 +119,33 @@ func (*DefaultRemoteExecutor) Execute(method string, url *url.URL, config *restc
 	if err != nil {
 		return err
 	}
-	return exec.Stream(remotecommand.StreamOptions{
+	streamOptions := remotecommand.StreamOptions{
 		Stdin:             stdin,
 		Stdout:            stdout,
 		Stderr:            stderr,
 		Tty:               tty,
 		TerminalSizeQueue: terminalSizeQueue,
-	})
+	}
+	err = exec.Stream(streamOptions)
+	if err == nil || !IsSPDYUpgradeFailure(err) {
+		return err
+	}
+	// Proxies that do not pass SPDY through reject the upgrade before any
+	// stream is established, so the whole command can be retried over
+	// WebSockets.
+	klog.V(2).Infof("SPDY upgrade failed (%v), falling back to the WebSocket transport", err)
+	wsExec, wsErr := remotecommand.NewWebSocketExecutor(config, url)
+	if wsErr != nil {
+		return err
+	}
+	return wsExec.Stream(streamOptions)
+}
+
+// IsSPDYUpgradeFailure returns true when the error indicates that the SPDY
+// upgrade was rejected before any stream was established, which is what
+// intermediate proxies that do not speak SPDY produce.
+func IsSPDYUpgradeFailure(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "unable to upgrade connection")
 }
 
 type StreamOptions struct {
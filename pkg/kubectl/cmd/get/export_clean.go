@@ -0,0 +1,126 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/cli-runtime/pkg/resource"
+)
+
+// Field groups that --export-clean can strip. Each name maps to the set of
+// server-populated data it removes from the object.
+const (
+	exportCleanStatus             = "status"
+	exportCleanUID                = "uid"
+	exportCleanResourceVersion    = "resourceVersion"
+	exportCleanSelfLink           = "selfLink"
+	exportCleanCreationTimestamp  = "creationTimestamp"
+	exportCleanGeneration         = "generation"
+	exportCleanManagedAnnotations = "managedAnnotations"
+)
+
+// validExportCleanFields are the field groups accepted by --export-clean-fields,
+// and also the default set stripped by --export-clean.
+var validExportCleanFields = []string{
+	exportCleanStatus,
+	exportCleanUID,
+	exportCleanResourceVersion,
+	exportCleanSelfLink,
+	exportCleanCreationTimestamp,
+	exportCleanGeneration,
+	exportCleanManagedAnnotations,
+}
+
+// managedAnnotationKeys are annotations maintained by controllers or kubectl
+// itself that should not travel to another cluster.
+var managedAnnotationKeys = []string{
+	"kubectl.kubernetes.io/last-applied-configuration",
+	"deployment.kubernetes.io/revision",
+	"control-plane.alpha.kubernetes.io/leader",
+}
+
+// exportCleanFieldSet validates the requested field names and returns them as a
+// set, defaulting to all known fields if none were requested.
+func exportCleanFieldSet(requested []string) (sets.String, error) {
+	if len(requested) == 0 {
+		return sets.NewString(validExportCleanFields...), nil
+	}
+	valid := sets.NewString(validExportCleanFields...)
+	result := sets.NewString()
+	for _, field := range requested {
+		if !valid.Has(field) {
+			return nil, fmt.Errorf("unknown field %q for --export-clean-fields, must be one of: %s", field, strings.Join(validExportCleanFields, ", "))
+		}
+		result.Insert(field)
+	}
+	return result, nil
+}
+
+// cleanInfosForExport strips the requested server-populated fields from the
+// fetched objects so that the printed manifests can be re-applied in another
+// cluster. Objects that are not unstructured are left untouched.
+func (o *GetOptions) cleanInfosForExport(infos []*resource.Info) error {
+	fields, err := exportCleanFieldSet(o.ExportCleanFields)
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		if obj, ok := info.Object.(*unstructured.Unstructured); ok {
+			cleanObjectForExport(obj, fields)
+		}
+	}
+	return nil
+}
+
+// cleanObjectForExport removes the given field groups from a single object.
+func cleanObjectForExport(obj *unstructured.Unstructured, fields sets.String) {
+	if fields.Has(exportCleanStatus) {
+		unstructured.RemoveNestedField(obj.Object, "status")
+	}
+	if fields.Has(exportCleanUID) {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "uid")
+	}
+	if fields.Has(exportCleanResourceVersion) {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "resourceVersion")
+	}
+	if fields.Has(exportCleanSelfLink) {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "selfLink")
+	}
+	if fields.Has(exportCleanCreationTimestamp) {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "creationTimestamp")
+	}
+	if fields.Has(exportCleanGeneration) {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "generation")
+	}
+	if fields.Has(exportCleanManagedAnnotations) {
+		annotations := obj.GetAnnotations()
+		if annotations != nil {
+			for _, key := range managedAnnotationKeys {
+				delete(annotations, key)
+			}
+			if len(annotations) == 0 {
+				unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+			} else {
+				obj.SetAnnotations(annotations)
+			}
+		}
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func exportTestObject() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":              "foo",
+				"namespace":         "bar",
+				"uid":               "1234",
+				"resourceVersion":   "42",
+				"selfLink":          "/api/v1/namespaces/bar/configmaps/foo",
+				"creationTimestamp": "2019-01-01T00:00:00Z",
+				"generation":        int64(3),
+				"annotations": map[string]interface{}{
+					"kubectl.kubernetes.io/last-applied-configuration": "{}",
+					"user-annotation": "keep-me",
+				},
+			},
+			"data": map[string]interface{}{
+				"key": "value",
+			},
+			"status": map[string]interface{}{
+				"phase": "Active",
+			},
+		},
+	}
+}
+
+func TestExportCleanFieldSet(t *testing.T) {
+	fields, err := exportCleanFieldSet(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !fields.HasAll(validExportCleanFields...) {
+		t.Errorf("expected all fields by default, got %v", fields.List())
+	}
+
+	fields, err = exportCleanFieldSet([]string{"status", "uid"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !fields.Equal(sets.NewString("status", "uid")) {
+		t.Errorf("expected only the requested fields, got %v", fields.List())
+	}
+
+	if _, err := exportCleanFieldSet([]string{"bogus"}); err == nil {
+		t.Errorf("expected an error for an unknown field")
+	}
+}
+
+func TestCleanObjectForExportDefaults(t *testing.T) {
+	obj := exportTestObject()
+	fields, err := exportCleanFieldSet(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cleanObjectForExport(obj, fields)
+
+	if _, found, _ := unstructured.NestedMap(obj.Object, "status"); found {
+		t.Errorf("expected status to be stripped")
+	}
+	for _, field := range []string{"uid", "resourceVersion", "selfLink", "creationTimestamp", "generation"} {
+		if _, found, _ := unstructured.NestedFieldNoCopy(obj.Object, "metadata", field); found {
+			t.Errorf("expected metadata.%s to be stripped", field)
+		}
+	}
+	annotations := obj.GetAnnotations()
+	if _, found := annotations["kubectl.kubernetes.io/last-applied-configuration"]; found {
+		t.Errorf("expected the last-applied-configuration annotation to be stripped")
+	}
+	if annotations["user-annotation"] != "keep-me" {
+		t.Errorf("expected user annotations to be preserved, got %v", annotations)
+	}
+	if obj.GetName() != "foo" || obj.GetNamespace() != "bar" {
+		t.Errorf("expected name and namespace to be preserved")
+	}
+	if data, found, _ := unstructured.NestedMap(obj.Object, "data"); !found || data["key"] != "value" {
+		t.Errorf("expected data to be preserved, got %v", data)
+	}
+}
+
+func TestCleanObjectForExportSelectedFields(t *testing.T) {
+	obj := exportTestObject()
+	cleanObjectForExport(obj, sets.NewString("status"))
+
+	if _, found, _ := unstructured.NestedMap(obj.Object, "status"); found {
+		t.Errorf("expected status to be stripped")
+	}
+	if _, found, _ := unstructured.NestedString(obj.Object, "metadata", "uid"); !found {
+		t.Errorf("expected metadata.uid to be preserved when not requested")
+	}
+}
+
+func TestCleanObjectForExportDropsEmptyAnnotations(t *testing.T) {
+	obj := exportTestObject()
+	obj.SetAnnotations(map[string]string{
+		"kubectl.kubernetes.io/last-applied-configuration": "{}",
+	})
+	cleanObjectForExport(obj, sets.NewString("managedAnnotations"))
+
+	if _, found, _ := unstructured.NestedMap(obj.Object, "metadata", "annotations"); found {
+		t.Errorf("expected the empty annotations map to be removed")
+	}
+}
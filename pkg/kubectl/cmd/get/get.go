@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"k8s.io/klog"
@@ -80,6 +81,9 @@ type GetOptions struct {
 	IgnoreNotFound bool
 	Export         bool
 
+	ExportClean       bool
+	ExportCleanFields []string
+
 	genericclioptions.IOStreams
 }
 
@@ -183,6 +187,8 @@ func NewCmdGet(parent string, f cmdutil.Factory, streams genericclioptions.IOStr
 	addServerPrintColumnFlags(cmd, o)
 	cmd.Flags().BoolVar(&o.Export, "export", o.Export, "If true, use 'export' for the resources.  Exported resources are stripped of cluster-specific information.")
 	cmd.Flags().MarkDeprecated("export", "This flag is deprecated and will be removed in future.")
+	cmd.Flags().BoolVar(&o.ExportClean, "export-clean", o.ExportClean, "If true, strip server-populated fields from the output so the manifests can be re-applied in another cluster. Requires -o yaml or -o json.")
+	cmd.Flags().StringSliceVar(&o.ExportCleanFields, "export-clean-fields", o.ExportCleanFields, fmt.Sprintf("Comma-separated list of fields stripped by --export-clean. Defaults to all of: %s.", strings.Join(validExportCleanFields, ", ")))
 	cmdutil.AddFilenameOptionFlags(cmd, &o.FilenameOptions, "identifying the resource to get from a server.")
 	return cmd
 }
@@ -308,6 +314,17 @@ func (o *GetOptions) Validate(cmd *cobra.Command) error {
 			return fmt.Errorf("--show-labels option cannot be used with %s printer", outputOption)
 		}
 	}
+	if o.ExportClean {
+		outputOption := cmd.Flags().Lookup("output").Value.String()
+		if outputOption != "json" && !strings.HasPrefix(outputOption, "yaml") {
+			return fmt.Errorf("--export-clean requires -o yaml or -o json")
+		}
+		if _, err := exportCleanFieldSet(o.ExportCleanFields); err != nil {
+			return err
+		}
+	} else if len(o.ExportCleanFields) > 0 {
+		return fmt.Errorf("--export-clean-fields may only be used together with --export-clean")
+	}
 	return nil
 }
 
@@ -750,6 +767,12 @@ func (o *GetOptions) printGeneric(r *resource.Result) error {
 		return utilerrors.Reduce(utilerrors.Flatten(utilerrors.NewAggregate(errs)))
 	}
 
+	if o.ExportClean {
+		if err := o.cleanInfosForExport(infos); err != nil {
+			return err
+		}
+	}
+
 	printer, err := o.ToPrinter(nil, false, false)
 	if err != nil {
 		return err
@@ -26,6 +26,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
@@ -178,7 +179,7 @@ func isLess(i, j reflect.Value) (bool, error) {
 	case reflect.Float32, reflect.Float64:
 		return i.Float() < j.Float(), nil
 	case reflect.String:
-		return sortorder.NaturalLess(i.String(), j.String()), nil
+		return lessStrings(i.String(), j.String()), nil
 	case reflect.Ptr:
 		return isLess(i.Elem(), j.Elem())
 	case reflect.Struct:
@@ -188,6 +189,11 @@ func isLess(i, j reflect.Value) (bool, error) {
 			time := j.Interface().(metav1.Time)
 			return t.Before(&time), nil
 		}
+		// sort resource.Quantity
+		if iQuantity, ok := in.(resource.Quantity); ok {
+			jQuantity := j.Interface().(resource.Quantity)
+			return iQuantity.Cmp(jQuantity) < 0, nil
+		}
 		// fallback to the fields comparison
 		for idx := 0; idx < i.NumField(); idx++ {
 			less, err := isLess(i.Field(idx), j.Field(idx))
@@ -258,7 +264,7 @@ func isLess(i, j reflect.Value) (bool, error) {
 			}
 		case string:
 			if jtype, ok := j.Interface().(string); ok {
-				return sortorder.NaturalLess(itype, jtype), nil
+				return lessStrings(itype, jtype), nil
 			}
 		default:
 			return false, fmt.Errorf("unsortable type: %T", itype)
@@ -270,6 +276,18 @@ func isLess(i, j reflect.Value) (bool, error) {
 	}
 }
 
+// lessStrings compares two strings as resource quantities when both parse as
+// such, so that e.g. "2Gi" sorts after "900Mi" and "1e3" after "500". Strings
+// that are not quantities fall back to natural ordering.
+func lessStrings(i, j string) bool {
+	iQuantity, iErr := resource.ParseQuantity(i)
+	jQuantity, jErr := resource.ParseQuantity(j)
+	if iErr == nil && jErr == nil {
+		return iQuantity.Cmp(jQuantity) < 0
+	}
+	return sortorder.NaturalLess(i, j)
+}
+
 func (r *RuntimeSort) Less(i, j int) bool {
 	iObj := r.objs[i]
 	jObj := r.objs[j]
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -64,6 +65,9 @@ var (
 		# Begin streaming the logs from all containers in pods defined by label app=nginx
 		kubectl logs -f -lapp=nginx --all-containers=true
 
+		# Begin streaming the logs from all containers in pods defined by label app=nginx, prefixing each line with its source
+		kubectl logs -f -lapp=nginx --all-containers=true --prefix
+
 		# Display only the most recent 20 lines of output in pod nginx
 		kubectl logs --tail=20 nginx
 
@@ -108,6 +112,7 @@ type LogsOptions struct {
 	ContainerNameSpecified bool
 	Selector               string
 	MaxFollowConcurency    int
+	Prefix                 bool
 
 	Object           runtime.Object
 	GetPodTimeout    time.Duration
@@ -161,6 +166,7 @@ func NewCmdLogs(f cmdutil.Factory, streams genericclioptions.IOStreams) *cobra.C
 	cmdutil.AddPodRunningTimeoutFlag(cmd, defaultPodLogsTimeout)
 	cmd.Flags().StringVarP(&o.Selector, "selector", "l", o.Selector, "Selector (label query) to filter on.")
 	cmd.Flags().IntVar(&o.MaxFollowConcurency, "max-log-requests", o.MaxFollowConcurency, "Specify maximum number of concurrent logs to follow when using by a selector. Defaults to 5.")
+	cmd.Flags().BoolVar(&o.Prefix, "prefix", o.Prefix, "Prefix each log line with the log source (pod name and container name)")
 	return cmd
 }
 
@@ -318,13 +324,13 @@ func (o LogsOptions) RunLogs() error {
 	return o.sequentialConsumeRequest(requests)
 }
 
-func (o LogsOptions) parallelConsumeRequest(requests []rest.ResponseWrapper) error {
+func (o LogsOptions) parallelConsumeRequest(requests map[corev1.ObjectReference]rest.ResponseWrapper) error {
 	reader, writer := io.Pipe()
 	wg := &sync.WaitGroup{}
 	wg.Add(len(requests))
-	for _, request := range requests {
-		go func(request rest.ResponseWrapper) {
-			if err := o.ConsumeRequestFn(request, writer); err != nil {
+	for objRef, request := range requests {
+		go func(objRef corev1.ObjectReference, request rest.ResponseWrapper) {
+			if err := o.ConsumeRequestFn(request, o.addPrefixIfNeeded(objRef, writer)); err != nil {
 				if !o.IgnoreLogErrors {
 					writer.CloseWithError(err)
 
@@ -336,7 +342,7 @@ func (o LogsOptions) parallelConsumeRequest(requests []rest.ResponseWrapper) err
 			}
 
 			wg.Done()
-		}(request)
+		}(objRef, request)
 	}
 
 	go func() {
@@ -348,9 +354,9 @@ func (o LogsOptions) parallelConsumeRequest(requests []rest.ResponseWrapper) err
 	return err
 }
 
-func (o LogsOptions) sequentialConsumeRequest(requests []rest.ResponseWrapper) error {
-	for _, request := range requests {
-		if err := o.ConsumeRequestFn(request, o.Out); err != nil {
+func (o LogsOptions) sequentialConsumeRequest(requests map[corev1.ObjectReference]rest.ResponseWrapper) error {
+	for objRef, request := range requests {
+		if err := o.ConsumeRequestFn(request, o.addPrefixIfNeeded(objRef, o.Out)); err != nil {
 			return err
 		}
 	}
@@ -358,6 +364,29 @@ func (o LogsOptions) sequentialConsumeRequest(requests []rest.ResponseWrapper) e
 	return nil
 }
 
+func (o LogsOptions) addPrefixIfNeeded(ref corev1.ObjectReference, writer io.Writer) io.Writer {
+	if !o.Prefix || ref.FieldPath == "" || ref.Name == "" {
+		return writer
+	}
+
+	// We rely on ref.FieldPath to contain a reference to a container
+	// including a container name (not an index) so we can get a container name
+	// without making an extra API request.
+	var containerName string
+	fieldPath := strings.TrimSuffix(ref.FieldPath, "}")
+	fieldPath = strings.TrimPrefix(fieldPath, "spec.initContainers{")
+	fieldPath = strings.TrimPrefix(fieldPath, "spec.containers{")
+	if !strings.ContainsAny(fieldPath, "{}") {
+		containerName = fieldPath
+	}
+
+	prefix := fmt.Sprintf("[pod/%s/%s] ", ref.Name, containerName)
+	return &prefixingWriter{
+		prefix: []byte(prefix),
+		writer: writer,
+	}
+}
+
 // DefaultConsumeRequest reads the data from request and writes into
 // the out writer. It buffers data from requests until the newline or io.EOF
 // occurs in the data, so it doesn't interleave logs sub-line
@@ -388,3 +417,27 @@ func DefaultConsumeRequest(request rest.ResponseWrapper, out io.Writer) error {
 		}
 	}
 }
+
+// prefixingWriter writes a fixed prefix in front of every chunk written to the
+// underlying writer. The prefix and the chunk are written in a single call so
+// that prefixed lines do not interleave sub-line when written concurrently
+// through an io.Pipe.
+type prefixingWriter struct {
+	prefix []byte
+	writer io.Writer
+}
+
+func (pw *prefixingWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	n, err := pw.writer.Write(append(pw.prefix, p...))
+	if n > len(p) {
+		// To comply with the io.Writer interface requirements we must
+		// return a number of bytes written from p (0 <= n <= len(p)),
+		// so we are ignoring the length of the prefix here.
+		return len(p), nil
+	}
+	return n, err
+}
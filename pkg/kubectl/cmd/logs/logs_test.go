@@ -47,8 +47,8 @@ func TestLog(t *testing.T) {
 			name: "v1 - pod log",
 			opts: func(streams genericclioptions.IOStreams) *LogsOptions {
 				mock := &logTestMock{
-					logsForObjectRequests: []restclient.ResponseWrapper{
-						&responseWrapperMock{data: strings.NewReader("test log content\n")},
+					logsForObjectRequests: map[corev1.ObjectReference]restclient.ResponseWrapper{
+						{}: &responseWrapperMock{data: strings.NewReader("test log content\n")},
 					},
 				}
 
@@ -64,10 +64,10 @@ func TestLog(t *testing.T) {
 			name: "get logs from multiple requests sequentially",
 			opts: func(streams genericclioptions.IOStreams) *LogsOptions {
 				mock := &logTestMock{
-					logsForObjectRequests: []restclient.ResponseWrapper{
-						&responseWrapperMock{data: strings.NewReader("test log content from source 1\n")},
-						&responseWrapperMock{data: strings.NewReader("test log content from source 2\n")},
-						&responseWrapperMock{data: strings.NewReader("test log content from source 3\n")},
+					logsForObjectRequests: map[corev1.ObjectReference]restclient.ResponseWrapper{
+						{Name: "some-pod-1", FieldPath: "spec.containers{some-container}"}: &responseWrapperMock{data: strings.NewReader("test log content from source 1\n")},
+						{Name: "some-pod-2", FieldPath: "spec.containers{some-container}"}: &responseWrapperMock{data: strings.NewReader("test log content from source 2\n")},
+						{Name: "some-pod-3", FieldPath: "spec.containers{some-container}"}: &responseWrapperMock{data: strings.NewReader("test log content from source 3\n")},
 					},
 				}
 
@@ -77,8 +77,32 @@ func TestLog(t *testing.T) {
 				return o
 			},
 			expectedOutSubstrings: []string{
-				// Order in this case must always be the same, because we read requests sequentially
-				"test log content from source 1\ntest log content from source 2\ntest log content from source 3\n",
+				"test log content from source 1\n",
+				"test log content from source 2\n",
+				"test log content from source 3\n",
+			},
+		},
+		{
+			name: "get logs from multiple requests sequentially with prefix",
+			opts: func(streams genericclioptions.IOStreams) *LogsOptions {
+				mock := &logTestMock{
+					logsForObjectRequests: map[corev1.ObjectReference]restclient.ResponseWrapper{
+						{Name: "some-pod-1", FieldPath: "spec.containers{some-container}"}:     &responseWrapperMock{data: strings.NewReader("test log content from source 1\n")},
+						{Name: "some-pod-2", FieldPath: "spec.containers{some-container}"}:     &responseWrapperMock{data: strings.NewReader("test log content from source 2\n")},
+						{Name: "some-pod-3", FieldPath: "spec.initContainers{some-container}"}: &responseWrapperMock{data: strings.NewReader("test log content from source 3\n")},
+					},
+				}
+
+				o := NewLogsOptions(streams, false)
+				o.LogsForObject = mock.mockLogsForObject
+				o.ConsumeRequestFn = mock.mockConsumeRequest
+				o.Prefix = true
+				return o
+			},
+			expectedOutSubstrings: []string{
+				"[pod/some-pod-1/some-container] test log content from source 1\n",
+				"[pod/some-pod-2/some-container] test log content from source 2\n",
+				"[pod/some-pod-3/some-container] test log content from source 3\n",
 			},
 		},
 		{
@@ -86,10 +110,10 @@ func TestLog(t *testing.T) {
 			opts: func(streams genericclioptions.IOStreams) *LogsOptions {
 				wg := &sync.WaitGroup{}
 				mock := &logTestMock{
-					logsForObjectRequests: []restclient.ResponseWrapper{
-						&responseWrapperMock{data: strings.NewReader("test log content from source 1\n")},
-						&responseWrapperMock{data: strings.NewReader("test log content from source 2\n")},
-						&responseWrapperMock{data: strings.NewReader("test log content from source 3\n")},
+					logsForObjectRequests: map[corev1.ObjectReference]restclient.ResponseWrapper{
+						{Name: "some-pod-1", FieldPath: "spec.containers{some-container}"}: &responseWrapperMock{data: strings.NewReader("test log content from source 1\n")},
+						{Name: "some-pod-2", FieldPath: "spec.containers{some-container}"}: &responseWrapperMock{data: strings.NewReader("test log content from source 2\n")},
+						{Name: "some-pod-3", FieldPath: "spec.containers{some-container}"}: &responseWrapperMock{data: strings.NewReader("test log content from source 3\n")},
 					},
 					wg: wg,
 				}
@@ -107,15 +131,42 @@ func TestLog(t *testing.T) {
 				"test log content from source 3\n",
 			},
 		},
+		{
+			name: "follow logs from multiple requests concurrently with prefix",
+			opts: func(streams genericclioptions.IOStreams) *LogsOptions {
+				wg := &sync.WaitGroup{}
+				mock := &logTestMock{
+					logsForObjectRequests: map[corev1.ObjectReference]restclient.ResponseWrapper{
+						{Name: "some-pod-1", FieldPath: "spec.containers{some-container}"}: &responseWrapperMock{data: strings.NewReader("test log content from source 1\n")},
+						{Name: "some-pod-2", FieldPath: "spec.containers{some-container}"}: &responseWrapperMock{data: strings.NewReader("test log content from source 2\n")},
+						{Name: "some-pod-3", FieldPath: "spec.containers{some-container}"}: &responseWrapperMock{data: strings.NewReader("test log content from source 3\n")},
+					},
+					wg: wg,
+				}
+				wg.Add(3)
+
+				o := NewLogsOptions(streams, false)
+				o.LogsForObject = mock.mockLogsForObject
+				o.ConsumeRequestFn = mock.mockConsumeRequest
+				o.Follow = true
+				o.Prefix = true
+				return o
+			},
+			expectedOutSubstrings: []string{
+				"[pod/some-pod-1/some-container] test log content from source 1\n",
+				"[pod/some-pod-2/some-container] test log content from source 2\n",
+				"[pod/some-pod-3/some-container] test log content from source 3\n",
+			},
+		},
 		{
 			name: "fail to follow logs from multiple requests when there are more logs sources then MaxFollowConcurency allows",
 			opts: func(streams genericclioptions.IOStreams) *LogsOptions {
 				wg := &sync.WaitGroup{}
 				mock := &logTestMock{
-					logsForObjectRequests: []restclient.ResponseWrapper{
-						&responseWrapperMock{data: strings.NewReader("test log content\n")},
-						&responseWrapperMock{data: strings.NewReader("test log content\n")},
-						&responseWrapperMock{data: strings.NewReader("test log content\n")},
+					logsForObjectRequests: map[corev1.ObjectReference]restclient.ResponseWrapper{
+						{Name: "some-pod-1"}: &responseWrapperMock{data: strings.NewReader("test log content\n")},
+						{Name: "some-pod-2"}: &responseWrapperMock{data: strings.NewReader("test log content\n")},
+						{Name: "some-pod-3"}: &responseWrapperMock{data: strings.NewReader("test log content\n")},
 					},
 					wg: wg,
 				}
@@ -134,7 +185,7 @@ func TestLog(t *testing.T) {
 			name: "fail if LogsForObject fails",
 			opts: func(streams genericclioptions.IOStreams) *LogsOptions {
 				o := NewLogsOptions(streams, false)
-				o.LogsForObject = func(restClientGetter genericclioptions.RESTClientGetter, object, options runtime.Object, timeout time.Duration, allContainers bool) ([]restclient.ResponseWrapper, error) {
+				o.LogsForObject = func(restClientGetter genericclioptions.RESTClientGetter, object, options runtime.Object, timeout time.Duration, allContainers bool) (map[corev1.ObjectReference]restclient.ResponseWrapper, error) {
 					return nil, errors.New("Error from the LogsForObject")
 				}
 				return o
@@ -145,9 +196,9 @@ func TestLog(t *testing.T) {
 			name: "fail to get logs, if ConsumeRequestFn fails",
 			opts: func(streams genericclioptions.IOStreams) *LogsOptions {
 				mock := &logTestMock{
-					logsForObjectRequests: []restclient.ResponseWrapper{
-						&responseWrapperMock{},
-						&responseWrapperMock{},
+					logsForObjectRequests: map[corev1.ObjectReference]restclient.ResponseWrapper{
+						{Name: "some-pod-1"}: &responseWrapperMock{},
+						{Name: "some-pod-2"}: &responseWrapperMock{},
 					},
 				}
 
@@ -165,10 +216,10 @@ func TestLog(t *testing.T) {
 			opts: func(streams genericclioptions.IOStreams) *LogsOptions {
 				wg := &sync.WaitGroup{}
 				mock := &logTestMock{
-					logsForObjectRequests: []restclient.ResponseWrapper{
-						&responseWrapperMock{},
-						&responseWrapperMock{},
-						&responseWrapperMock{},
+					logsForObjectRequests: map[corev1.ObjectReference]restclient.ResponseWrapper{
+						{Name: "some-pod-1"}: &responseWrapperMock{},
+						{Name: "some-pod-2"}: &responseWrapperMock{},
+						{Name: "some-pod-3"}: &responseWrapperMock{},
 					},
 					wg: wg,
 				}
@@ -188,7 +239,9 @@ func TestLog(t *testing.T) {
 			name: "fail to follow logs, if ConsumeRequestFn fails",
 			opts: func(streams genericclioptions.IOStreams) *LogsOptions {
 				mock := &logTestMock{
-					logsForObjectRequests: []restclient.ResponseWrapper{&responseWrapperMock{}},
+					logsForObjectRequests: map[corev1.ObjectReference]restclient.ResponseWrapper{
+						{}: &responseWrapperMock{},
+					},
 				}
 
 				o := NewLogsOptions(streams, false)
@@ -505,7 +558,7 @@ func (r *responseWrapperMock) Stream() (io.ReadCloser, error) {
 }
 
 type logTestMock struct {
-	logsForObjectRequests []restclient.ResponseWrapper
+	logsForObjectRequests map[corev1.ObjectReference]restclient.ResponseWrapper
 
 	// We need a WaitGroup in some test cases to make sure that we fetch logs concurrently.
 	// These test cases will finish successfully without the WaitGroup, but the WaitGroup
@@ -530,7 +583,7 @@ func (l *logTestMock) mockConsumeRequest(request restclient.ResponseWrapper, out
 	return err
 }
 
-func (l *logTestMock) mockLogsForObject(restClientGetter genericclioptions.RESTClientGetter, object, options runtime.Object, timeout time.Duration, allContainers bool) ([]restclient.ResponseWrapper, error) {
+func (l *logTestMock) mockLogsForObject(restClientGetter genericclioptions.RESTClientGetter, object, options runtime.Object, timeout time.Duration, allContainers bool) (map[corev1.ObjectReference]restclient.ResponseWrapper, error) {
 	switch object.(type) {
 	case *corev1.Pod:
 		_, ok := options.(*corev1.PodLogOptions)
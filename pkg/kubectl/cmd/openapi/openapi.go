@@ -0,0 +1,124 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/discovery"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/util/openapi"
+	"k8s.io/kubernetes/pkg/kubectl/util/i18n"
+	"k8s.io/kubernetes/pkg/kubectl/util/templates"
+)
+
+var (
+	fetchLong = templates.LongDesc(i18n.T(`
+		Download the openapi schema of the connected cluster into a local
+		directory.
+
+		The directory can later be passed to commands supporting offline
+		validation (e.g. 'kubectl apply --offline-schema=DIRECTORY') so
+		manifests can be strictly validated without cluster access.`))
+
+	fetchExample = templates.Examples(i18n.T(`
+		# Download the cluster openapi schema into ./schema
+		kubectl openapi fetch --schema-dir ./schema`))
+)
+
+// NewCmdOpenAPI creates the `openapi` command and its subcommands
+func NewCmdOpenAPI(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "openapi SUBCOMMAND",
+		Short: i18n.T("Work with the cluster openapi schema"),
+		Long:  "Work with the openapi schema published by the connected cluster.",
+		Run:   cmdutil.DefaultSubCommandRun(ioStreams.ErrOut),
+	}
+
+	cmd.AddCommand(NewCmdOpenAPIFetch(f, ioStreams))
+	return cmd
+}
+
+// FetchOptions have the data required to download the openapi schema
+type FetchOptions struct {
+	SchemaDir string
+
+	discoveryClient discovery.CachedDiscoveryInterface
+
+	genericclioptions.IOStreams
+}
+
+// NewFetchOptions creates the options for the fetch subcommand
+func NewFetchOptions(ioStreams genericclioptions.IOStreams) *FetchOptions {
+	return &FetchOptions{
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdOpenAPIFetch creates the `openapi fetch` command
+func NewCmdOpenAPIFetch(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewFetchOptions(ioStreams)
+	cmd := &cobra.Command{
+		Use:     "fetch",
+		Short:   i18n.T("Download the cluster openapi schema to a local directory"),
+		Long:    fetchLong,
+		Example: fetchExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, cmd, args))
+			cmdutil.CheckErr(o.RunFetch())
+		},
+	}
+	cmd.Flags().StringVar(&o.SchemaDir, "schema-dir", o.SchemaDir, "Directory the openapi schema is written to.")
+	cmd.MarkFlagRequired("schema-dir")
+	return cmd
+}
+
+// Complete adapts from the command line args and factory to the data required
+func (o *FetchOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) != 0 {
+		return cmdutil.UsageErrorf(cmd, "unexpected arguments: %v", args)
+	}
+	if len(o.SchemaDir) == 0 {
+		return cmdutil.UsageErrorf(cmd, "--schema-dir is required")
+	}
+	var err error
+	o.discoveryClient, err = f.ToDiscoveryClient()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RunFetch downloads the openapi schema and writes it to the schema directory
+func (o *FetchOptions) RunFetch() error {
+	// Always request fresh data from the server
+	o.discoveryClient.Invalidate()
+
+	doc, err := o.discoveryClient.OpenAPISchema()
+	if err != nil {
+		return fmt.Errorf("couldn't get openapi schema from server: %v", err)
+	}
+
+	if err := openapi.WriteSchemaFile(doc, o.SchemaDir); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "openapi schema written to %s\n", o.SchemaDir)
+	return nil
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package rollout
 
 import (
+	"bufio"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"k8s.io/kubernetes/pkg/kubectl/polymorphichelpers"
@@ -25,6 +27,7 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/printers"
 	"k8s.io/cli-runtime/pkg/resource"
+	"k8s.io/kubernetes/pkg/kubectl"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/kubectl/scheme"
 	"k8s.io/kubernetes/pkg/kubectl/util/i18n"
@@ -40,6 +43,7 @@ type UndoOptions struct {
 	Builder          func() *resource.Builder
 	ToRevision       int64
 	DryRun           bool
+	Confirm          bool
 	Resources        []string
 	Namespace        string
 	EnforceNamespace bool
@@ -61,7 +65,10 @@ var (
 		kubectl rollout undo daemonset/abc --to-revision=3
 
 		# Rollback to the previous deployment with dry-run
-		kubectl rollout undo --dry-run=true deployment/abc`)
+		kubectl rollout undo --dry-run=true deployment/abc
+
+		# Show the template that would be rolled back to and ask for confirmation first
+		kubectl rollout undo --confirm deployment/abc`)
 )
 
 // NewRolloutUndoOptions returns an initialized UndoOptions instance
@@ -94,6 +101,7 @@ func NewCmdRolloutUndo(f cmdutil.Factory, streams genericclioptions.IOStreams) *
 	}
 
 	cmd.Flags().Int64Var(&o.ToRevision, "to-revision", o.ToRevision, "The revision to rollback to. Default to 0 (last revision).")
+	cmd.Flags().BoolVar(&o.Confirm, "confirm", o.Confirm, "Show the template of the revision being rolled back to and require confirmation before performing the rollback. Confirmation is read from stdin, so non-interactive callers must pipe in an answer explicitly.")
 	usage := "identifying the resource to get from a server."
 	cmdutil.AddFilenameOptionFlags(cmd, &o.FilenameOptions, usage)
 	cmdutil.AddDryRunFlag(cmd)
@@ -156,6 +164,17 @@ func (o *UndoOptions) RunUndo() error {
 			return err
 		}
 
+		if o.Confirm && !o.DryRun {
+			confirmed, err := o.confirmRollback(rollbacker, info)
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				fmt.Fprintf(o.ErrOut, "%s/%s skipped rollback\n", info.Mapping.Resource.Resource, info.Name)
+				return nil
+			}
+		}
+
 		result, err := rollbacker.Rollback(info.Object, nil, o.ToRevision, o.DryRun)
 		if err != nil {
 			return err
@@ -171,3 +190,21 @@ func (o *UndoOptions) RunUndo() error {
 
 	return err
 }
+
+// confirmRollback shows the template of the revision the rollback would apply,
+// obtained with a dry-run rollback, and asks the user to confirm the change.
+func (o *UndoOptions) confirmRollback(rollbacker kubectl.Rollbacker, info *resource.Info) (bool, error) {
+	preview, err := rollbacker.Rollback(info.Object, nil, o.ToRevision, true)
+	if err != nil {
+		return false, err
+	}
+	fmt.Fprintf(o.ErrOut, "%s\n", preview)
+	fmt.Fprintf(o.ErrOut, "Do you want to roll back %s/%s? (y/n): ", info.Mapping.Resource.Resource, info.Name)
+
+	answer, err := bufio.NewReader(o.In).ReadString('\n')
+	if err != nil {
+		return false, fmt.Errorf("could not read confirmation: %v", err)
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
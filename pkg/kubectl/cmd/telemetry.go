@@ -0,0 +1,147 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/version"
+)
+
+var (
+	telemetryConfigFile string
+	telemetryStart      time.Time
+)
+
+// telemetryTimeout bounds how long a telemetry hook may delay the end of a run.
+const telemetryTimeout = 5 * time.Second
+
+func addTelemetryFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&telemetryConfigFile, "telemetry-config", "", "If non-empty, path to a file declaring a hook that is invoked with anonymized command metadata after each run. Telemetry is disabled when unset.")
+}
+
+// telemetryConfig declares where command metadata is delivered. Exactly one of
+// Command and Endpoint must be set.
+type telemetryConfig struct {
+	// Command is the path to an executable that receives the JSON encoded
+	// record on stdin.
+	Command string `json:"command,omitempty"`
+	// Endpoint is an HTTP(S) URL the JSON encoded record is POSTed to.
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// telemetryRecord is the metadata reported for a single run. It deliberately
+// carries no arguments, flag values, or object contents.
+type telemetryRecord struct {
+	Command              string `json:"command"`
+	DurationMilliseconds int64  `json:"durationMilliseconds"`
+	ExitCode             int    `json:"exitCode"`
+	ClientVersion        string `json:"clientVersion"`
+}
+
+// initTelemetry records the start of the run and, if telemetry is enabled,
+// overrides the fatal error handler so that runs which exit the process before
+// the post-run hook can fire are still reported with their exit code.
+func initTelemetry(cmd *cobra.Command) {
+	telemetryStart = time.Now()
+	if len(telemetryConfigFile) == 0 {
+		return
+	}
+	cmdutil.BehaviorOnFatal(func(msg string, code int) {
+		if err := reportTelemetry(cmd, code); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+		}
+		if len(msg) > 0 {
+			if !strings.HasSuffix(msg, "\n") {
+				msg += "\n"
+			}
+			fmt.Fprint(os.Stderr, msg)
+		}
+		os.Exit(code)
+	})
+}
+
+// reportTelemetry invokes the hook declared in the telemetry config file, if
+// any, with the metadata for the finished run.
+func reportTelemetry(cmd *cobra.Command, exitCode int) error {
+	if len(telemetryConfigFile) == 0 {
+		return nil
+	}
+	data, err := ioutil.ReadFile(telemetryConfigFile)
+	if err != nil {
+		return fmt.Errorf("error reading telemetry config %s: %v", telemetryConfigFile, err)
+	}
+	config := &telemetryConfig{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return fmt.Errorf("error parsing telemetry config %s: %v", telemetryConfigFile, err)
+	}
+	record := telemetryRecord{
+		Command:              cmd.CommandPath(),
+		DurationMilliseconds: int64(time.Since(telemetryStart) / time.Millisecond),
+		ExitCode:             exitCode,
+		ClientVersion:        version.Get().GitVersion,
+	}
+	payload, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(config.Command) > 0:
+		return deliverTelemetryExec(config.Command, payload)
+	case len(config.Endpoint) > 0:
+		return deliverTelemetryHTTP(config.Endpoint, payload)
+	}
+	return fmt.Errorf("telemetry config %s declares neither a command nor an endpoint", telemetryConfigFile)
+}
+
+func deliverTelemetryExec(command string, payload []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), telemetryTimeout)
+	defer cancel()
+	hook := exec.CommandContext(ctx, command)
+	hook.Stdin = bytes.NewReader(payload)
+	if err := hook.Run(); err != nil {
+		return fmt.Errorf("error running telemetry command %s: %v", command, err)
+	}
+	return nil
+}
+
+func deliverTelemetryHTTP(endpoint string, payload []byte) error {
+	client := &http.Client{Timeout: telemetryTimeout}
+	resp, err := client.Post(endpoint, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("error posting to telemetry endpoint %s: %v", endpoint, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("telemetry endpoint %s returned %s", endpoint, resp.Status)
+	}
+	return nil
+}
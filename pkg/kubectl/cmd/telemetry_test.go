@@ -0,0 +1,131 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/version"
+)
+
+func TestReportTelemetryDisabled(t *testing.T) {
+	defer func(saved string) { telemetryConfigFile = saved }(telemetryConfigFile)
+	telemetryConfigFile = ""
+	if err := reportTelemetry(&cobra.Command{Use: "kubectl"}, 0); err != nil {
+		t.Errorf("expected no error with telemetry disabled, got %v", err)
+	}
+}
+
+func TestReportTelemetryEndpoint(t *testing.T) {
+	defer func(saved string) { telemetryConfigFile = saved }(telemetryConfigFile)
+
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		received = body
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "telemetry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	telemetryConfigFile = filepath.Join(dir, "config.yaml")
+	if err := ioutil.WriteFile(telemetryConfigFile, []byte("endpoint: "+server.URL+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := reportTelemetry(&cobra.Command{Use: "kubectl"}, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	record := telemetryRecord{}
+	if err := json.Unmarshal(received, &record); err != nil {
+		t.Fatalf("error decoding record %q: %v", received, err)
+	}
+	if record.Command != "kubectl" {
+		t.Errorf("expected command %q, got %q", "kubectl", record.Command)
+	}
+	if record.ExitCode != 1 {
+		t.Errorf("expected exit code 1, got %d", record.ExitCode)
+	}
+	if record.ClientVersion != version.Get().GitVersion {
+		t.Errorf("expected client version %q, got %q", version.Get().GitVersion, record.ClientVersion)
+	}
+}
+
+func TestReportTelemetryCommand(t *testing.T) {
+	defer func(saved string) { telemetryConfigFile = saved }(telemetryConfigFile)
+
+	dir, err := ioutil.TempDir("", "telemetry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	out := filepath.Join(dir, "record.json")
+	hook := filepath.Join(dir, "hook.sh")
+	if err := ioutil.WriteFile(hook, []byte("#!/bin/sh\ncat > "+out+"\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	telemetryConfigFile = filepath.Join(dir, "config.yaml")
+	if err := ioutil.WriteFile(telemetryConfigFile, []byte("command: "+hook+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := reportTelemetry(&cobra.Command{Use: "kubectl"}, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	received, err := ioutil.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record := telemetryRecord{}
+	if err := json.Unmarshal(received, &record); err != nil {
+		t.Fatalf("error decoding record %q: %v", received, err)
+	}
+	if record.Command != "kubectl" {
+		t.Errorf("expected command %q, got %q", "kubectl", record.Command)
+	}
+}
+
+func TestReportTelemetryInvalidConfig(t *testing.T) {
+	defer func(saved string) { telemetryConfigFile = saved }(telemetryConfigFile)
+
+	dir, err := ioutil.TempDir("", "telemetry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	telemetryConfigFile = filepath.Join(dir, "config.yaml")
+	if err := ioutil.WriteFile(telemetryConfigFile, []byte("{}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := reportTelemetry(&cobra.Command{Use: "kubectl"}, 0); err == nil {
+		t.Error("expected an error for a config with no hook declared")
+	}
+}
@@ -18,11 +18,14 @@ package testing
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -667,3 +670,130 @@ func testDynamicResources() []*restmapper.APIGroupResources {
 		},
 	}
 }
+
+// RoundTripFixture is a single recorded request/response pair, stored in a
+// golden file by a RoundTripRecorder and served again by ReplayRoundTrips.
+type RoundTripFixture struct {
+	Method          string            `json:"method"`
+	Path            string            `json:"path"`
+	Query           string            `json:"query,omitempty"`
+	RequestBody     string            `json:"requestBody,omitempty"`
+	StatusCode      int               `json:"statusCode"`
+	ResponseHeaders map[string]string `json:"responseHeaders,omitempty"`
+	ResponseBody    string            `json:"responseBody,omitempty"`
+}
+
+// RoundTripRecorder wraps a round trip function and captures every
+// request/response pair that passes through it, so the pairs can be saved
+// as fixtures and later replayed without hand-building http.Response
+// objects for every case. It is intended to be plugged into a
+// fake.RESTClient via fake.CreateHTTPClient.
+type RoundTripRecorder struct {
+	lock     sync.Mutex
+	delegate func(*http.Request) (*http.Response, error)
+	fixtures []RoundTripFixture
+}
+
+// NewRoundTripRecorder returns a recorder capturing the round trips served
+// by the given delegate.
+func NewRoundTripRecorder(delegate func(*http.Request) (*http.Response, error)) *RoundTripRecorder {
+	return &RoundTripRecorder{delegate: delegate}
+}
+
+// RoundTrip forwards the request to the delegate and records the pair.
+// The request and response bodies are re-buffered so recording is
+// transparent to the caller.
+func (r *RoundTripRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	fixture := RoundTripFixture{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.RawQuery,
+	}
+	if req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		fixture.RequestBody = string(body)
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := r.delegate(req)
+	if err != nil {
+		return resp, err
+	}
+
+	fixture.StatusCode = resp.StatusCode
+	if len(resp.Header) > 0 {
+		fixture.ResponseHeaders = map[string]string{}
+		for k := range resp.Header {
+			fixture.ResponseHeaders[k] = resp.Header.Get(k)
+		}
+	}
+	if resp.Body != nil {
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		fixture.ResponseBody = string(body)
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.fixtures = append(r.fixtures, fixture)
+	return resp, nil
+}
+
+// Save writes the recorded fixtures to the given golden file.
+func (r *RoundTripRecorder) Save(path string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	data, err := json.MarshalIndent(r.fixtures, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// ReplayRoundTrips returns a round trip function serving the fixtures
+// recorded in the given golden file, in the order they were recorded.
+// Requests that do not match the next fixture's method and path return an
+// error, as does running out of fixtures.
+func ReplayRoundTrips(path string) (func(*http.Request) (*http.Response, error), error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var fixtures []RoundTripFixture
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		return nil, fmt.Errorf("cannot load round trip fixtures from %s: %v", path, err)
+	}
+
+	var lock sync.Mutex
+	next := 0
+	return func(req *http.Request) (*http.Response, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		if next >= len(fixtures) {
+			return nil, fmt.Errorf("unexpected request %s %s: all %d fixtures in %s already served", req.Method, req.URL.Path, len(fixtures), path)
+		}
+		fixture := fixtures[next]
+		if req.Method != fixture.Method || req.URL.Path != fixture.Path {
+			return nil, fmt.Errorf("unexpected request %s %s: fixture %d in %s is %s %s", req.Method, req.URL.Path, next, path, fixture.Method, fixture.Path)
+		}
+		next++
+
+		header := http.Header{}
+		for k, v := range fixture.ResponseHeaders {
+			header.Set(k, v)
+		}
+		return &http.Response{
+			StatusCode: fixture.StatusCode,
+			Header:     header,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(fixture.ResponseBody))),
+		}, nil
+	}, nil
+}
@@ -18,6 +18,7 @@ package top
 
 import (
 	"errors"
+	"sort"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -40,6 +41,7 @@ import (
 type TopNodeOptions struct {
 	ResourceName    string
 	Selector        string
+	SortBy          string
 	NoHeaders       bool
 	NodeClient      corev1client.CoreV1Interface
 	HeapsterOptions HeapsterTopOptions
@@ -113,6 +115,7 @@ func NewCmdTopNode(f cmdutil.Factory, o *TopNodeOptions, streams genericclioptio
 		Aliases: []string{"nodes", "no"},
 	}
 	cmd.Flags().StringVarP(&o.Selector, "selector", "l", o.Selector, "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	cmd.Flags().StringVar(&o.SortBy, "sort-by", o.SortBy, "If non-empty, sort nodes list using specified field. The field can be either 'cpu' or 'memory'.")
 	cmd.Flags().BoolVar(&o.NoHeaders, "no-headers", o.NoHeaders, "If present, print output without headers")
 
 	o.HeapsterOptions.Bind(cmd.Flags())
@@ -150,6 +153,11 @@ func (o *TopNodeOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []
 }
 
 func (o *TopNodeOptions) Validate() error {
+	if len(o.SortBy) > 0 {
+		if o.SortBy != sortByCPU && o.SortBy != sortByMemory {
+			return errors.New("--sort-by accepts only cpu or memory")
+		}
+	}
 	if len(o.ResourceName) > 0 && len(o.Selector) > 0 {
 		return errors.New("only one of NAME or --selector can be provided")
 	}
@@ -190,6 +198,10 @@ func (o TopNodeOptions) RunTopNode() error {
 		return errors.New("metrics not available yet")
 	}
 
+	if len(o.SortBy) > 0 {
+		sort.Sort(metricsutil.NewNodeMetricsSorter(metrics.Items, v1.ResourceName(o.SortBy)))
+	}
+
 	var nodes []v1.Node
 	if len(o.ResourceName) > 0 {
 		node, err := o.NodeClient.Nodes().Get(o.ResourceName, metav1.GetOptions{})
@@ -19,6 +19,7 @@ package top
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"k8s.io/api/core/v1"
@@ -43,6 +44,7 @@ type TopPodOptions struct {
 	ResourceName    string
 	Namespace       string
 	Selector        string
+	SortBy          string
 	AllNamespaces   bool
 	PrintContainers bool
 	NoHeaders       bool
@@ -104,6 +106,7 @@ func NewCmdTopPod(f cmdutil.Factory, o *TopPodOptions, streams genericclioptions
 	cmd.Flags().StringVarP(&o.Selector, "selector", "l", o.Selector, "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
 	cmd.Flags().BoolVar(&o.PrintContainers, "containers", o.PrintContainers, "If present, print usage of containers within a pod.")
 	cmd.Flags().BoolVarP(&o.AllNamespaces, "all-namespaces", "A", o.AllNamespaces, "If present, list the requested object(s) across all namespaces. Namespace in current context is ignored even if specified with --namespace.")
+	cmd.Flags().StringVar(&o.SortBy, "sort-by", o.SortBy, "If non-empty, sort pods list using specified field. The field can be either 'cpu' or 'memory'.")
 	cmd.Flags().BoolVar(&o.NoHeaders, "no-headers", o.NoHeaders, "If present, print output without headers.")
 	o.HeapsterOptions.Bind(cmd.Flags())
 	return cmd
@@ -144,6 +147,11 @@ func (o *TopPodOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []s
 }
 
 func (o *TopPodOptions) Validate() error {
+	if len(o.SortBy) > 0 {
+		if o.SortBy != sortByCPU && o.SortBy != sortByMemory {
+			return errors.New("--sort-by accepts only cpu or memory")
+		}
+	}
 	if len(o.ResourceName) > 0 && len(o.Selector) > 0 {
 		return errors.New("only one of NAME or --selector can be provided")
 	}
@@ -194,6 +202,10 @@ func (o TopPodOptions) RunTopPod() error {
 		return err
 	}
 
+	if len(o.SortBy) > 0 {
+		sort.Sort(metricsutil.NewPodMetricsSorter(metrics.Items, v1.ResourceName(o.SortBy)))
+	}
+
 	return o.Printer.PrintPodMetrics(metrics.Items, o.PrintContainers, o.AllNamespaces, o.NoHeaders)
 }
 
@@ -19,6 +19,7 @@ package editor
 import (
 	"bufio"
 	"bytes"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
@@ -27,6 +28,7 @@ import (
 	"reflect"
 	goruntime "runtime"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/evanphx/json-patch"
 	"github.com/spf13/cobra"
@@ -62,6 +64,7 @@ type EditOptions struct {
 
 	OutputPatch        bool
 	WindowsLineEndings bool
+	Decode             bool
 
 	cmdutil.ValidateOptions
 
@@ -167,6 +170,10 @@ func (o *EditOptions) Complete(f cmdutil.Factory, args []string, cmd *cobra.Comm
 		return fmt.Errorf("the edit mode doesn't support output the patch")
 	}
 
+	if o.Decode && o.EditMode != NormalEditMode {
+		return fmt.Errorf("the edit mode doesn't support --decode")
+	}
+
 	cmdNamespace, enforceNamespace, err := f.ToRawKubeConfigLoader().Namespace()
 	if err != nil {
 		return err
@@ -234,7 +241,7 @@ func (o *EditOptions) Run() error {
 			var originalObj runtime.Object
 			switch len(infos) {
 			case 1:
-				originalObj = infos[0].Object
+				originalObj = o.decodeSecretData(infos[0].Object)
 			default:
 				l := &unstructured.UnstructuredList{
 					Object: map[string]interface{}{
@@ -244,7 +251,7 @@ func (o *EditOptions) Run() error {
 					},
 				}
 				for _, info := range infos {
-					l.Items = append(l.Items, *info.Object.(*unstructured.Unstructured))
+					l.Items = append(l.Items, *o.decodeSecretData(info.Object).(*unstructured.Unstructured))
 				}
 				originalObj = l
 			}
@@ -335,6 +342,11 @@ func (o *EditOptions) Run() error {
 			}
 			// not a syntax error as it turns out...
 			containsError = false
+			if o.Decode {
+				for _, info := range updatedInfos {
+					o.encodeEditedSecretData(info.Object)
+				}
+			}
 			updatedVisitor := resource.InfoListVisitor(updatedInfos)
 
 			// need to make sure the original namespace wasn't changed while editing
@@ -436,6 +448,93 @@ func (o *EditOptions) Run() error {
 	}
 }
 
+// decodeSecretData returns a copy of a secret with base64-encoded data values
+// moved to the stringData field so they can be edited in clear text. Values
+// that do not decode to valid text are left in data untouched, since binary
+// content cannot be usefully edited in a text editor. Objects other than
+// secrets are returned unchanged, as is everything when --decode is off.
+func (o *EditOptions) decodeSecretData(obj runtime.Object) runtime.Object {
+	if !o.Decode {
+		return obj
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok || u.GetAPIVersion() != "v1" || u.GetKind() != "Secret" {
+		return obj
+	}
+	if data, ok := u.Object["data"].(map[string]interface{}); !ok || len(data) == 0 {
+		return obj
+	}
+	u = u.DeepCopy()
+	data := u.Object["data"].(map[string]interface{})
+	stringData := map[string]interface{}{}
+	for k, v := range data {
+		encoded, ok := v.(string)
+		if !ok {
+			continue
+		}
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			fmt.Fprintf(o.ErrOut, "warning: secret key %q is not valid base64 and is left encoded\n", k)
+			continue
+		}
+		if !utf8.Valid(decoded) || bytes.ContainsAny(string(decoded), "\x00") {
+			fmt.Fprintf(o.ErrOut, "warning: secret key %q contains binary data and is left base64-encoded\n", k)
+			continue
+		}
+		stringData[k] = string(decoded)
+		delete(data, k)
+	}
+	if len(stringData) == 0 {
+		return obj
+	}
+	if len(data) == 0 {
+		delete(u.Object, "data")
+	}
+	u.Object["stringData"] = stringData
+	return u
+}
+
+// encodeEditedSecretData folds the stringData entries of an edited secret back
+// into base64-encoded data entries, so the patch computed against the original
+// object only touches the data field. A warning is printed when the re-encoded
+// secret is larger than the apiserver accepts.
+func (o *EditOptions) encodeEditedSecretData(obj runtime.Object) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok || u.GetAPIVersion() != "v1" || u.GetKind() != "Secret" {
+		return
+	}
+	stringData, ok := u.Object["stringData"].(map[string]interface{})
+	if !ok || len(stringData) == 0 {
+		return
+	}
+	data, ok := u.Object["data"].(map[string]interface{})
+	if !ok {
+		data = map[string]interface{}{}
+	}
+	for k, v := range stringData {
+		// non-string values are left for server-side validation to reject
+		if s, ok := v.(string); ok {
+			data[k] = base64.StdEncoding.EncodeToString([]byte(s))
+			delete(stringData, k)
+		}
+	}
+	if len(stringData) == 0 {
+		delete(u.Object, "stringData")
+	}
+	if len(data) > 0 {
+		u.Object["data"] = data
+	}
+	totalSize := 0
+	for _, v := range data {
+		if s, ok := v.(string); ok {
+			totalSize += base64.StdEncoding.DecodedLen(len(s))
+		}
+	}
+	if totalSize > corev1.MaxSecretSize {
+		fmt.Fprintf(o.ErrOut, "warning: secret data is larger than %d bytes and will be rejected by the server\n", corev1.MaxSecretSize)
+	}
+}
+
 func (o *EditOptions) visitToApplyEditPatch(originalInfos []*resource.Info, patchVisitor resource.Visitor) error {
 	err := patchVisitor.Visit(func(info *resource.Info, incomingErr error) error {
 		editObjUID, err := meta.NewAccessor().UID(info.Object)
@@ -17,9 +17,73 @@ limitations under the License.
 package editor
 
 import (
+	"encoding/base64"
+	"reflect"
 	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
+func TestDecodeSecretDataRoundTrip(t *testing.T) {
+	o := NewEditOptions(NormalEditMode, genericclioptions.NewTestIOStreamsDiscard())
+	o.Decode = true
+
+	binary := base64.StdEncoding.EncodeToString([]byte{0x00, 0x01, 0xff})
+	secret := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]interface{}{"name": "test", "namespace": "default"},
+			"data": map[string]interface{}{
+				"username": base64.StdEncoding.EncodeToString([]byte("admin")),
+				"blob":     binary,
+			},
+		},
+	}
+
+	decoded, ok := o.decodeSecretData(secret).(*unstructured.Unstructured)
+	if !ok {
+		t.Fatalf("expected an unstructured object back")
+	}
+	if decoded == secret {
+		t.Fatalf("expected a copy, got the original object")
+	}
+	expectedStringData := map[string]interface{}{"username": "admin"}
+	if !reflect.DeepEqual(decoded.Object["stringData"], expectedStringData) {
+		t.Errorf("unexpected stringData: %v", decoded.Object["stringData"])
+	}
+	expectedData := map[string]interface{}{"blob": binary}
+	if !reflect.DeepEqual(decoded.Object["data"], expectedData) {
+		t.Errorf("expected binary value to stay encoded, got: %v", decoded.Object["data"])
+	}
+
+	o.encodeEditedSecretData(decoded)
+	if _, found := decoded.Object["stringData"]; found {
+		t.Errorf("expected stringData to be folded back into data")
+	}
+	if !reflect.DeepEqual(decoded.Object["data"], secret.Object["data"]) {
+		t.Errorf("expected re-encoded data to match the original, got: %v", decoded.Object["data"])
+	}
+}
+
+func TestDecodeSecretDataSkipsNonSecrets(t *testing.T) {
+	o := NewEditOptions(NormalEditMode, genericclioptions.NewTestIOStreamsDiscard())
+	o.Decode = true
+
+	cm := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": "test"},
+			"data":       map[string]interface{}{"key": "value"},
+		},
+	}
+	if got := o.decodeSecretData(cm); got != cm {
+		t.Errorf("expected non-secret objects to be returned unchanged")
+	}
+}
+
 func TestHashOnLineBreak(t *testing.T) {
 	tests := []struct {
 		original string
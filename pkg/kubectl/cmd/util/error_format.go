@@ -0,0 +1,156 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	utilexec "k8s.io/utils/exec"
+)
+
+// Supported values for the --error-format flag.
+const (
+	ErrorFormatText = "text"
+	ErrorFormatJSON = "json"
+)
+
+// Differentiated exit codes used when --error-format=json is in effect, so
+// that callers can branch on the class of failure without parsing stderr.
+const (
+	NotFoundErrorExitCode = 3
+	ConflictErrorExitCode = 4
+	TimeoutErrorExitCode  = 5
+)
+
+// errorFormat holds the error output mode selected via --error-format.
+var errorFormat = ErrorFormatText
+
+// SetErrorFormat selects how fatal errors are rendered. It returns an error
+// if the given format is not recognized.
+func SetErrorFormat(format string) error {
+	switch format {
+	case ErrorFormatText, ErrorFormatJSON:
+		errorFormat = format
+		return nil
+	default:
+		return fmt.Errorf("unknown error format %q, must be one of (%s|%s)", format, ErrorFormatText, ErrorFormatJSON)
+	}
+}
+
+// StructuredError is the machine-readable error object written to stderr when
+// --error-format=json is in effect.
+type StructuredError struct {
+	// Reason is a stable, machine-friendly classification of the failure.
+	Reason string `json:"reason"`
+	// Message is the human-readable error text.
+	Message string `json:"message"`
+	// Resource identifies the object or resource type the error refers to,
+	// if it can be derived from the error.
+	Resource string `json:"resource,omitempty"`
+	// Suggestion is a hint on how the caller might recover, if one applies.
+	Suggestion string `json:"suggestion,omitempty"`
+	// ExitCode is the process exit code associated with this failure.
+	ExitCode int `json:"exitCode"`
+}
+
+// structuredErrorMessage renders err as a single-line JSON object and returns
+// it together with the exit code to use.
+func structuredErrorMessage(err error) (string, int) {
+	code := exitCodeForError(err)
+	structured := StructuredError{
+		Reason:   reasonForError(err),
+		Message:  err.Error(),
+		ExitCode: code,
+	}
+
+	if status, ok := err.(kerrors.APIStatus); ok {
+		if details := status.Status().Details; details != nil {
+			structured.Resource = resourceFromDetails(details.Kind, details.Name)
+		}
+	}
+	if noMatch, ok := err.(*meta.NoResourceMatchError); ok {
+		structured.Resource = noMatch.PartialResource.Resource
+	}
+
+	switch {
+	case kerrors.IsNotFound(err) || isNoResourceMatch(err):
+		structured.Suggestion = "verify the resource type, name and namespace"
+	case kerrors.IsConflict(err) || kerrors.IsAlreadyExists(err):
+		structured.Suggestion = "fetch the latest version of the object and retry"
+	case kerrors.IsTimeout(err) || kerrors.IsServerTimeout(err):
+		structured.Suggestion = "retry the operation"
+	case kerrors.IsUnauthorized(err) || kerrors.IsForbidden(err):
+		structured.Suggestion = "check your credentials and RBAC permissions"
+	}
+
+	data, marshalErr := json.Marshal(structured)
+	if marshalErr != nil {
+		// Fall back to the plain error text rather than losing the failure.
+		return err.Error(), code
+	}
+	return string(data), code
+}
+
+// exitCodeForError maps classes of errors to differentiated exit codes.
+// Errors that carry their own exit status keep it; everything else that is
+// not specially classified exits with DefaultErrorExitCode.
+func exitCodeForError(err error) int {
+	if exitErr, ok := err.(utilexec.ExitError); ok {
+		return exitErr.ExitStatus()
+	}
+	switch {
+	case kerrors.IsNotFound(err) || isNoResourceMatch(err):
+		return NotFoundErrorExitCode
+	case kerrors.IsConflict(err) || kerrors.IsAlreadyExists(err):
+		return ConflictErrorExitCode
+	case kerrors.IsTimeout(err) || kerrors.IsServerTimeout(err):
+		return TimeoutErrorExitCode
+	default:
+		return DefaultErrorExitCode
+	}
+}
+
+// reasonForError returns a stable classification string for err.
+func reasonForError(err error) string {
+	if isNoResourceMatch(err) {
+		return "NoResourceMatch"
+	}
+	if reason := kerrors.ReasonForError(err); len(reason) > 0 {
+		return string(reason)
+	}
+	return "Unknown"
+}
+
+func isNoResourceMatch(err error) bool {
+	_, ok := err.(*meta.NoResourceMatchError)
+	return ok
+}
+
+// resourceFromDetails renders "kind/name" from status details, tolerating
+// either half being empty.
+func resourceFromDetails(kind, name string) string {
+	if len(kind) == 0 {
+		return name
+	}
+	if len(name) == 0 {
+		return kind
+	}
+	return kind + "/" + name
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/exec"
+)
+
+func TestSetErrorFormat(t *testing.T) {
+	defer func() { errorFormat = ErrorFormatText }()
+
+	if err := SetErrorFormat(ErrorFormatJSON); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if errorFormat != ErrorFormatJSON {
+		t.Errorf("expected error format to be set to json, got %q", errorFormat)
+	}
+	if err := SetErrorFormat("xml"); err == nil {
+		t.Errorf("expected an error for an unknown format")
+	}
+}
+
+func TestExitCodeForError(t *testing.T) {
+	tests := []struct {
+		err          error
+		expectedCode int
+	}{
+		{errors.NewNotFound(schema.GroupResource{Resource: "pods"}, "foo"), NotFoundErrorExitCode},
+		{&meta.NoResourceMatchError{PartialResource: schema.GroupVersionResource{Resource: "foo"}}, NotFoundErrorExitCode},
+		{errors.NewConflict(schema.GroupResource{Resource: "pods"}, "foo", fmt.Errorf("conflict")), ConflictErrorExitCode},
+		{errors.NewAlreadyExists(schema.GroupResource{Resource: "pods"}, "foo"), ConflictErrorExitCode},
+		{errors.NewTimeoutError("timed out", 1), TimeoutErrorExitCode},
+		{errors.NewServerTimeout(schema.GroupResource{Resource: "pods"}, "get", 1), TimeoutErrorExitCode},
+		{exec.CodeExitError{Err: fmt.Errorf("terminated"), Code: 42}, 42},
+		{fmt.Errorf("some other error"), DefaultErrorExitCode},
+	}
+
+	for _, test := range tests {
+		if code := exitCodeForError(test.err); code != test.expectedCode {
+			t.Errorf("%v: expected exit code %d, got %d", test.err, test.expectedCode, code)
+		}
+	}
+}
+
+func TestStructuredErrorMessage(t *testing.T) {
+	msg, code := structuredErrorMessage(errors.NewNotFound(schema.GroupResource{Resource: "pods"}, "foo"))
+	if code != NotFoundErrorExitCode {
+		t.Errorf("expected exit code %d, got %d", NotFoundErrorExitCode, code)
+	}
+
+	structured := StructuredError{}
+	if err := json.Unmarshal([]byte(msg), &structured); err != nil {
+		t.Fatalf("expected valid JSON, got %q: %v", msg, err)
+	}
+	if structured.Reason != "NotFound" {
+		t.Errorf("expected reason NotFound, got %q", structured.Reason)
+	}
+	if structured.Resource != "foo" {
+		t.Errorf("expected resource foo, got %q", structured.Resource)
+	}
+	if len(structured.Suggestion) == 0 {
+		t.Errorf("expected a suggestion for a not-found error")
+	}
+	if structured.ExitCode != NotFoundErrorExitCode {
+		t.Errorf("expected embedded exit code %d, got %d", NotFoundErrorExitCode, structured.ExitCode)
+	}
+}
+
+func TestCheckErrStructuredFormat(t *testing.T) {
+	defer func() { errorFormat = ErrorFormatText }()
+	errorFormat = ErrorFormatJSON
+
+	var errReturned string
+	var codeReturned int
+	checkErr(errors.NewConflict(schema.GroupResource{Resource: "pods"}, "foo", fmt.Errorf("conflict")), func(err string, code int) {
+		errReturned = err
+		codeReturned = code
+	})
+
+	if codeReturned != ConflictErrorExitCode {
+		t.Errorf("expected exit code %d, got %d", ConflictErrorExitCode, codeReturned)
+	}
+	structured := StructuredError{}
+	if err := json.Unmarshal([]byte(errReturned), &structured); err != nil {
+		t.Fatalf("expected valid JSON on stderr, got %q: %v", errReturned, err)
+	}
+	if structured.Reason != "Conflict" {
+		t.Errorf("expected reason Conflict, got %q", structured.Reason)
+	}
+
+	// ErrExit stays silent regardless of the selected format.
+	errReturned = ""
+	checkErr(ErrExit, func(err string, code int) {
+		errReturned = err
+		codeReturned = code
+	})
+	if errReturned != "" || codeReturned != DefaultErrorExitCode {
+		t.Errorf("expected ErrExit to produce no output and exit code %d, got %q and %d", DefaultErrorExitCode, errReturned, codeReturned)
+	}
+}
@@ -126,6 +126,13 @@ func checkErr(err error, handleErr func(string, int)) {
 		return
 	}
 
+	// In structured mode the error is rendered as a machine-readable object
+	// with a differentiated exit code instead of the text formatting below.
+	if errorFormat == ErrorFormatJSON && err != ErrExit {
+		handleErr(structuredErrorMessage(err))
+		return
+	}
+
 	switch {
 	case err == ErrExit:
 		handleErr("", DefaultErrorExitCode)
@@ -409,9 +416,15 @@ func AddDryRunFlag(cmd *cobra.Command) {
 }
 
 func AddServerSideApplyFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("server-side", false, "If true, apply runs in the server instead of the client. This is an alpha feature and flag.")
+	cmd.Flags().Bool("force-conflicts", false, "If true, server-side apply will force the changes against conflicts. This is an alpha feature and flag.")
+	cmd.Flags().String("field-manager", "kubectl", "Name of the manager used to track field ownership. This is an alpha feature and flag.")
 	cmd.Flags().Bool("experimental-server-side", false, "If true, apply runs in the server instead of the client. This is an alpha feature and flag.")
+	cmd.Flags().MarkDeprecated("experimental-server-side", "use --server-side instead.")
 	cmd.Flags().Bool("experimental-force-conflicts", false, "If true, server-side apply will force the changes against conflicts. This is an alpha feature and flag.")
+	cmd.Flags().MarkDeprecated("experimental-force-conflicts", "use --force-conflicts instead.")
 	cmd.Flags().String("experimental-field-manager", "kubectl", "Name of the manager used to track field ownership. This is an alpha feature and flag.")
+	cmd.Flags().MarkDeprecated("experimental-field-manager", "use --field-manager instead.")
 }
 
 func AddIncludeUninitializedFlag(cmd *cobra.Command) {
@@ -488,15 +501,18 @@ func DumpReaderToFile(reader io.Reader, filename string) error {
 }
 
 func GetServerSideApplyFlag(cmd *cobra.Command) bool {
-	return GetFlagBool(cmd, "experimental-server-side")
+	return GetFlagBool(cmd, "server-side") || GetFlagBool(cmd, "experimental-server-side")
 }
 
 func GetForceConflictsFlag(cmd *cobra.Command) bool {
-	return GetFlagBool(cmd, "experimental-force-conflicts")
+	return GetFlagBool(cmd, "force-conflicts") || GetFlagBool(cmd, "experimental-force-conflicts")
 }
 
 func GetFieldManagerFlag(cmd *cobra.Command) string {
-	return GetFlagString(cmd, "experimental-field-manager")
+	if cmd.Flags().Changed("experimental-field-manager") {
+		return GetFlagString(cmd, "experimental-field-manager")
+	}
+	return GetFlagString(cmd, "field-manager")
 }
 
 func GetDryRunFlag(cmd *cobra.Command) bool {
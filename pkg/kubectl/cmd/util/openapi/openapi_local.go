@@ -0,0 +1,73 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/protobuf/proto"
+	openapi_v2 "github.com/googleapis/gnostic/OpenAPIv2"
+)
+
+// LocalSchemaFileName is the name of the file holding the openapi spec
+// inside a local schema directory written by WriteSchemaFile.
+const LocalSchemaFileName = "openapi_schema"
+
+// localSchemaGetter reads the openapi spec from a local schema directory
+// instead of a server, so manifests can be validated without cluster access.
+type localSchemaGetter struct {
+	dir string
+}
+
+var _ Getter = &localSchemaGetter{}
+
+// NewLocalSchemaGetter returns a Getter reading the openapi spec from the
+// given directory, as written by WriteSchemaFile.
+func NewLocalSchemaGetter(dir string) Getter {
+	return &localSchemaGetter{dir: dir}
+}
+
+// Get implements Getter
+func (g *localSchemaGetter) Get() (Resources, error) {
+	data, err := ioutil.ReadFile(filepath.Join(g.dir, LocalSchemaFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &openapi_v2.Document{}
+	if err := proto.Unmarshal(data, doc); err != nil {
+		return nil, err
+	}
+	return NewOpenAPIData(doc)
+}
+
+// WriteSchemaFile writes the given openapi spec into a local schema
+// directory, creating it if necessary, so it can later be read by
+// NewLocalSchemaGetter.
+func WriteSchemaFile(doc *openapi_v2.Document, dir string) error {
+	data, err := proto.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, LocalSchemaFileName), data, 0644)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricsutil
+
+import (
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metricsapi "k8s.io/metrics/pkg/apis/metrics"
+)
+
+// NodeMetricsSorter sorts a list of node metrics by the usage of the
+// given resource, most consuming node first.
+type NodeMetricsSorter struct {
+	metrics []metricsapi.NodeMetrics
+	sortBy  v1.ResourceName
+}
+
+// NewNodeMetricsSorter returns a sorter ordering metrics by the usage of
+// the given resource.
+func NewNodeMetricsSorter(metrics []metricsapi.NodeMetrics, sortBy v1.ResourceName) *NodeMetricsSorter {
+	return &NodeMetricsSorter{
+		metrics: metrics,
+		sortBy:  sortBy,
+	}
+}
+
+func (n *NodeMetricsSorter) Len() int {
+	return len(n.metrics)
+}
+
+func (n *NodeMetricsSorter) Swap(i, j int) {
+	n.metrics[i], n.metrics[j] = n.metrics[j], n.metrics[i]
+}
+
+func (n *NodeMetricsSorter) Less(i, j int) bool {
+	qi := n.metrics[i].Usage[n.sortBy]
+	qj := n.metrics[j].Usage[n.sortBy]
+	return qi.Cmp(qj) > 0
+}
+
+// PodMetricsSorter sorts a list of pod metrics by the total usage of the
+// given resource over all the pod's containers, most consuming pod first.
+type PodMetricsSorter struct {
+	metrics []metricsapi.PodMetrics
+	sortBy  v1.ResourceName
+}
+
+// NewPodMetricsSorter returns a sorter ordering metrics by the usage of
+// the given resource.
+func NewPodMetricsSorter(metrics []metricsapi.PodMetrics, sortBy v1.ResourceName) *PodMetricsSorter {
+	return &PodMetricsSorter{
+		metrics: metrics,
+		sortBy:  sortBy,
+	}
+}
+
+func (p *PodMetricsSorter) Len() int {
+	return len(p.metrics)
+}
+
+func (p *PodMetricsSorter) Swap(i, j int) {
+	p.metrics[i], p.metrics[j] = p.metrics[j], p.metrics[i]
+}
+
+func (p *PodMetricsSorter) Less(i, j int) bool {
+	return podResourceUsage(&p.metrics[i], p.sortBy).Cmp(podResourceUsage(&p.metrics[j], p.sortBy)) > 0
+}
+
+// podResourceUsage returns the total usage of the given resource over all
+// the pod's containers.
+func podResourceUsage(metrics *metricsapi.PodMetrics, resourceName v1.ResourceName) resource.Quantity {
+	var total resource.Quantity
+	for _, container := range metrics.Containers {
+		if quantity, found := container.Usage[resourceName]; found {
+			total.Add(quantity)
+		}
+	}
+	return total
+}
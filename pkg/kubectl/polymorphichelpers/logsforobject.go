@@ -28,10 +28,12 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/reference"
+	"k8s.io/kubernetes/pkg/kubectl/scheme"
 	"k8s.io/kubernetes/pkg/kubectl/util/podutils"
 )
 
-func logsForObject(restClientGetter genericclioptions.RESTClientGetter, object, options runtime.Object, timeout time.Duration, allContainers bool) ([]rest.ResponseWrapper, error) {
+func logsForObject(restClientGetter genericclioptions.RESTClientGetter, object, options runtime.Object, timeout time.Duration, allContainers bool) (map[corev1.ObjectReference]rest.ResponseWrapper, error) {
 	clientConfig, err := restClientGetter.ToRESTConfig()
 	if err != nil {
 		return nil, err
@@ -46,7 +48,7 @@ func logsForObject(restClientGetter genericclioptions.RESTClientGetter, object,
 
 // TODO: remove internal clientset once all callers use external versions
 // this is split for easy test-ability
-func logsForObjectWithClient(clientset corev1client.CoreV1Interface, object, options runtime.Object, timeout time.Duration, allContainers bool) ([]rest.ResponseWrapper, error) {
+func logsForObjectWithClient(clientset corev1client.CoreV1Interface, object, options runtime.Object, timeout time.Duration, allContainers bool) (map[corev1.ObjectReference]rest.ResponseWrapper, error) {
 	opts, ok := options.(*corev1.PodLogOptions)
 	if !ok {
 		return nil, errors.New("provided options object is not a PodLogOptions")
@@ -54,23 +56,34 @@ func logsForObjectWithClient(clientset corev1client.CoreV1Interface, object, opt
 
 	switch t := object.(type) {
 	case *corev1.PodList:
-		ret := []rest.ResponseWrapper{}
+		ret := map[corev1.ObjectReference]rest.ResponseWrapper{}
 		for i := range t.Items {
 			currRet, err := logsForObjectWithClient(clientset, &t.Items[i], options, timeout, allContainers)
 			if err != nil {
 				return nil, err
 			}
-			ret = append(ret, currRet...)
+			for ref, rw := range currRet {
+				ret[ref] = rw
+			}
 		}
 		return ret, nil
 
 	case *corev1.Pod:
 		// if allContainers is true, then we're going to locate all containers and then iterate through them. At that point, "allContainers" is false
 		if !allContainers {
-			return []rest.ResponseWrapper{clientset.Pods(t.Namespace).GetLogs(t.Name, opts)}, nil
+			ref, err := reference.GetReference(scheme.Scheme, t)
+			if err != nil {
+				return nil, fmt.Errorf("unable to construct reference to '%#v': %v", t, err)
+			}
+			container := ""
+			if opts != nil {
+				container = opts.Container
+			}
+			ref.FieldPath = containerFieldPath(t, container)
+			return map[corev1.ObjectReference]rest.ResponseWrapper{*ref: clientset.Pods(t.Namespace).GetLogs(t.Name, opts)}, nil
 		}
 
-		ret := []rest.ResponseWrapper{}
+		ret := map[corev1.ObjectReference]rest.ResponseWrapper{}
 		for _, c := range t.Spec.InitContainers {
 			currOpts := opts.DeepCopy()
 			currOpts.Container = c.Name
@@ -78,7 +91,9 @@ func logsForObjectWithClient(clientset corev1client.CoreV1Interface, object, opt
 			if err != nil {
 				return nil, err
 			}
-			ret = append(ret, currRet...)
+			for ref, rw := range currRet {
+				ret[ref] = rw
+			}
 		}
 		for _, c := range t.Spec.Containers {
 			currOpts := opts.DeepCopy()
@@ -87,7 +102,9 @@ func logsForObjectWithClient(clientset corev1client.CoreV1Interface, object, opt
 			if err != nil {
 				return nil, err
 			}
-			ret = append(ret, currRet...)
+			for ref, rw := range currRet {
+				ret[ref] = rw
+			}
 		}
 
 		return ret, nil
@@ -109,3 +126,22 @@ func logsForObjectWithClient(clientset corev1client.CoreV1Interface, object, opt
 
 	return logsForObjectWithClient(clientset, pod, options, timeout, allContainers)
 }
+
+// containerFieldPath returns the reference field path for the named container
+// of the pod, so that callers can recover the container name from a reference
+// without an extra API request. An empty name refers to the pod's only
+// container.
+func containerFieldPath(pod *corev1.Pod, name string) string {
+	if name == "" {
+		if len(pod.Spec.Containers) != 1 {
+			return ""
+		}
+		name = pod.Spec.Containers[0].Name
+	}
+	for _, c := range pod.Spec.InitContainers {
+		if c.Name == name {
+			return fmt.Sprintf("spec.initContainers{%s}", name)
+		}
+	}
+	return fmt.Sprintf("spec.containers{%s}", name)
+}
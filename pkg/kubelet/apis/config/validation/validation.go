@@ -42,6 +42,15 @@ func ValidateKubeletConfiguration(kc *kubeletconfig.KubeletConfiguration) error
 	if kc.NodeLeaseDurationSeconds <= 0 {
 		allErrors = append(allErrors, fmt.Errorf("invalid configuration: NodeLeaseDurationSeconds must be greater than 0"))
 	}
+	if kc.ShutdownGracePeriod.Duration < 0 {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: ShutdownGracePeriod %v must not be negative", kc.ShutdownGracePeriod.Duration))
+	}
+	if kc.ShutdownGracePeriodCriticalPods.Duration < 0 {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: ShutdownGracePeriodCriticalPods %v must not be negative", kc.ShutdownGracePeriodCriticalPods.Duration))
+	}
+	if kc.ShutdownGracePeriodCriticalPods.Duration > kc.ShutdownGracePeriod.Duration {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: ShutdownGracePeriodCriticalPods %v must be no greater than ShutdownGracePeriod %v", kc.ShutdownGracePeriodCriticalPods.Duration, kc.ShutdownGracePeriod.Duration))
+	}
 	if !kc.CgroupsPerQOS && len(kc.EnforceNodeAllocatable) > 0 {
 		allErrors = append(allErrors, fmt.Errorf("invalid configuration: EnforceNodeAllocatable (--enforce-node-allocatable) is not supported unless CgroupsPerQOS (--cgroups-per-qos) feature is turned on"))
 	}
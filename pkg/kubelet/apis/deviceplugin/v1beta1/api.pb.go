@@ -70,6 +70,10 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type DevicePluginOptions struct {
 	// Indicates if PreStartContainer call is required before each container start
 	PreStartRequired bool `protobuf:"varint,1,opt,name=pre_start_required,json=preStartRequired,proto3" json:"pre_start_required,omitempty"`
+	// Indicates that the plugin reconfigures hardware during Allocate and
+	// needs the call to be re-issued for restarted containers even when the
+	// assigned devices are unchanged
+	ReallocateOnRestart bool `protobuf:"varint,2,opt,name=reallocate_on_restart,json=reallocateOnRestart,proto3" json:"reallocate_on_restart,omitempty"`
 }
 
 func (m *DevicePluginOptions) Reset()                    { *m = DevicePluginOptions{} }
@@ -83,6 +87,13 @@ func (m *DevicePluginOptions) GetPreStartRequired() bool {
 	return false
 }
 
+func (m *DevicePluginOptions) GetReallocateOnRestart() bool {
+	if m != nil {
+		return m.ReallocateOnRestart
+	}
+	return false
+}
+
 type RegisterRequest struct {
 	// Version of the API the Device Plugin was built against
 	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -713,6 +724,16 @@ func (m *DevicePluginOptions) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.ReallocateOnRestart {
+		dAtA[i] = 0x10
+		i++
+		if m.ReallocateOnRestart {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1151,6 +1172,9 @@ func (m *DevicePluginOptions) Size() (n int) {
 	if m.PreStartRequired {
 		n += 2
 	}
+	if m.ReallocateOnRestart {
+		n += 2
+	}
 	return n
 }
 
@@ -1350,6 +1374,7 @@ func (this *DevicePluginOptions) String() string {
 	}
 	s := strings.Join([]string{`&DevicePluginOptions{`,
 		`PreStartRequired:` + fmt.Sprintf("%v", this.PreStartRequired) + `,`,
+		`ReallocateOnRestart:` + fmt.Sprintf("%v", this.ReallocateOnRestart) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1560,6 +1585,26 @@ func (m *DevicePluginOptions) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.PreStartRequired = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReallocateOnRestart", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReallocateOnRestart = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
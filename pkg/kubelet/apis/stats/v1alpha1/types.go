@@ -135,6 +135,10 @@ type ContainerStats struct {
 	Memory *MemoryStats `json:"memory,omitempty"`
 	// Metrics for Accelerators. Each Accelerator corresponds to one element in the array.
 	Accelerators []AcceleratorStats `json:"accelerators,omitempty"`
+	// Stats for device plugin resources assigned to the container.
+	// Each entry corresponds to one extended resource.
+	// +optional
+	Devices []DeviceStats `json:"devices,omitempty"`
 	// Stats pertaining to container rootfs usage of filesystem resources.
 	// Rootfs.UsedBytes is the number of bytes used for the container write layer.
 	// +optional
@@ -225,6 +229,18 @@ type MemoryStats struct {
 	MajorPageFaults *uint64 `json:"majorPageFaults,omitempty"`
 }
 
+// DeviceStats contains the assignment of device plugin resources to the container.
+type DeviceStats struct {
+	// Name of the extended resource exposed by the device plugin.
+	ResourceName string `json:"resourceName"`
+
+	// IDs of the devices assigned to the container for this resource.
+	DeviceIDs []string `json:"deviceIds,omitempty"`
+
+	// Number of devices assigned to the container for this resource.
+	AllocatedCount int64 `json:"allocatedCount"`
+}
+
 // AcceleratorStats contains stats for accelerators attached to the container.
 type AcceleratorStats struct {
 	// Make of the accelerator (nvidia, amd, google etc.)
@@ -48,6 +48,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/cadvisor"
 	"k8s.io/kubernetes/pkg/kubelet/cm/cpumanager"
 	"k8s.io/kubernetes/pkg/kubelet/cm/devicemanager"
+	"k8s.io/kubernetes/pkg/kubelet/cm/topologymanager"
 	cmutil "k8s.io/kubernetes/pkg/kubelet/cm/util"
 	"k8s.io/kubernetes/pkg/kubelet/config"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
@@ -137,6 +138,8 @@ type containerManagerImpl struct {
 	deviceManager devicemanager.Manager
 	// Interface for CPU affinity management.
 	cpuManager cpumanager.Manager
+	// Interface for NUMA alignment across resource managers.
+	topologyManager topologymanager.Manager
 }
 
 type features struct {
@@ -308,6 +311,22 @@ func NewContainerManager(mountUtil mount.Interface, cadvisorInterface cadvisor.I
 		}
 	}
 
+	// Initialize the topology manager and register the resource managers that
+	// have NUMA placement preferences.
+	topologyManagerPolicy := nodeConfig.ExperimentalTopologyManagerPolicy
+	if topologyManagerPolicy == "" {
+		topologyManagerPolicy = topologymanager.PolicyBestEffort
+	}
+	cm.topologyManager, err = topologymanager.NewManager(topologyManagerPolicy)
+	if err != nil {
+		klog.Errorf("failed to initialize topology manager: %v", err)
+		return nil, err
+	}
+	cm.topologyManager.AddHintProvider(cm.deviceManager)
+	if cm.cpuManager != nil {
+		cm.topologyManager.AddHintProvider(cm.cpuManager)
+	}
+
 	return cm, nil
 }
 
@@ -643,7 +662,18 @@ func (cm *containerManagerImpl) GetResources(pod *v1.Pod, container *v1.Containe
 }
 
 func (cm *containerManagerImpl) UpdatePluginResources(node *schedulernodeinfo.NodeInfo, attrs *lifecycle.PodAdmitAttributes) error {
-	return cm.deviceManager.Allocate(node, attrs)
+	if err := cm.deviceManager.Allocate(node, attrs); err != nil {
+		return err
+	}
+	// With the strict topology manager policy, reject the pod at admission if
+	// its exclusive CPUs and devices cannot be aligned on a single NUMA node.
+	pod := attrs.Pod
+	for i := range pod.Spec.Containers {
+		if _, err := cm.topologyManager.Affinity(pod, &pod.Spec.Containers[i]); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (cm *containerManagerImpl) SystemCgroupsLimit() v1.ResourceList {
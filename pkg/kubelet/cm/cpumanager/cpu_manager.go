@@ -31,6 +31,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/cm/cpumanager/state"
 	"k8s.io/kubernetes/pkg/kubelet/cm/cpumanager/topology"
 	"k8s.io/kubernetes/pkg/kubelet/cm/cpuset"
+	"k8s.io/kubernetes/pkg/kubelet/cm/topologymanager"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
 	"k8s.io/kubernetes/pkg/kubelet/status"
 )
@@ -64,6 +65,10 @@ type Manager interface {
 
 	// State returns a read-only interface to the internal CPU manager state.
 	State() state.Reader
+
+	// GetTopologyHints implements the topologymanager.HintProvider interface
+	// so the topology manager can align exclusive CPUs with other resources.
+	GetTopologyHints(pod *v1.Pod, container *v1.Container) []topologymanager.TopologyHint
 }
 
 type manager struct {
@@ -210,6 +215,17 @@ func (m *manager) State() state.Reader {
 	return m.state
 }
 
+func (m *manager) GetTopologyHints(pod *v1.Pod, container *v1.Container) []topologymanager.TopologyHint {
+	// Only the static policy has NUMA placement preferences.
+	policy, ok := m.policy.(*staticPolicy)
+	if !ok {
+		return nil
+	}
+	m.Lock()
+	defer m.Unlock()
+	return policy.GetTopologyHints(m.state, pod, container)
+}
+
 type reconciledContainer struct {
 	podName       string
 	containerName string
@@ -20,11 +20,13 @@ import (
 	"fmt"
 
 	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog"
 	v1qos "k8s.io/kubernetes/pkg/apis/core/v1/helper/qos"
 	"k8s.io/kubernetes/pkg/kubelet/cm/cpumanager/state"
 	"k8s.io/kubernetes/pkg/kubelet/cm/cpumanager/topology"
 	"k8s.io/kubernetes/pkg/kubelet/cm/cpuset"
+	"k8s.io/kubernetes/pkg/kubelet/cm/topologymanager"
 )
 
 // PolicyStatic is the name of the static policy
@@ -216,6 +218,25 @@ func (p *staticPolicy) allocateCPUs(s state.State, numCPUs int) (cpuset.CPUSet,
 	return result, nil
 }
 
+// GetTopologyHints returns the sockets on which the container's entire
+// exclusive CPU request can be satisfied from assignable CPUs. Sockets stand
+// in for NUMA nodes, matching the assumption made elsewhere in this package.
+// Containers that will not receive exclusive CPUs impose no restriction.
+func (p *staticPolicy) GetTopologyHints(s state.State, pod *v1.Pod, container *v1.Container) []topologymanager.TopologyHint {
+	numCPUs := guaranteedCPUs(pod, container)
+	if numCPUs == 0 {
+		return nil
+	}
+	assignable := p.assignableCPUs(s)
+	nodes := sets.NewInt()
+	for _, socketID := range p.topology.CPUDetails.Sockets().ToSlice() {
+		if assignable.Intersection(p.topology.CPUDetails.CPUsInSocket(socketID)).Size() >= numCPUs {
+			nodes.Insert(socketID)
+		}
+	}
+	return []topologymanager.TopologyHint{{NUMANodes: nodes}}
+}
+
 func guaranteedCPUs(pod *v1.Pod, container *v1.Container) int {
 	if v1qos.GetPodQOS(pod) != v1.PodQOSGuaranteed {
 		return 0
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/kubelet/cm/cpumanager/state"
 	"k8s.io/kubernetes/pkg/kubelet/cm/cpumanager/topology"
 	"k8s.io/kubernetes/pkg/kubelet/cm/cpuset"
@@ -512,3 +513,70 @@ func TestStaticPolicyRemove(t *testing.T) {
 		}
 	}
 }
+
+func TestStaticPolicyGetTopologyHints(t *testing.T) {
+	testCases := []struct {
+		description     string
+		topo            *topology.CPUTopology
+		stDefaultCPUSet cpuset.CPUSet
+		pod             *v1.Pod
+		expNodes        sets.Int
+		expNoHints      bool
+	}{
+		{
+			description:     "non-guaranteed pod imposes no restriction",
+			topo:            topoDualSocketHT,
+			stDefaultCPUSet: cpuset.NewCPUSet(0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11),
+			pod:             makePod("1000m", "2000m"),
+			expNoHints:      true,
+		},
+		{
+			description:     "request fits on either socket",
+			topo:            topoDualSocketHT,
+			stDefaultCPUSet: cpuset.NewCPUSet(0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11),
+			pod:             makePod("2000m", "2000m"),
+			expNodes:        sets.NewInt(0, 1),
+		},
+		{
+			description:     "request only fits on the socket with free CPUs",
+			topo:            topoDualSocketHT,
+			stDefaultCPUSet: cpuset.NewCPUSet(0, 1, 2, 4, 6, 8, 10),
+			pod:             makePod("4000m", "4000m"),
+			expNodes:        sets.NewInt(0),
+		},
+		{
+			description:     "request fits on no single socket",
+			topo:            topoDualSocketHT,
+			stDefaultCPUSet: cpuset.NewCPUSet(0, 1, 2, 3),
+			pod:             makePod("4000m", "4000m"),
+			expNodes:        sets.NewInt(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		policy := NewStaticPolicy(testCase.topo, 1).(*staticPolicy)
+
+		st := &mockState{
+			assignments:   state.ContainerCPUAssignments{},
+			defaultCPUSet: testCase.stDefaultCPUSet,
+		}
+
+		hints := policy.GetTopologyHints(st, testCase.pod, &testCase.pod.Spec.Containers[0])
+		if testCase.expNoHints {
+			if hints != nil {
+				t.Errorf("StaticPolicy GetTopologyHints() error (%v). expected no hints but got %v",
+					testCase.description, hints)
+			}
+			continue
+		}
+		if len(hints) != 1 {
+			t.Errorf("StaticPolicy GetTopologyHints() error (%v). expected exactly one hint but got %v",
+				testCase.description, hints)
+			continue
+		}
+		if !hints[0].NUMANodes.Equal(testCase.expNodes) {
+			t.Errorf("StaticPolicy GetTopologyHints() error (%v). expected NUMA nodes %v but got %v",
+				testCase.description, testCase.expNodes.List(), hints[0].NUMANodes.List())
+		}
+	}
+}
@@ -38,6 +38,7 @@ type endpoint interface {
 	allocate(devs []string) (*pluginapi.AllocateResponse, error)
 	preStartContainer(devs []string) (*pluginapi.PreStartContainerResponse, error)
 	callback(resourceName string, devices []pluginapi.Device)
+	socket() string
 	isStopped() bool
 	stopGracePeriodExpired() bool
 }
@@ -87,6 +88,11 @@ func (e *endpointImpl) callback(resourceName string, devices []pluginapi.Device)
 	e.cb(resourceName, devices)
 }
 
+// socket returns the path of the unix socket this endpoint is connected to.
+func (e *endpointImpl) socket() string {
+	return e.socketPath
+}
+
 // run initializes ListAndWatch gRPC call for the device plugin and
 // blocks on receiving ListAndWatch gRPC stream updates. Each ListAndWatch
 // stream update contains a new list of device states.
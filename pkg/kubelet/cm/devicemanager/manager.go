@@ -37,6 +37,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/checkpointmanager"
 	"k8s.io/kubernetes/pkg/kubelet/checkpointmanager/errors"
 	"k8s.io/kubernetes/pkg/kubelet/cm/devicemanager/checkpoint"
+	"k8s.io/kubernetes/pkg/kubelet/cm/topologymanager"
 	"k8s.io/kubernetes/pkg/kubelet/config"
 	"k8s.io/kubernetes/pkg/kubelet/lifecycle"
 	"k8s.io/kubernetes/pkg/kubelet/metrics"
@@ -249,8 +250,8 @@ func (m *ManagerImpl) GetWatcherHandler() watcher.PluginHandler {
 func (m *ManagerImpl) ValidatePlugin(pluginName string, endpoint string, versions []string, foundInDeprecatedDir bool) error {
 	klog.V(2).Infof("Got Plugin %s at endpoint %s with versions %v", pluginName, endpoint, versions)
 
-	if !m.isVersionCompatibleWithPlugin(versions) {
-		return fmt.Errorf("manager version, %s, is not among plugin supported versions %v", pluginapi.Version, versions)
+	if _, err := negotiateVersion(versions); err != nil {
+		return err
 	}
 
 	if !v1helper.IsExtendedResourceName(v1.ResourceName(pluginName)) {
@@ -264,7 +265,11 @@ func (m *ManagerImpl) ValidatePlugin(pluginName string, endpoint string, version
 // TODO: Start the endpoint and wait for the First ListAndWatch call
 //       before registering the plugin
 func (m *ManagerImpl) RegisterPlugin(pluginName string, endpoint string, versions []string) error {
-	klog.V(2).Infof("Registering Plugin %s at endpoint %s", pluginName, endpoint)
+	version, err := negotiateVersion(versions)
+	if err != nil {
+		return err
+	}
+	klog.V(2).Infof("Registering Plugin %s at endpoint %s with API version %s", pluginName, endpoint, version)
 
 	e, err := newEndpointImpl(endpoint, pluginName, m.callback)
 	if err != nil {
@@ -296,19 +301,21 @@ func (m *ManagerImpl) DeRegisterPlugin(pluginName string) {
 	}
 }
 
-func (m *ManagerImpl) isVersionCompatibleWithPlugin(versions []string) bool {
-	// TODO(vikasc): Currently this is fine as we only have a single supported version. When we do need to support
-	// multiple versions in the future, we may need to extend this function to return a supported version.
-	// E.g., say kubelet supports v1beta1 and v1beta2, and we get v1alpha1 and v1beta1 from a device plugin,
-	// this function should return v1beta1
-	for _, version := range versions {
-		for _, supportedVersion := range pluginapi.SupportedVersions {
+// negotiateVersion selects the device plugin API version to speak with a
+// plugin that advertises the given versions. The manager's preference order in
+// pluginapi.SupportedVersions wins, so when both sides support several
+// versions the newest mutually supported one is chosen.
+// E.g., if the kubelet supports v1beta1 and v1beta2, and a device plugin
+// offers v1alpha1 and v1beta1, v1beta1 is selected.
+func negotiateVersion(versions []string) (string, error) {
+	for _, supportedVersion := range pluginapi.SupportedVersions {
+		for _, version := range versions {
 			if version == supportedVersion {
-				return true
+				return supportedVersion, nil
 			}
 		}
 	}
-	return false
+	return "", fmt.Errorf("manager supported versions, %v, are not among plugin supported versions %v", pluginapi.SupportedVersions, versions)
 }
 
 func (m *ManagerImpl) allocatePodResources(pod *v1.Pod) error {
@@ -374,15 +381,35 @@ func (m *ManagerImpl) Register(ctx context.Context, r *pluginapi.RegisterRequest
 		return &pluginapi.Empty{}, fmt.Errorf(errorString)
 	}
 
-	// TODO: for now, always accepts newest device plugin. Later may consider to
-	// add some policies here, e.g., verify whether an old device plugin with the
-	// same resource name is still alive to determine whether we want to accept
-	// the new registration.
+	if err := m.checkRegistrationConflict(r); err != nil {
+		klog.Infof("Bad registration request from device plugin with resource name %q: %v", r.ResourceName, err)
+		return &pluginapi.Empty{}, err
+	}
+
 	go m.addEndpoint(r)
 
 	return &pluginapi.Empty{}, nil
 }
 
+// checkRegistrationConflict rejects a registration request when the requested
+// resource name is already served by a live endpoint that is connected to a
+// different socket. Re-registration over the same socket (e.g. after a plugin
+// restart) and taking over from a stopped endpoint are both allowed, with the
+// newest registration winning.
+func (m *ManagerImpl) checkRegistrationConflict(r *pluginapi.RegisterRequest) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	old, ok := m.endpoints[r.ResourceName]
+	if !ok || old.e.isStopped() {
+		return nil
+	}
+	if socket := old.e.socket(); socket != "" && socket != filepath.Join(m.socketdir, r.Endpoint) {
+		return fmt.Errorf(errDevicePluginAlreadyExists, r.ResourceName, socket)
+	}
+	return nil
+}
+
 // Stop is the function that can stop the gRPC server.
 // Can be called concurrently, more than once, and is safe to call
 // without a prior Start.
@@ -502,6 +529,7 @@ func (m *ManagerImpl) GetCapacity() (v1.ResourceList, v1.ResourceList, []string)
 			capacity[v1.ResourceName(resourceName)] = capacityCount
 		}
 	}
+	m.updateDeviceMetrics(deletedResources)
 	m.mutex.Unlock()
 	if needsUpdateCheckpoint {
 		m.writeCheckpoint()
@@ -595,6 +623,12 @@ func (m *ManagerImpl) devicesToAllocate(podUID, contName, resource string, requi
 		if needed != 0 {
 			return nil, fmt.Errorf("pod %q container %q changed request for resource %q from %d to %d", podUID, contName, resource, devices.Len(), required)
 		}
+		// Before the cached allocation is reused without an Allocate call,
+		// make sure the devices are still healthy; hardware may have failed
+		// or the plugin may have withdrawn devices while the container was down.
+		if unhealthy := devices.Difference(m.healthyDevices[resource]); unhealthy.Len() != 0 {
+			return nil, fmt.Errorf("previously allocated devices %v of resource %q for pod %q container %q are no longer healthy", unhealthy.List(), resource, podUID, contName)
+		}
 	}
 	if needed == 0 {
 		// No change, no work.
@@ -623,7 +657,11 @@ func (m *ManagerImpl) devicesToAllocate(podUID, contName, resource string, requi
 	// Gets a list of available devices.
 	available := m.healthyDevices[resource].Difference(devicesInUse)
 	if available.Len() < needed {
-		return nil, fmt.Errorf("requested number of devices unavailable for %s. Requested: %d, Available: %d", resource, needed, available.Len())
+		return nil, &lifecycle.InsufficientExtendedResourceError{
+			ResourceName: v1.ResourceName(resource),
+			Requested:    int64(needed),
+			Available:    int64(available.Len()),
+		}
 	}
 	allocated := available.UnsortedList()[:needed]
 	// Updates m.allocatedDevices with allocated devices to prevent them
@@ -700,6 +738,7 @@ func (m *ManagerImpl) allocateContainerResources(pod *v1.Pod, container *v1.Cont
 		metrics.DevicePluginAllocationDuration.WithLabelValues(resource).Observe(metrics.SinceInSeconds(startRPCTime))
 		metrics.DeprecatedDevicePluginAllocationLatency.WithLabelValues(resource).Observe(metrics.SinceInMicroseconds(startRPCTime))
 		if err != nil {
+			metrics.DevicePluginAllocationFailures.WithLabelValues(resource).Inc()
 			// In case of allocation failure, we want to restore m.allocatedDevices
 			// to the actual allocated state from m.podDevices.
 			m.mutex.Lock()
@@ -743,6 +782,13 @@ func (m *ManagerImpl) GetDeviceRunContainerOptions(pod *v1.Pod, container *v1.Co
 		// restart. We re-issue allocate request to cover this race.
 		if m.podDevices.containerDevices(podUID, contName, resource) == nil {
 			needsReAllocate = true
+		} else if m.reallocateOnRestart(resource) {
+			// The cached allocation is reused without an Allocate call on
+			// container restarts, but this plugin declared that it needs the
+			// call to reconfigure hardware before every container start.
+			if err := m.reallocateContainerResources(podUID, contName, resource); err != nil {
+				return nil, err
+			}
 		}
 	}
 	if needsReAllocate {
@@ -754,6 +800,48 @@ func (m *ManagerImpl) GetDeviceRunContainerOptions(pod *v1.Pod, container *v1.Co
 	return m.podDevices.deviceRunContainerOptions(string(pod.UID), container.Name), nil
 }
 
+// reallocateOnRestart returns true when the plugin serving the resource asked
+// for Allocate to be re-invoked for containers that start again with an
+// unchanged device assignment.
+func (m *ManagerImpl) reallocateOnRestart(resource string) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	eI, ok := m.endpoints[resource]
+	return ok && eI.opts != nil && eI.opts.ReallocateOnRestart
+}
+
+// reallocateContainerResources re-issues the Allocate call with the cached
+// devices of the given container, refreshing the cached container runtime
+// settings from the response. It is used for plugins that opted in to
+// reallocation on container restart.
+func (m *ManagerImpl) reallocateContainerResources(podUID, contName, resource string) error {
+	m.mutex.Lock()
+	devices := m.podDevices.containerDevices(podUID, contName, resource)
+	eI, ok := m.endpoints[resource]
+	m.mutex.Unlock()
+	if devices == nil {
+		return nil
+	}
+	if !ok {
+		return fmt.Errorf("endpoint not found in cache for a registered resource: %s", resource)
+	}
+
+	devs := devices.UnsortedList()
+	klog.V(3).Infof("Re-issuing allocation request for devices %v of restarted container %q in pod %q", devs, contName, podUID)
+	resp, err := eI.e.allocate(devs)
+	if err != nil {
+		return err
+	}
+	if len(resp.ContainerResponses) == 0 {
+		return fmt.Errorf("No containers return in allocation response %v", resp)
+	}
+
+	m.mutex.Lock()
+	m.podDevices.insert(podUID, contName, resource, devices, resp.ContainerResponses[0])
+	m.mutex.Unlock()
+	return m.writeCheckpoint()
+}
+
 // callPreStartContainerIfNeeded issues PreStartContainer grpc call for device plugin resource
 // with PreStartRequired option set.
 func (m *ManagerImpl) callPreStartContainerIfNeeded(podUID, contName, resource string) error {
@@ -826,9 +914,46 @@ func (m *ManagerImpl) isDevicePluginResource(resource string) bool {
 	return false
 }
 
+// updateDeviceMetrics refreshes the per-resource device gauges from the
+// current plugin state and drops the series of resources whose endpoints went
+// away. It is called from GetCapacity, which runs on every node status
+// update, so the gauges closely track what the node advertises.
+// Expects the caller to hold m.mutex.
+func (m *ManagerImpl) updateDeviceMetrics(deletedResources sets.String) {
+	for resourceName := range deletedResources {
+		metrics.DevicePluginDevices.DeleteLabelValues(resourceName)
+		metrics.DevicePluginAllocatedDevices.DeleteLabelValues(resourceName)
+		metrics.DevicePluginUnhealthyDevices.DeleteLabelValues(resourceName)
+	}
+	resourceNames := sets.NewString()
+	for resourceName := range m.healthyDevices {
+		resourceNames.Insert(resourceName)
+	}
+	for resourceName := range m.unhealthyDevices {
+		resourceNames.Insert(resourceName)
+	}
+	for resourceName := range resourceNames {
+		healthy := m.healthyDevices[resourceName].Len()
+		unhealthy := m.unhealthyDevices[resourceName].Len()
+		metrics.DevicePluginDevices.WithLabelValues(resourceName).Set(float64(healthy + unhealthy))
+		metrics.DevicePluginAllocatedDevices.WithLabelValues(resourceName).Set(float64(m.allocatedDevices[resourceName].Len()))
+		metrics.DevicePluginUnhealthyDevices.WithLabelValues(resourceName).Set(float64(unhealthy))
+	}
+}
+
 // GetDevices returns the devices used by the specified container
 func (m *ManagerImpl) GetDevices(podUID, containerName string) []*podresourcesapi.ContainerDevices {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 	return m.podDevices.getContainerDevices(podUID, containerName)
 }
+
+// GetTopologyHints returns the NUMA placement preferences for the container's
+// device requests. The v1beta1 device plugin API does not report per-device
+// topology yet, so the device manager currently imposes no restriction; the
+// method exists so the topology manager consults the device manager through
+// the same HintProvider contract as the CPU manager, and gains real hints
+// once plugins report device locality.
+func (m *ManagerImpl) GetTopologyHints(pod *v1.Pod, container *v1.Container) []topologymanager.TopologyHint {
+	return nil
+}
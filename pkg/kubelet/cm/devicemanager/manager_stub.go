@@ -19,6 +19,7 @@ package devicemanager
 import (
 	"k8s.io/api/core/v1"
 	podresourcesapi "k8s.io/kubernetes/pkg/kubelet/apis/podresources/v1alpha1"
+	"k8s.io/kubernetes/pkg/kubelet/cm/topologymanager"
 	"k8s.io/kubernetes/pkg/kubelet/config"
 	"k8s.io/kubernetes/pkg/kubelet/lifecycle"
 	"k8s.io/kubernetes/pkg/kubelet/util/pluginwatcher"
@@ -67,3 +68,8 @@ func (h *ManagerStub) GetWatcherHandler() pluginwatcher.PluginHandler {
 func (h *ManagerStub) GetDevices(_, _ string) []*podresourcesapi.ContainerDevices {
 	return nil
 }
+
+// GetTopologyHints returns nil
+func (h *ManagerStub) GetTopologyHints(pod *v1.Pod, container *v1.Container) []topologymanager.TopologyHint {
+	return nil
+}
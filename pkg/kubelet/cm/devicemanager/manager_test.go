@@ -25,6 +25,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/api/core/v1"
@@ -36,6 +37,7 @@ import (
 	watcherapi "k8s.io/kubernetes/pkg/kubelet/apis/pluginregistration/v1"
 	"k8s.io/kubernetes/pkg/kubelet/checkpointmanager"
 	"k8s.io/kubernetes/pkg/kubelet/lifecycle"
+	"k8s.io/kubernetes/pkg/kubelet/metrics"
 	"k8s.io/kubernetes/pkg/kubelet/util/pluginwatcher"
 	schedulernodeinfo "k8s.io/kubernetes/pkg/scheduler/nodeinfo"
 )
@@ -63,6 +65,15 @@ func TestNewManagerImpl(t *testing.T) {
 	os.RemoveAll(socketDir)
 }
 
+func TestNegotiateVersion(t *testing.T) {
+	version, err := negotiateVersion([]string{"v1alpha1", pluginapi.Version})
+	require.NoError(t, err)
+	require.Equal(t, pluginapi.Version, version)
+
+	_, err = negotiateVersion([]string{"v1alpha1"})
+	require.Error(t, err)
+}
+
 func TestNewManagerImplStart(t *testing.T) {
 	socketDir, socketName, pluginSocketName, err := tmpSocketDir()
 	require.NoError(t, err)
@@ -557,6 +568,8 @@ func (m *MockEndpoint) allocate(devs []string) (*pluginapi.AllocateResponse, err
 	return nil, nil
 }
 
+func (m *MockEndpoint) socket() string { return "" }
+
 func (m *MockEndpoint) isStopped() bool { return false }
 
 func (m *MockEndpoint) stopGracePeriodExpired() bool { return false }
@@ -709,7 +722,11 @@ func TestPodContainerDeviceAllocation(t *testing.T) {
 			expectedContainerOptsLen:  nil,
 			expectedAllocatedResName1: 2,
 			expectedAllocatedResName2: 1,
-			expErr:                    fmt.Errorf("requested number of devices unavailable for domain1.com/resource1. Requested: 1, Available: 0"),
+			expErr: &lifecycle.InsufficientExtendedResourceError{
+				ResourceName: "domain1.com/resource1",
+				Requested:    1,
+				Available:    0,
+			},
 		},
 		{
 			description:               "Successful allocation of all available Res1 resources and Res2 resources",
@@ -992,3 +1009,35 @@ func allocateStubFunc() func(devs []string) (*pluginapi.AllocateResponse, error)
 		return resps, nil
 	}
 }
+
+func TestDeviceMetrics(t *testing.T) {
+	socketDir, socketName, _, err := tmpSocketDir()
+	require.NoError(t, err)
+	defer os.RemoveAll(socketDir)
+	testManager, err := newManagerImpl(socketName)
+	as := assert.New(t)
+	as.NotNil(testManager)
+	as.Nil(err)
+
+	resourceName := "domain1.com/metered-resource"
+	testManager.endpoints[resourceName] = endpointInfo{e: &endpointImpl{}, opts: nil}
+	testManager.genericDeviceUpdateCallback(resourceName, []pluginapi.Device{
+		{ID: "Device1", Health: pluginapi.Healthy},
+		{ID: "Device2", Health: pluginapi.Healthy},
+		{ID: "Device3", Health: pluginapi.Unhealthy},
+	})
+	testManager.allocatedDevices[resourceName] = sets.NewString("Device1")
+
+	// GetCapacity refreshes the gauges.
+	testManager.GetCapacity()
+	as.Equal(float64(3), testutil.ToFloat64(metrics.DevicePluginDevices.WithLabelValues(resourceName)))
+	as.Equal(float64(1), testutil.ToFloat64(metrics.DevicePluginAllocatedDevices.WithLabelValues(resourceName)))
+	as.Equal(float64(1), testutil.ToFloat64(metrics.DevicePluginUnhealthyDevices.WithLabelValues(resourceName)))
+
+	// Marking the whole resource unhealthy moves all devices to the
+	// unhealthy gauge without changing the total.
+	testManager.markResourceUnhealthy(resourceName)
+	testManager.GetCapacity()
+	as.Equal(float64(3), testutil.ToFloat64(metrics.DevicePluginDevices.WithLabelValues(resourceName)))
+	as.Equal(float64(3), testutil.ToFloat64(metrics.DevicePluginUnhealthyDevices.WithLabelValues(resourceName)))
+}
@@ -21,6 +21,7 @@ import (
 
 	"k8s.io/api/core/v1"
 	podresourcesapi "k8s.io/kubernetes/pkg/kubelet/apis/podresources/v1alpha1"
+	"k8s.io/kubernetes/pkg/kubelet/cm/topologymanager"
 	"k8s.io/kubernetes/pkg/kubelet/config"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
 	"k8s.io/kubernetes/pkg/kubelet/lifecycle"
@@ -58,6 +59,10 @@ type Manager interface {
 
 	// GetDevices returns information about the devices assigned to pods and containers
 	GetDevices(podUID, containerName string) []*podresourcesapi.ContainerDevices
+
+	// GetTopologyHints implements the topologymanager.HintProvider interface
+	// so device allocations can be aligned with other resources on a NUMA node.
+	GetTopologyHints(pod *v1.Pod, container *v1.Container) []topologymanager.TopologyHint
 }
 
 // DeviceRunContainerOptions contains the combined container runtime settings to consume its allocated devices.
@@ -80,6 +85,9 @@ const (
 	// errUnsupportedVersion is the error raised when the device plugin uses an API version not
 	// supported by the Kubelet registry
 	errUnsupportedVersion = "requested API version %q is not supported by kubelet. Supported version is %q"
+	// errDevicePluginAlreadyExists is the error raised when a device plugin tries to register
+	// a ResourceName that is already served by a live endpoint on a different socket
+	errDevicePluginAlreadyExists = "the ResourceName %q is already registered by the device plugin on socket %q"
 	// errInvalidResourceName is the error raised when a device plugin is registering
 	// itself with an invalid ResourceName
 	errInvalidResourceName = "the ResourceName %q is invalid"
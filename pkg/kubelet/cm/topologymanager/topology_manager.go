@@ -0,0 +1,120 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package topologymanager coordinates NUMA placement between resource
+// managers. Resource managers (the CPU manager, the device plugin manager)
+// register as hint providers; for a Guaranteed pod the topology manager
+// intersects their per-container NUMA node preferences so exclusive CPUs and
+// devices are drawn from the same NUMA node when possible.
+package topologymanager
+
+import (
+	"fmt"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog"
+)
+
+const (
+	// PolicyBestEffort aligns resources on a common NUMA node when the hint
+	// providers agree on one, and silently falls back to unaligned placement
+	// when they do not.
+	PolicyBestEffort = "best-effort"
+	// PolicyStrict rejects the pod when the hint providers cannot agree on a
+	// common NUMA node.
+	PolicyStrict = "strict"
+)
+
+// TopologyHint describes the NUMA nodes on which a hint provider can satisfy
+// a container's request for one resource. An empty NUMANodes set means the
+// provider cannot satisfy the request from any single NUMA node.
+type TopologyHint struct {
+	NUMANodes sets.Int
+}
+
+// HintProvider is implemented by resource managers that have an opinion about
+// NUMA placement. A nil result means the provider imposes no restriction for
+// this container; every returned hint constrains the merged affinity.
+type HintProvider interface {
+	// GetTopologyHints returns one hint per managed resource requested by
+	// the container.
+	GetTopologyHints(pod *v1.Pod, container *v1.Container) []TopologyHint
+}
+
+// Manager merges the hints of all registered providers according to the
+// configured policy.
+type Manager interface {
+	// AddHintProvider registers a provider consulted on every Affinity call.
+	AddHintProvider(provider HintProvider)
+	// Affinity returns the set of NUMA nodes all providers can satisfy the
+	// container from. An empty set with a nil error means no restriction.
+	// Under the strict policy an error is returned when the providers have
+	// preferences but share no common NUMA node; the pod should then be
+	// rejected at admission.
+	Affinity(pod *v1.Pod, container *v1.Container) (sets.Int, error)
+}
+
+type manager struct {
+	policy    string
+	providers []HintProvider
+}
+
+var _ Manager = &manager{}
+
+// NewManager returns a Manager using the given policy.
+func NewManager(policy string) (Manager, error) {
+	switch policy {
+	case PolicyBestEffort, PolicyStrict:
+	default:
+		return nil, fmt.Errorf("unknown topology manager policy %q, expected %q or %q", policy, PolicyBestEffort, PolicyStrict)
+	}
+	return &manager{policy: policy}, nil
+}
+
+func (m *manager) AddHintProvider(provider HintProvider) {
+	m.providers = append(m.providers, provider)
+}
+
+func (m *manager) Affinity(pod *v1.Pod, container *v1.Container) (sets.Int, error) {
+	var affinity sets.Int
+	for _, provider := range m.providers {
+		for _, hint := range provider.GetTopologyHints(pod, container) {
+			if affinity == nil {
+				affinity = sets.NewInt(hint.NUMANodes.List()...)
+				continue
+			}
+			affinity = affinity.Intersection(hint.NUMANodes)
+		}
+	}
+
+	if affinity == nil {
+		// No provider expressed a preference.
+		return sets.NewInt(), nil
+	}
+	if affinity.Len() == 0 {
+		if m.policy == PolicyStrict {
+			return nil, fmt.Errorf("container %q in pod %q cannot be aligned on a single NUMA node", container.Name, format(pod))
+		}
+		klog.V(4).Infof("[topologymanager] No common NUMA node for container %q in pod %q, falling back to unaligned placement", container.Name, format(pod))
+		return sets.NewInt(), nil
+	}
+	return affinity, nil
+}
+
+func format(pod *v1.Pod) string {
+	return fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topologymanager
+
+import (
+	"testing"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+type fakeHintProvider struct {
+	hints []TopologyHint
+}
+
+func (f *fakeHintProvider) GetTopologyHints(pod *v1.Pod, container *v1.Container) []TopologyHint {
+	return f.hints
+}
+
+func testPodAndContainer() (*v1.Pod, *v1.Container) {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "test-ns"}}
+	return pod, &v1.Container{Name: "test-container"}
+}
+
+func TestNewManagerPolicyValidation(t *testing.T) {
+	for _, policy := range []string{PolicyBestEffort, PolicyStrict} {
+		if _, err := NewManager(policy); err != nil {
+			t.Errorf("unexpected error for policy %q: %v", policy, err)
+		}
+	}
+	if _, err := NewManager("none"); err == nil {
+		t.Errorf("expected an error for an unknown policy")
+	}
+}
+
+func TestAffinity(t *testing.T) {
+	pod, container := testPodAndContainer()
+
+	testCases := []struct {
+		name             string
+		policy           string
+		providers        []HintProvider
+		expectedAffinity sets.Int
+		expectedError    bool
+	}{
+		{
+			name:             "no providers means no restriction",
+			policy:           PolicyStrict,
+			providers:        nil,
+			expectedAffinity: sets.NewInt(),
+		},
+		{
+			name:   "providers without hints mean no restriction",
+			policy: PolicyStrict,
+			providers: []HintProvider{
+				&fakeHintProvider{},
+				&fakeHintProvider{},
+			},
+			expectedAffinity: sets.NewInt(),
+		},
+		{
+			name:   "an unsatisfiable hint fails under strict",
+			policy: PolicyStrict,
+			providers: []HintProvider{
+				&fakeHintProvider{hints: []TopologyHint{{NUMANodes: sets.NewInt()}}},
+			},
+			expectedError: true,
+		},
+		{
+			name:   "agreeing providers intersect to the common node",
+			policy: PolicyStrict,
+			providers: []HintProvider{
+				&fakeHintProvider{hints: []TopologyHint{{NUMANodes: sets.NewInt(0, 1)}}},
+				&fakeHintProvider{hints: []TopologyHint{{NUMANodes: sets.NewInt(1)}}},
+			},
+			expectedAffinity: sets.NewInt(1),
+		},
+		{
+			name:   "disagreeing providers fail under strict",
+			policy: PolicyStrict,
+			providers: []HintProvider{
+				&fakeHintProvider{hints: []TopologyHint{{NUMANodes: sets.NewInt(0)}}},
+				&fakeHintProvider{hints: []TopologyHint{{NUMANodes: sets.NewInt(1)}}},
+			},
+			expectedError: true,
+		},
+		{
+			name:   "disagreeing providers fall back under best-effort",
+			policy: PolicyBestEffort,
+			providers: []HintProvider{
+				&fakeHintProvider{hints: []TopologyHint{{NUMANodes: sets.NewInt(0)}}},
+				&fakeHintProvider{hints: []TopologyHint{{NUMANodes: sets.NewInt(1)}}},
+			},
+			expectedAffinity: sets.NewInt(),
+		},
+		{
+			name:   "multiple hints from one provider all constrain the result",
+			policy: PolicyBestEffort,
+			providers: []HintProvider{
+				&fakeHintProvider{hints: []TopologyHint{
+					{NUMANodes: sets.NewInt(0, 1)},
+					{NUMANodes: sets.NewInt(1, 2)},
+				}},
+			},
+			expectedAffinity: sets.NewInt(1),
+		},
+	}
+
+	for _, tc := range testCases {
+		mngr, err := NewManager(tc.policy)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		for _, provider := range tc.providers {
+			mngr.AddHintProvider(provider)
+		}
+
+		affinity, err := mngr.Affinity(pod, container)
+		if tc.expectedError {
+			if err == nil {
+				t.Errorf("%s: expected an error, got affinity %v", tc.name, affinity)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if !affinity.Equal(tc.expectedAffinity) {
+			t.Errorf("%s: expected affinity %v, got %v", tc.name, tc.expectedAffinity.List(), affinity.List())
+		}
+	}
+}
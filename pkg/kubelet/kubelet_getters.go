@@ -27,6 +27,7 @@ import (
 
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	statsapi "k8s.io/kubernetes/pkg/kubelet/apis/stats/v1alpha1"
 	"k8s.io/kubernetes/pkg/kubelet/cm"
 	"k8s.io/kubernetes/pkg/kubelet/config"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
@@ -352,3 +353,21 @@ func (kl *Kubelet) GetVersionInfo() (*cadvisorapiv1.VersionInfo, error) {
 func (kl *Kubelet) GetCachedMachineInfo() (*cadvisorapiv1.MachineInfo, error) {
 	return kl.machineInfo, nil
 }
+
+// GetDeviceStats returns the device plugin resources assigned to the container,
+// for inclusion in the stats summary API.
+func (kl *Kubelet) GetDeviceStats(podUID, containerName string) []statsapi.DeviceStats {
+	devices := kl.containerManager.GetDevices(podUID, containerName)
+	if len(devices) == 0 {
+		return nil
+	}
+	deviceStats := make([]statsapi.DeviceStats, 0, len(devices))
+	for _, device := range devices {
+		deviceStats = append(deviceStats, statsapi.DeviceStats{
+			ResourceName:   device.ResourceName,
+			DeviceIDs:      append([]string(nil), device.DeviceIds...),
+			AllocatedCount: int64(len(device.DeviceIds)),
+		})
+	}
+	return deviceStats
+}
@@ -38,6 +38,20 @@ type AdmissionFailureHandler interface {
 	HandleAdmissionFailure(admitPod *v1.Pod, failureReasons []predicates.PredicateFailureReason) (bool, []predicates.PredicateFailureReason, error)
 }
 
+// InsufficientExtendedResourceError is returned by a plugin resource update
+// func when a pod requests more of an extended resource than the node has
+// available, carrying the counts so admission can report a structured result
+// instead of an opaque error.
+type InsufficientExtendedResourceError struct {
+	ResourceName v1.ResourceName
+	Requested    int64
+	Available    int64
+}
+
+func (e *InsufficientExtendedResourceError) Error() string {
+	return fmt.Sprintf("requested number of devices unavailable for %s. Requested: %d, Available: %d", e.ResourceName, e.Requested, e.Available)
+}
+
 type predicateAdmitHandler struct {
 	getNodeAnyWayFunc        getNodeAnyWayFuncType
 	pluginResourceUpdateFunc pluginResourceUpdateFuncType
@@ -70,6 +84,15 @@ func (w *predicateAdmitHandler) Admit(attrs *PodAdmitAttributes) PodAdmitResult
 	nodeInfo.SetNode(node)
 	// ensure the node has enough plugin resources for that required in pods
 	if err = w.pluginResourceUpdateFunc(nodeInfo, attrs); err != nil {
+		if insufficient, ok := err.(*InsufficientExtendedResourceError); ok {
+			message := insufficient.Error()
+			klog.V(2).Infof("Failed to admit pod %v - %s", format.Pod(admitPod), message)
+			return PodAdmitResult{
+				Admit:   false,
+				Reason:  "OutOfExtendedResource",
+				Message: message,
+			}
+		}
 		message := fmt.Sprintf("Update plugin resources failed due to %v, which is unexpected.", err)
 		klog.Warningf("Failed to admit pod %v - %s", format.Pod(admitPod), message)
 		return PodAdmitResult{
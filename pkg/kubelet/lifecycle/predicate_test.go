@@ -112,3 +112,31 @@ func makeTestNode(allocatable v1.ResourceList) *v1.Node {
 		},
 	}
 }
+
+func TestAdmitPodInsufficientExtendedResource(t *testing.T) {
+	allocErr := &InsufficientExtendedResourceError{
+		ResourceName: v1.ResourceName(extendedResourceName1),
+		Requested:    2,
+		Available:    1,
+	}
+	handler := NewPredicateAdmitHandler(
+		func() (*v1.Node, error) {
+			return makeTestNode(v1.ResourceList{}), nil
+		},
+		nil,
+		func(*schedulernodeinfo.NodeInfo, *PodAdmitAttributes) error {
+			return allocErr
+		},
+	)
+
+	result := handler.Admit(&PodAdmitAttributes{Pod: makeTestPod(v1.ResourceList{}, v1.ResourceList{})})
+	if result.Admit {
+		t.Errorf("expected pod to be rejected")
+	}
+	if result.Reason != "OutOfExtendedResource" {
+		t.Errorf("expected reason %q, got %q", "OutOfExtendedResource", result.Reason)
+	}
+	if result.Message != allocErr.Error() {
+		t.Errorf("expected message %q, got %q", allocErr.Error(), result.Message)
+	}
+}
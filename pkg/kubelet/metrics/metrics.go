@@ -67,6 +67,10 @@ const (
 	DevicePluginAllocationDurationKey          = "device_plugin_alloc_duration_seconds"
 	DeprecatedDevicePluginRegistrationCountKey = "device_plugin_registration_count"
 	DeprecatedDevicePluginAllocationLatencyKey = "device_plugin_alloc_latency_microseconds"
+	DevicePluginDevicesKey                     = "device_plugin_devices"
+	DevicePluginAllocatedDevicesKey            = "device_plugin_allocated_devices"
+	DevicePluginUnhealthyDevicesKey            = "device_plugin_unhealthy_devices"
+	DevicePluginAllocationFailuresKey          = "device_plugin_alloc_failures_total"
 
 	// Metric keys for node config
 	AssignedConfigKey             = "node_config_assigned"
@@ -211,6 +215,38 @@ var (
 		},
 		[]string{"resource_name"},
 	)
+	DevicePluginDevices = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: KubeletSubsystem,
+			Name:      DevicePluginDevicesKey,
+			Help:      "Number of devices registered by device plugins. Broken down by resource name.",
+		},
+		[]string{"resource_name"},
+	)
+	DevicePluginAllocatedDevices = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: KubeletSubsystem,
+			Name:      DevicePluginAllocatedDevicesKey,
+			Help:      "Number of devices currently allocated to containers. Broken down by resource name.",
+		},
+		[]string{"resource_name"},
+	)
+	DevicePluginUnhealthyDevices = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: KubeletSubsystem,
+			Name:      DevicePluginUnhealthyDevicesKey,
+			Help:      "Number of registered devices currently reported unhealthy. Broken down by resource name.",
+		},
+		[]string{"resource_name"},
+	)
+	DevicePluginAllocationFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: KubeletSubsystem,
+			Name:      DevicePluginAllocationFailuresKey,
+			Help:      "Cumulative number of failed device plugin Allocate RPCs. Broken down by resource name.",
+		},
+		[]string{"resource_name"},
+	)
 
 	DeprecatedPodWorkerLatency = prometheus.NewSummaryVec(
 		prometheus.SummaryOpts{
@@ -380,6 +416,10 @@ func Register(containerCache kubecontainer.RuntimeCache, collectors ...prometheu
 		prometheus.MustRegister(EvictionStatsAge)
 		prometheus.MustRegister(DevicePluginRegistrationCount)
 		prometheus.MustRegister(DevicePluginAllocationDuration)
+		prometheus.MustRegister(DevicePluginDevices)
+		prometheus.MustRegister(DevicePluginAllocatedDevices)
+		prometheus.MustRegister(DevicePluginUnhealthyDevices)
+		prometheus.MustRegister(DevicePluginAllocationFailures)
 		prometheus.MustRegister(DeprecatedPodWorkerLatency)
 		prometheus.MustRegister(DeprecatedPodStartLatency)
 		prometheus.MustRegister(DeprecatedCgroupManagerLatency)
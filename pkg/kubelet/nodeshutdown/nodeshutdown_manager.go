@@ -0,0 +1,235 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package nodeshutdown can watch for node level shutdown events and trigger
+// graceful termination of pods running on the node prior to shutdown.
+package nodeshutdown
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/klog"
+	"k8s.io/kubernetes/pkg/kubelet/eviction"
+	"k8s.io/kubernetes/pkg/kubelet/lifecycle"
+	kubelettypes "k8s.io/kubernetes/pkg/kubelet/types"
+	"k8s.io/kubernetes/pkg/kubelet/util/format"
+	utildbus "k8s.io/kubernetes/pkg/util/dbus"
+
+	godbus "github.com/godbus/dbus"
+)
+
+const (
+	logindService = "org.freedesktop.login1"
+	logindPath    = "/org/freedesktop/login1"
+	logindManager = "org.freedesktop.login1.Manager"
+
+	nodeShutdownNotAdmittedReason  = "NodeShutdown"
+	nodeShutdownNotAdmittedMessage = "Pod was rejected as the node is shutting down."
+)
+
+// Manager watches for systemd shutdown events via a logind inhibitor lock and
+// terminates pods with a grace period before the host powers off. Critical
+// pods (as determined by kubelettypes.IsCriticalPod) are terminated last, and
+// within each class lower priority pods are signalled first.
+//
+// Note that logind only delays the shutdown up to its InhibitDelayMaxSec
+// setting, so the configured shutdown grace period must not exceed it.
+type Manager struct {
+	getPods     eviction.ActivePodsFunc
+	killPodFunc eviction.KillPodFunc
+	// syncNodeStatus is called when the shutdown state flips so the node is
+	// reported NotReady (via the manager's health check) without waiting for
+	// the next periodic status sync.
+	syncNodeStatus func()
+
+	shutdownGracePeriodRequested    time.Duration
+	shutdownGracePeriodCriticalPods time.Duration
+
+	dbus utildbus.Interface
+
+	nodeShuttingDownMutex sync.Mutex
+	nodeShuttingDownNow   bool
+}
+
+// NewManager returns a new node shutdown manager.
+func NewManager(getPods eviction.ActivePodsFunc, killPodFunc eviction.KillPodFunc, syncNodeStatus func(), shutdownGracePeriodRequested, shutdownGracePeriodCriticalPods time.Duration) *Manager {
+	return &Manager{
+		getPods:                         getPods,
+		killPodFunc:                     killPodFunc,
+		syncNodeStatus:                  syncNodeStatus,
+		shutdownGracePeriodRequested:    shutdownGracePeriodRequested,
+		shutdownGracePeriodCriticalPods: shutdownGracePeriodCriticalPods,
+		dbus:                            utildbus.New(),
+	}
+}
+
+// Start takes a logind delay inhibitor lock and begins watching for shutdown
+// events. It is a no-op when no shutdown grace period is configured.
+func (m *Manager) Start() error {
+	if m.shutdownGracePeriodRequested == 0 {
+		return nil
+	}
+
+	systemBus, err := m.dbus.SystemBus()
+	if err != nil {
+		return fmt.Errorf("failed to connect to the system bus: %v", err)
+	}
+
+	// Take a delay inhibitor lock so logind delays the shutdown until pods
+	// are terminated or the grace period passes. The lock is intentionally
+	// held for the lifetime of the process; logind releases it when the
+	// kubelet exits.
+	logind := systemBus.Object(logindService, logindPath)
+	var fd godbus.UnixFD
+	err = logind.Call(logindManager+".Inhibit", 0, "shutdown", "kubelet", "Kubelet needs time to terminate pods", "delay").Store(&fd)
+	if err != nil {
+		return fmt.Errorf("failed to take a shutdown inhibitor lock: %v", err)
+	}
+
+	rule := fmt.Sprintf("type='signal',sender='%s',path='%s',interface='%s',member='PrepareForShutdown'", logindService, logindPath, logindManager)
+	systemBus.BusObject().Call("org.freedesktop.DBus.AddMatch", 0, rule)
+
+	events := make(chan *godbus.Signal, 1)
+	systemBus.Signal(events)
+	go func() {
+		for event := range events {
+			if event == nil || len(event.Body) == 0 {
+				continue
+			}
+			if shuttingDown, ok := event.Body[0].(bool); !ok || !shuttingDown {
+				continue
+			}
+			m.processShutdownEvent()
+		}
+	}()
+	klog.Infof("Node shutdown manager acquired a shutdown inhibitor lock, shutdownGracePeriodRequested: %v, shutdownGracePeriodCriticalPods: %v", m.shutdownGracePeriodRequested, m.shutdownGracePeriodCriticalPods)
+	return nil
+}
+
+// ShuttingDown returns whether a node shutdown has been observed.
+func (m *Manager) ShuttingDown() bool {
+	m.nodeShuttingDownMutex.Lock()
+	defer m.nodeShuttingDownMutex.Unlock()
+	return m.nodeShuttingDownNow
+}
+
+// HealthCheck reports the node unhealthy while a shutdown is in progress, so
+// that the node ready condition flips to false and no new pods are scheduled
+// here. It is intended to be registered with the kubelet's runtime state.
+func (m *Manager) HealthCheck() (bool, error) {
+	if m.ShuttingDown() {
+		return false, fmt.Errorf("node is shutting down")
+	}
+	return true, nil
+}
+
+// Admit rejects all pods while a shutdown is in progress.
+func (m *Manager) Admit(attrs *lifecycle.PodAdmitAttributes) lifecycle.PodAdmitResult {
+	if m.ShuttingDown() {
+		return lifecycle.PodAdmitResult{
+			Admit:   false,
+			Reason:  nodeShutdownNotAdmittedReason,
+			Message: nodeShutdownNotAdmittedMessage,
+		}
+	}
+	return lifecycle.PodAdmitResult{Admit: true}
+}
+
+func (m *Manager) processShutdownEvent() {
+	klog.Infof("Node shutdown manager detected an imminent node shutdown, terminating pods")
+
+	m.nodeShuttingDownMutex.Lock()
+	m.nodeShuttingDownNow = true
+	m.nodeShuttingDownMutex.Unlock()
+	m.syncNodeStatus()
+
+	activePods := m.getPods()
+	nonCriticalPods, criticalPods := splitCriticalPods(activePods)
+
+	nonCriticalGracePeriod := m.shutdownGracePeriodRequested - m.shutdownGracePeriodCriticalPods
+	m.terminatePods(nonCriticalPods, nonCriticalGracePeriod)
+	m.terminatePods(criticalPods, m.shutdownGracePeriodCriticalPods)
+
+	klog.Infof("Node shutdown manager finished terminating pods")
+}
+
+// terminatePods signals the given pods in increasing priority order and waits
+// for their termination, at most until the grace period passes. Each pod is
+// given the remainder of the grace period, or its own termination grace
+// period if that is shorter.
+func (m *Manager) terminatePods(pods []*v1.Pod, gracePeriod time.Duration) {
+	if len(pods) == 0 || gracePeriod <= 0 {
+		return
+	}
+	sort.SliceStable(pods, func(i, j int) bool {
+		return podPriority(pods[i]) < podPriority(pods[j])
+	})
+
+	var wg sync.WaitGroup
+	for _, pod := range pods {
+		gracePeriodOverride := int64(gracePeriod.Seconds())
+		if pod.Spec.TerminationGracePeriodSeconds != nil && *pod.Spec.TerminationGracePeriodSeconds < gracePeriodOverride {
+			gracePeriodOverride = *pod.Spec.TerminationGracePeriodSeconds
+		}
+		klog.V(1).Infof("Node shutdown manager killing pod %q with gracePeriod %d seconds", format.Pod(pod), gracePeriodOverride)
+
+		wg.Add(1)
+		go func(pod *v1.Pod, gracePeriodOverride int64) {
+			defer wg.Done()
+			status := v1.PodStatus{
+				Phase:   v1.PodFailed,
+				Reason:  nodeShutdownNotAdmittedReason,
+				Message: "Pod was terminated in response to imminent node shutdown.",
+			}
+			if err := m.killPodFunc(pod, status, &gracePeriodOverride); err != nil {
+				klog.Errorf("Node shutdown manager failed killing pod %q: %v", format.Pod(pod), err)
+			}
+		}(pod, gracePeriodOverride)
+	}
+
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		wg.Wait()
+	}()
+	select {
+	case <-doneCh:
+	case <-time.After(gracePeriod):
+		klog.Errorf("Node shutdown manager pod killing did not complete in %v", gracePeriod)
+	}
+}
+
+func splitCriticalPods(pods []*v1.Pod) (nonCritical, critical []*v1.Pod) {
+	for _, pod := range pods {
+		if kubelettypes.IsCriticalPod(pod) {
+			critical = append(critical, pod)
+		} else {
+			nonCritical = append(nonCritical, pod)
+		}
+	}
+	return nonCritical, critical
+}
+
+func podPriority(pod *v1.Pod) int32 {
+	if pod.Spec.Priority != nil {
+		return *pod.Spec.Priority
+	}
+	return 0
+}
@@ -90,6 +90,9 @@ type Provider interface {
 	ListVolumesForPod(podUID types.UID) (map[string]volume.Volume, bool)
 	// GetPods returns the specs of all the pods running on this node.
 	GetPods() []*v1.Pod
+	// GetDeviceStats returns the device plugin resources assigned to the
+	// container.
+	GetDeviceStats(podUID, containerName string) []statsapi.DeviceStats
 
 	// RlimitStats returns the rlimit stats of system.
 	RlimitStats() (*statsapi.RlimitStats, error)
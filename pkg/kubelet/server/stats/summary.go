@@ -94,6 +94,15 @@ func (sp *summaryProviderImpl) Get(updateStats bool) (*statsapi.Summary, error)
 		return nil, fmt.Errorf("failed to list pod stats: %v", err)
 	}
 
+	// Attach the device plugin assignments so consumers of the summary can
+	// reason about actual extended-resource usage per container.
+	for i := range podStats {
+		for j := range podStats[i].Containers {
+			container := &podStats[i].Containers[j]
+			container.Devices = sp.provider.GetDeviceStats(podStats[i].PodRef.UID, container.Name)
+		}
+	}
+
 	rlimit, err := sp.provider.RlimitStats()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get rlimit stats: %v", err)
@@ -206,6 +206,22 @@ func (_m *StatsProvider) GetPods() []*corev1.Pod {
 	return r0
 }
 
+// GetDeviceStats provides a mock function with given fields: podUID, containerName
+func (_m *StatsProvider) GetDeviceStats(podUID string, containerName string) []v1alpha1.DeviceStats {
+	ret := _m.Called(podUID, containerName)
+
+	var r0 []v1alpha1.DeviceStats
+	if rf, ok := ret.Get(0).(func(string, string) []v1alpha1.DeviceStats); ok {
+		r0 = rf(podUID, containerName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v1alpha1.DeviceStats)
+		}
+	}
+
+	return r0
+}
+
 // GetRawContainerInfo provides a mock function with given fields: containerName, req, subcontainers
 func (_m *StatsProvider) GetRawContainerInfo(containerName string, req *v1.ContainerInfoRequest, subcontainers bool) (map[string]*v1.ContainerInfo, error) {
 	ret := _m.Called(containerName, req, subcontainers)
@@ -69,6 +69,7 @@ import (
 	serverstorage "k8s.io/apiserver/pkg/server/storage"
 	storagefactory "k8s.io/apiserver/pkg/storage/storagebackend/factory"
 	"k8s.io/client-go/informers"
+	coordinationv1client "k8s.io/client-go/kubernetes/typed/coordination/v1"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	api "k8s.io/kubernetes/pkg/apis/core"
 	kubeoptions "k8s.io/kubernetes/pkg/kubeapiserver/options"
@@ -310,6 +311,16 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 		routes.Logs{}.Install(s.Handler.GoRestfulContainer)
 	}
 
+	routes.NewControlPlaneStatus(
+		c.GenericConfig.Version,
+		corev1client.NewForConfigOrDie(c.GenericConfig.LoopbackClientConfig),
+		coordinationv1client.NewForConfigOrDie(c.GenericConfig.LoopbackClientConfig),
+	).Install(s.Handler.GoRestfulContainer)
+
+	if storageFactory, ok := c.ExtraConfig.StorageFactory.(*serverstorage.DefaultStorageFactory); ok {
+		routes.StorageVersions{StorageFactory: storageFactory}.Install(s.Handler.GoRestfulContainer)
+	}
+
 	m := &Master{
 		GenericAPIServer: s,
 	}
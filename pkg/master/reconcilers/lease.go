@@ -284,8 +284,11 @@ func checkEndpointSubsetFormatWithLease(e *corev1.Endpoints, expectedIPs []strin
 	return true, ipsCorrect, portsCorrect
 }
 
+// RemoveEndpoints removes this master's lease and reconciles the remaining
+// endpoints. A missing lease is tolerated so that cleanup stays idempotent
+// when the lease has already expired or been removed by a previous call.
 func (r *leaseEndpointReconciler) RemoveEndpoints(serviceName string, ip net.IP, endpointPorts []corev1.EndpointPort) error {
-	if err := r.masterLeases.RemoveLease(ip.String()); err != nil {
+	if err := r.masterLeases.RemoveLease(ip.String()); err != nil && !storage.IsNotFound(err) {
 		return err
 	}
 
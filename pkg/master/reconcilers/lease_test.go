@@ -28,17 +28,19 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/storage"
 	"k8s.io/client-go/kubernetes/fake"
 )
 
 type fakeLeases struct {
-	keys map[string]bool
+	keys      map[string]bool
+	removeErr error
 }
 
 var _ Leases = &fakeLeases{}
 
 func newFakeLeases() *fakeLeases {
-	return &fakeLeases{make(map[string]bool)}
+	return &fakeLeases{keys: make(map[string]bool)}
 }
 
 func (f *fakeLeases) ListLeases() ([]string, error) {
@@ -55,6 +57,9 @@ func (f *fakeLeases) UpdateLease(ip string) error {
 }
 
 func (f *fakeLeases) RemoveLease(ip string) error {
+	if f.removeErr != nil {
+		return f.removeErr
+	}
 	delete(f.keys, ip)
 	return nil
 }
@@ -648,3 +653,33 @@ func TestLeaseRemoveEndpoints(t *testing.T) {
 		})
 	}
 }
+
+func TestLeaseRemoveEndpointsMissingLease(t *testing.T) {
+	ns := corev1.NamespaceDefault
+	fakeLeases := newFakeLeases()
+	fakeLeases.SetKeys([]string{"4.3.2.2"})
+	fakeLeases.removeErr = storage.NewKeyNotFoundError("/registry/masterleases/1.2.3.4", 0)
+	clientset := fake.NewSimpleClientset(&corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "foo"},
+		Subsets: []corev1.EndpointSubset{{
+			Addresses: []corev1.EndpointAddress{
+				{IP: "1.2.3.4"},
+				{IP: "4.3.2.2"},
+			},
+			Ports: []corev1.EndpointPort{{Name: "foo", Port: 8080, Protocol: "TCP"}},
+		}},
+	})
+	r := NewLeaseEndpointReconciler(clientset.CoreV1(), fakeLeases)
+	if err := r.RemoveEndpoints("foo", net.ParseIP("1.2.3.4"), []corev1.EndpointPort{{Name: "foo", Port: 8080, Protocol: "TCP"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []corev1.EndpointAddress{{IP: "4.3.2.2"}}
+	actualEndpoints, err := clientset.CoreV1().Endpoints(ns).Get("foo", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := expected, actualEndpoints.Subsets[0].Addresses; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected addresses %v, got %v", e, a)
+	}
+}
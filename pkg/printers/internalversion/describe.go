@@ -3136,13 +3136,26 @@ func describeHorizontalPodAutoscaler(hpa *autoscaling.HorizontalPodAutoscaler, e
 }
 
 func describeNodeResource(nodeNonTerminatedPodsList *api.PodList, node *api.Node, w PrefixWriter) {
-	w.Write(LEVEL_0, "Non-terminated Pods:\t(%d in total)\n", len(nodeNonTerminatedPodsList.Items))
-	w.Write(LEVEL_1, "Namespace\tName\t\tCPU Requests\tCPU Limits\tMemory Requests\tMemory Limits\tAGE\n")
-	w.Write(LEVEL_1, "---------\t----\t\t------------\t----------\t---------------\t-------------\t---\n")
 	allocatable := node.Status.Capacity
 	if len(node.Status.Allocatable) > 0 {
 		allocatable = node.Status.Allocatable
 	}
+	extResources := make([]string, 0, len(allocatable))
+	for resource := range allocatable {
+		if !helper.IsStandardContainerResourceName(string(resource)) && resource != api.ResourcePods {
+			extResources = append(extResources, string(resource))
+		}
+	}
+	sort.Strings(extResources)
+
+	extHeaders, extDashes := "", ""
+	for _, ext := range extResources {
+		extHeaders += fmt.Sprintf("\t%s Requests\t%s Limits", ext, ext)
+		extDashes += fmt.Sprintf("\t%s\t%s", strings.Repeat("-", len(ext)+9), strings.Repeat("-", len(ext)+7))
+	}
+	w.Write(LEVEL_0, "Non-terminated Pods:\t(%d in total)\n", len(nodeNonTerminatedPodsList.Items))
+	w.Write(LEVEL_1, "Namespace\tName\t\tCPU Requests\tCPU Limits\tMemory Requests\tMemory Limits%s\tAGE\n", extHeaders)
+	w.Write(LEVEL_1, "---------\t----\t\t------------\t----------\t---------------\t-------------%s\t---\n", extDashes)
 
 	for _, pod := range nodeNonTerminatedPodsList.Items {
 		req, limit := resourcehelper.PodRequestsAndLimits(&pod)
@@ -3151,9 +3164,15 @@ func describeNodeResource(nodeNonTerminatedPodsList *api.PodList, node *api.Node
 		fractionCpuLimit := float64(cpuLimit.MilliValue()) / float64(allocatable.Cpu().MilliValue()) * 100
 		fractionMemoryReq := float64(memoryReq.Value()) / float64(allocatable.Memory().Value()) * 100
 		fractionMemoryLimit := float64(memoryLimit.Value()) / float64(allocatable.Memory().Value()) * 100
-		w.Write(LEVEL_1, "%s\t%s\t\t%s (%d%%)\t%s (%d%%)\t%s (%d%%)\t%s (%d%%)\t%s\n", pod.Namespace, pod.Name,
+		extColumns := ""
+		for _, ext := range extResources {
+			extReq, extLimit := req[api.ResourceName(ext)], limit[api.ResourceName(ext)]
+			extColumns += fmt.Sprintf("\t%s\t%s", extReq.String(), extLimit.String())
+		}
+		w.Write(LEVEL_1, "%s\t%s\t\t%s (%d%%)\t%s (%d%%)\t%s (%d%%)\t%s (%d%%)%s\t%s\n", pod.Namespace, pod.Name,
 			cpuReq.String(), int64(fractionCpuReq), cpuLimit.String(), int64(fractionCpuLimit),
-			memoryReq.String(), int64(fractionMemoryReq), memoryLimit.String(), int64(fractionMemoryLimit), translateTimestampSince(pod.CreationTimestamp))
+			memoryReq.String(), int64(fractionMemoryReq), memoryLimit.String(), int64(fractionMemoryLimit),
+			extColumns, translateTimestampSince(pod.CreationTimestamp))
 	}
 
 	w.Write(LEVEL_0, "Allocated resources:\n  (Total limits may be over 100 percent, i.e., overcommitted.)\n")
@@ -3186,16 +3205,16 @@ func describeNodeResource(nodeNonTerminatedPodsList *api.PodList, node *api.Node
 		api.ResourceMemory, memoryReqs.String(), int64(fractionMemoryReqs), memoryLimits.String(), int64(fractionMemoryLimits))
 	w.Write(LEVEL_1, "%s\t%s (%d%%)\t%s (%d%%)\n",
 		api.ResourceEphemeralStorage, ephemeralstorageReqs.String(), int64(fractionEphemeralStorageReqs), ephemeralstorageLimits.String(), int64(fractionEphemeralStorageLimits))
-	extResources := make([]string, 0, len(allocatable))
-	for resource := range allocatable {
-		if !helper.IsStandardContainerResourceName(string(resource)) && resource != api.ResourcePods {
-			extResources = append(extResources, string(resource))
-		}
-	}
-	sort.Strings(extResources)
 	for _, ext := range extResources {
 		extRequests, extLimits := reqs[api.ResourceName(ext)], limits[api.ResourceName(ext)]
-		w.Write(LEVEL_1, "%s\t%s\t%s\n", ext, extRequests.String(), extLimits.String())
+		extAllocatable := allocatable[api.ResourceName(ext)]
+		if extAllocatable.Value() != 0 {
+			fractionExtReqs := float64(extRequests.Value()) / float64(extAllocatable.Value()) * 100
+			fractionExtLimits := float64(extLimits.Value()) / float64(extAllocatable.Value()) * 100
+			w.Write(LEVEL_1, "%s\t%s (%d%%)\t%s (%d%%)\n", ext, extRequests.String(), int64(fractionExtReqs), extLimits.String(), int64(fractionExtLimits))
+		} else {
+			w.Write(LEVEL_1, "%s\t%s\t%s\n", ext, extRequests.String(), extLimits.String())
+		}
 	}
 }
 
@@ -3652,6 +3671,17 @@ func describePodDisruptionBudget(pdb *policy.PodDisruptionBudget, events *api.Ev
 		w.Write(LEVEL_2, "Current:\t%d\n", pdb.Status.CurrentHealthy)
 		w.Write(LEVEL_2, "Desired:\t%d\n", pdb.Status.DesiredHealthy)
 		w.Write(LEVEL_2, "Total:\t%d\n", pdb.Status.ExpectedPods)
+		if len(pdb.Status.DisruptedPods) > 0 {
+			w.Write(LEVEL_2, "Disrupted pods:\n")
+			pods := make([]string, 0, len(pdb.Status.DisruptedPods))
+			for pod := range pdb.Status.DisruptedPods {
+				pods = append(pods, pod)
+			}
+			sort.Strings(pods)
+			for _, pod := range pods {
+				w.Write(LEVEL_3, "%s\t(%s)\n", pod, translateTimestampSince(pdb.Status.DisruptedPods[pod]))
+			}
+		}
 		if events != nil {
 			DescribeEvents(events, w)
 		}
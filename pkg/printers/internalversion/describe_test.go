@@ -1569,6 +1569,9 @@ func TestDescribePodDisruptionBudget(t *testing.T) {
 		},
 		Status: policy.PodDisruptionBudgetStatus{
 			PodDisruptionsAllowed: 5,
+			DisruptedPods: map[string]metav1.Time{
+				"pod1": {Time: time.Now().Add(-30 * time.Second)},
+			},
 		},
 	})
 	s := PodDisruptionBudgetDescriber{f}
@@ -1579,7 +1582,8 @@ func TestDescribePodDisruptionBudget(t *testing.T) {
 	if !strings.Contains(out, "pdb1") ||
 		!strings.Contains(out, "ns1") ||
 		!strings.Contains(out, "22") ||
-		!strings.Contains(out, "5") {
+		!strings.Contains(out, "5") ||
+		!strings.Contains(out, "pod1") {
 		t.Errorf("unexpected out: %s", out)
 	}
 }
@@ -2814,6 +2818,66 @@ func TestDescribeNode(t *testing.T) {
 
 }
 
+func TestDescribeNodeExtendedResources(t *testing.T) {
+	fake := fake.NewSimpleClientset(
+		&api.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "bar",
+			},
+			Status: api.NodeStatus{
+				Capacity: api.ResourceList{
+					api.ResourceCPU:                    resource.MustParse("4"),
+					api.ResourceMemory:                 resource.MustParse("4Gi"),
+					api.ResourceName("nvidia.com/gpu"): resource.MustParse("4"),
+				},
+				Allocatable: api.ResourceList{
+					api.ResourceCPU:                    resource.MustParse("4"),
+					api.ResourceMemory:                 resource.MustParse("4Gi"),
+					api.ResourceName("nvidia.com/gpu"): resource.MustParse("4"),
+				},
+			},
+		},
+		&api.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gpu-pod",
+				Namespace: "foo",
+			},
+			Spec: api.PodSpec{
+				NodeName: "bar",
+				Containers: []api.Container{
+					{
+						Name: "gpu-container",
+						Resources: api.ResourceRequirements{
+							Requests: api.ResourceList{
+								api.ResourceName("nvidia.com/gpu"): resource.MustParse("1"),
+							},
+							Limits: api.ResourceList{
+								api.ResourceName("nvidia.com/gpu"): resource.MustParse("1"),
+							},
+						},
+					},
+				},
+			},
+		})
+	c := &describeClient{T: t, Namespace: "foo", Interface: fake}
+	d := NodeDescriber{c}
+	out, err := d.Describe("foo", "bar", printers.DescriberSettings{ShowEvents: false})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	expectedOut := []string{
+		"nvidia.com/gpu Requests",
+		"nvidia.com/gpu Limits",
+		"1 (25%)",
+	}
+	for _, expected := range expectedOut {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected to find %q in output: %q", expected, out)
+		}
+	}
+}
+
 func TestDescribeStatefulSet(t *testing.T) {
 	fake := fake.NewSimpleClientset(&apps.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
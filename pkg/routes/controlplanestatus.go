@@ -0,0 +1,180 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/emicklei/go-restful"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	coordinationv1client "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// controlPlaneComponents names the leader election records that are inspected
+// for leader identities. The records live in the kube-system namespace under
+// the component name, either as an endpoints annotation or as a lease.
+var controlPlaneComponents = []string{"kube-controller-manager", "kube-scheduler"}
+
+// leaderCacheTTL bounds how stale the served leader identities may be. Leader
+// election renew deadlines are typically about 10 seconds, so caching for a
+// fraction of that keeps the endpoint cheap without hiding leadership changes.
+const leaderCacheTTL = 5 * time.Second
+
+// ComponentLeader reports the current leader election state of a single
+// control plane component.
+type ComponentLeader struct {
+	// Component is the name of the leader election record that was inspected.
+	Component string `json:"component"`
+	// HolderIdentity is the identity of the current leader, if any.
+	HolderIdentity string `json:"holderIdentity,omitempty"`
+	// AcquireTime is when the current leader acquired leadership.
+	AcquireTime *metav1.Time `json:"acquireTime,omitempty"`
+	// RenewTime is when the current leader last renewed its leadership.
+	RenewTime *metav1.Time `json:"renewTime,omitempty"`
+	// LeaderTransitions is the number of leadership handovers observed so far.
+	LeaderTransitions int `json:"leaderTransitions,omitempty"`
+	// Error describes why no leader information could be read, if applicable.
+	Error string `json:"error,omitempty"`
+}
+
+// ControlPlaneStatusInfo is the payload served from /controlplane/status.
+type ControlPlaneStatusInfo struct {
+	// Version is the build information of this apiserver.
+	Version *version.Info `json:"version"`
+	// Leaders holds the leader election state of the control plane components.
+	Leaders []ComponentLeader `json:"leaders"`
+}
+
+// ControlPlaneStatus provides a webservice aggregating the apiserver build
+// information with the leader identities of the other control plane
+// components, so operators can tell which instance is active without reading
+// the leader election records directly.
+type ControlPlaneStatus struct {
+	versionInfo *version.Info
+	endpoints   corev1client.EndpointsGetter
+	leases      coordinationv1client.LeasesGetter
+
+	lock      sync.Mutex
+	cached    *ControlPlaneStatusInfo
+	cacheTime time.Time
+}
+
+// NewControlPlaneStatus returns a ControlPlaneStatus reading leader election
+// records with the given clients.
+func NewControlPlaneStatus(versionInfo *version.Info, endpoints corev1client.EndpointsGetter, leases coordinationv1client.LeasesGetter) *ControlPlaneStatus {
+	return &ControlPlaneStatus{
+		versionInfo: versionInfo,
+		endpoints:   endpoints,
+		leases:      leases,
+	}
+}
+
+// Install registers the apiserver's `/controlplane/status` handler.
+func (s *ControlPlaneStatus) Install(c *restful.Container) {
+	ws := new(restful.WebService)
+	ws.Path("/controlplane")
+	ws.Doc("aggregated control plane status")
+	ws.Route(
+		ws.GET("/status").To(s.handleStatus).
+			Doc("get the control plane build info and component leader identities").
+			Operation("getControlPlaneStatus").
+			Produces(restful.MIME_JSON).
+			Consumes(restful.MIME_JSON).
+			Writes(ControlPlaneStatusInfo{}))
+
+	c.Add(ws)
+}
+
+// handleStatus writes the cached control plane status, refreshing it when the
+// cache has expired.
+func (s *ControlPlaneStatus) handleStatus(req *restful.Request, resp *restful.Response) {
+	responsewriters.WriteRawJSON(http.StatusOK, *s.status(), resp.ResponseWriter)
+}
+
+func (s *ControlPlaneStatus) status() *ControlPlaneStatusInfo {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.cached != nil && time.Since(s.cacheTime) < leaderCacheTTL {
+		return s.cached
+	}
+
+	status := &ControlPlaneStatusInfo{Version: s.versionInfo}
+	for _, component := range controlPlaneComponents {
+		status.Leaders = append(status.Leaders, s.leaderFor(component))
+	}
+	s.cached = status
+	s.cacheTime = time.Now()
+	return status
+}
+
+// leaderFor reads the leader election state of the named component, preferring
+// the endpoints annotation (the default resource lock of the scheduler and
+// controller manager) and falling back to a lease of the same name.
+func (s *ControlPlaneStatus) leaderFor(component string) ComponentLeader {
+	leader := ComponentLeader{Component: component}
+
+	if s.endpoints != nil {
+		ep, err := s.endpoints.Endpoints(metav1.NamespaceSystem).Get(component, metav1.GetOptions{})
+		if err == nil {
+			if recordBytes, found := ep.Annotations[resourcelock.LeaderElectionRecordAnnotationKey]; found {
+				record := resourcelock.LeaderElectionRecord{}
+				if err := json.Unmarshal([]byte(recordBytes), &record); err != nil {
+					leader.Error = err.Error()
+					return leader
+				}
+				leader.HolderIdentity = record.HolderIdentity
+				leader.AcquireTime = &record.AcquireTime
+				leader.RenewTime = &record.RenewTime
+				leader.LeaderTransitions = record.LeaderTransitions
+				return leader
+			}
+		}
+	}
+
+	if s.leases != nil {
+		lease, err := s.leases.Leases(metav1.NamespaceSystem).Get(component, metav1.GetOptions{})
+		if err == nil {
+			if lease.Spec.HolderIdentity != nil {
+				leader.HolderIdentity = *lease.Spec.HolderIdentity
+			}
+			if lease.Spec.AcquireTime != nil {
+				acquireTime := metav1.NewTime(lease.Spec.AcquireTime.Time)
+				leader.AcquireTime = &acquireTime
+			}
+			if lease.Spec.RenewTime != nil {
+				renewTime := metav1.NewTime(lease.Spec.RenewTime.Time)
+				leader.RenewTime = &renewTime
+			}
+			if lease.Spec.LeaseTransitions != nil {
+				leader.LeaderTransitions = int(*lease.Spec.LeaseTransitions)
+			}
+			return leader
+		}
+	}
+
+	leader.Error = "no leader election record found"
+	return leader
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routes
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+
+	"github.com/emicklei/go-restful"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	serverstorage "k8s.io/apiserver/pkg/server/storage"
+	storagefactory "k8s.io/apiserver/pkg/storage/storagebackend/factory"
+	"k8s.io/kubernetes/pkg/api/legacyscheme"
+)
+
+// defaultStorageVersionSampleLimit bounds how many stored objects of a
+// resource are inspected per request when counting stored versions.
+const defaultStorageVersionSampleLimit = 500
+
+// GroupStorageVersion reports the version at which the resources of an API
+// group are written to storage.
+type GroupStorageVersion struct {
+	// Group is the API group name, empty for the core API.
+	Group string `json:"group"`
+	// StorageVersion is the version resources of the group are encoded at
+	// when written, unless overridden per resource.
+	StorageVersion string `json:"storageVersion"`
+	// ResourceOverrides maps resources of the group that are pinned to a
+	// different version to that version.
+	ResourceOverrides map[string]string `json:"resourceOverrides,omitempty"`
+}
+
+// StorageVersionList is the payload served from /storageversions.
+type StorageVersionList struct {
+	Groups []GroupStorageVersion `json:"groups"`
+}
+
+// ResourceStorageVersionStatus reports the configured storage version of a
+// single resource together with a sampled count of the versions its objects
+// are currently stored at.
+type ResourceStorageVersionStatus struct {
+	// GroupResource identifies the inspected resource.
+	GroupResource string `json:"groupResource"`
+	// StorageVersion is the version new and updated objects are written at.
+	StorageVersion string `json:"storageVersion"`
+	// SampledObjects is the number of stored objects that were inspected.
+	SampledObjects int64 `json:"sampledObjects"`
+	// ObjectsAtOtherVersions is the number of sampled objects stored at a
+	// version other than StorageVersion. These objects are rewritten at the
+	// current storage version on their next update.
+	ObjectsAtOtherVersions int64 `json:"objectsAtOtherVersions"`
+	// VersionCounts maps each stored version seen in the sample to the
+	// number of objects stored at it.
+	VersionCounts map[string]int64 `json:"versionCounts,omitempty"`
+}
+
+// StorageVersions provides a webservice reporting the configured storage
+// versions and, per resource, a sampled count of objects stored at older
+// versions, so storage version migrations can be planned and verified.
+type StorageVersions struct {
+	StorageFactory *serverstorage.DefaultStorageFactory
+}
+
+// Install registers the apiserver's `/storageversions` handlers.
+func (s StorageVersions) Install(c *restful.Container) {
+	if s.StorageFactory == nil {
+		return
+	}
+
+	ws := new(restful.WebService)
+	ws.Path("/storageversions")
+	ws.Doc("storage versions of the persisted resources")
+	ws.Route(
+		ws.GET("/").To(s.handleList).
+			Doc("list the storage version of each API group").
+			Operation("getStorageVersions").
+			Produces(restful.MIME_JSON).
+			Consumes(restful.MIME_JSON).
+			Writes(StorageVersionList{}))
+	ws.Route(
+		ws.GET("/{resource}").To(s.handleResource).
+			Doc("get the storage version of a resource and a sampled count of objects stored at other versions").
+			Operation("getResourceStorageVersion").
+			Param(ws.PathParameter("resource", "lowercase plural resource name").DataType("string")).
+			Param(ws.QueryParameter("group", "API group of the resource, empty for the core API").DataType("string")).
+			Param(ws.QueryParameter("limit", "maximum number of stored objects to sample").DataType("integer")).
+			Produces(restful.MIME_JSON).
+			Consumes(restful.MIME_JSON).
+			Writes(ResourceStorageVersionStatus{}))
+
+	c.Add(ws)
+}
+
+func (s StorageVersions) handleList(req *restful.Request, resp *restful.Response) {
+	overrides := map[string]map[string]string{}
+	if encodingConfig, ok := s.StorageFactory.ResourceEncodingConfig.(*serverstorage.DefaultResourceEncodingConfig); ok {
+		for groupResource, encoding := range encodingConfig.ResourceOverrides() {
+			if overrides[groupResource.Group] == nil {
+				overrides[groupResource.Group] = map[string]string{}
+			}
+			overrides[groupResource.Group][groupResource.Resource] = encoding.ExternalResourceEncoding.String()
+		}
+	}
+
+	list := StorageVersionList{}
+	seen := map[string]bool{}
+	// PrioritizedVersionsAllGroups lists the versions of each group in
+	// decreasing priority, the first one per group is the storage version.
+	for _, gv := range legacyscheme.Scheme.PrioritizedVersionsAllGroups() {
+		if seen[gv.Group] {
+			continue
+		}
+		seen[gv.Group] = true
+		list.Groups = append(list.Groups, GroupStorageVersion{
+			Group:             gv.Group,
+			StorageVersion:    gv.Version,
+			ResourceOverrides: overrides[gv.Group],
+		})
+	}
+	sort.Slice(list.Groups, func(i, j int) bool { return list.Groups[i].Group < list.Groups[j].Group })
+
+	responsewriters.WriteRawJSON(http.StatusOK, list, resp.ResponseWriter)
+}
+
+func (s StorageVersions) handleResource(req *restful.Request, resp *restful.Response) {
+	groupResource := schema.GroupResource{
+		Group:    req.QueryParameter("group"),
+		Resource: req.PathParameter("resource"),
+	}
+
+	limit := int64(defaultStorageVersionSampleLimit)
+	if limitParam := req.QueryParameter("limit"); len(limitParam) > 0 {
+		parsed, err := strconv.ParseInt(limitParam, 10, 64)
+		if err != nil || parsed <= 0 {
+			resp.WriteError(http.StatusBadRequest, fmt.Errorf("invalid limit %q, must be a positive number", limitParam))
+			return
+		}
+		limit = parsed
+	}
+
+	storageVersion, err := s.StorageFactory.ResourceEncodingConfig.StorageEncodingFor(groupResource)
+	if err != nil {
+		resp.WriteError(http.StatusNotFound, err)
+		return
+	}
+	config, err := s.StorageFactory.NewConfig(groupResource)
+	if err != nil {
+		resp.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	keyPrefix := path.Join("/", config.Prefix, s.StorageFactory.ResourcePrefix(groupResource)) + "/"
+	counts, err := storagefactory.CountObjectVersions(*config, keyPrefix, limit)
+	if err != nil {
+		resp.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	status := ResourceStorageVersionStatus{
+		GroupResource:  groupResource.String(),
+		StorageVersion: storageVersion.String(),
+		VersionCounts:  counts,
+	}
+	for version, count := range counts {
+		status.SampledObjects += count
+		if version != status.StorageVersion {
+			status.ObjectsAtOtherVersions += count
+		}
+	}
+
+	responsewriters.WriteRawJSON(http.StatusOK, status, resp.ResponseWriter)
+}
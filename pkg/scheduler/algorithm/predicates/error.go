@@ -71,6 +71,8 @@ var (
 	ErrNodeUnschedulable = newPredicateFailureError("NodeUnschedulable", "node(s) were unschedulable")
 	// ErrNodeUnknownCondition is used for NodeUnknownCondition predicate error.
 	ErrNodeUnknownCondition = newPredicateFailureError("NodeUnknownCondition", "node(s) had unknown conditions")
+	// ErrNodeHeartbeatStale is used for CheckNodeStaleHeartbeat predicate error.
+	ErrNodeHeartbeatStale = newPredicateFailureError("NodeHeartbeatStale", "node(s) have not reported a status heartbeat recently")
 	// ErrVolumeNodeConflict is used for VolumeNodeAffinityConflict predicate error.
 	ErrVolumeNodeConflict = newPredicateFailureError("VolumeNodeAffinityConflict", "node(s) had volume node affinity conflict")
 	// ErrVolumeBindConflict is used for VolumeBindingNoMatch predicate error.
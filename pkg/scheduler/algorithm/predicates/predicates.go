@@ -22,6 +22,7 @@ import (
 	"os"
 	"regexp"
 	"strconv"
+	"time"
 
 	"k8s.io/klog"
 
@@ -56,6 +57,8 @@ const (
 	CheckVolumeBindingPred = "CheckVolumeBinding"
 	// CheckNodeConditionPred defines the name of predicate CheckNodeCondition.
 	CheckNodeConditionPred = "CheckNodeCondition"
+	// CheckNodeStaleHeartbeatPred defines the name of predicate CheckNodeStaleHeartbeat.
+	CheckNodeStaleHeartbeatPred = "CheckNodeStaleHeartbeat"
 	// GeneralPred defines the name of predicate GeneralPredicates.
 	GeneralPred = "GeneralPredicates"
 	// HostNamePred defines the name of predicate HostName.
@@ -140,7 +143,7 @@ const (
 // The order is based on the restrictiveness & complexity of predicates.
 // Design doc: https://github.com/kubernetes/community/blob/master/contributors/design-proposals/scheduling/predicates-ordering.md
 var (
-	predicatesOrdering = []string{CheckNodeConditionPred, CheckNodeUnschedulablePred,
+	predicatesOrdering = []string{CheckNodeConditionPred, CheckNodeStaleHeartbeatPred, CheckNodeUnschedulablePred,
 		GeneralPred, HostNamePred, PodFitsHostPortsPred,
 		MatchNodeSelectorPred, PodFitsResourcesPred, NoDiskConflictPred,
 		PodToleratesNodeTaintsPred, PodToleratesNodeNoExecuteTaintsPred, CheckNodeLabelPresencePred,
@@ -1632,6 +1635,37 @@ func CheckNodeConditionPredicate(pod *v1.Pod, meta PredicateMetadata, nodeInfo *
 	return len(reasons) == 0, reasons, nil
 }
 
+// StaleNodeHeartbeatThreshold is how long after the last observed heartbeat a
+// node is treated as stale by CheckNodeStaleHeartbeatPredicate and the
+// StaleNodeHeartbeatPriority prioritizer.
+const StaleNodeHeartbeatThreshold = 5 * time.Minute
+
+// NodeHeartbeatStale returns true if the node's Ready condition heartbeat is
+// older than the threshold. Nodes without a Ready condition are not considered
+// stale here; CheckNodeConditionPredicate already refuses them.
+func NodeHeartbeatStale(node *v1.Node, threshold time.Duration, now time.Time) bool {
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == v1.NodeReady {
+			return now.Sub(cond.LastHeartbeatTime.Time) > threshold
+		}
+	}
+	return false
+}
+
+// CheckNodeStaleHeartbeatPredicate refuses nodes whose status has not been
+// reported for longer than StaleNodeHeartbeatThreshold. This keeps pods off
+// nodes that are likely dead before the node lifecycle controller has had a
+// chance to taint them, e.g. during a controller-manager outage.
+func CheckNodeStaleHeartbeatPredicate(pod *v1.Pod, meta PredicateMetadata, nodeInfo *schedulernodeinfo.NodeInfo) (bool, []PredicateFailureReason, error) {
+	if nodeInfo == nil || nodeInfo.Node() == nil {
+		return false, []PredicateFailureReason{ErrNodeUnknownCondition}, nil
+	}
+	if NodeHeartbeatStale(nodeInfo.Node(), StaleNodeHeartbeatThreshold, time.Now()) {
+		return false, []PredicateFailureReason{ErrNodeHeartbeatStale}, nil
+	}
+	return true, nil, nil
+}
+
 // VolumeBindingChecker contains information to check a volume binding.
 type VolumeBindingChecker struct {
 	binder *volumebinder.VolumeBinder
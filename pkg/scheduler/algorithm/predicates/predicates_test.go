@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
@@ -5003,3 +5004,46 @@ func TestCheckNodeUnschedulablePredicate(t *testing.T) {
 		}
 	}
 }
+
+func TestNodeHeartbeatStale(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name  string
+		node  *v1.Node
+		stale bool
+	}{
+		{
+			name: "fresh heartbeat",
+			node: &v1.Node{
+				Status: v1.NodeStatus{
+					Conditions: []v1.NodeCondition{
+						{Type: v1.NodeReady, Status: v1.ConditionTrue, LastHeartbeatTime: metav1.NewTime(now.Add(-time.Minute))},
+					},
+				},
+			},
+			stale: false,
+		},
+		{
+			name: "stale heartbeat",
+			node: &v1.Node{
+				Status: v1.NodeStatus{
+					Conditions: []v1.NodeCondition{
+						{Type: v1.NodeReady, Status: v1.ConditionTrue, LastHeartbeatTime: metav1.NewTime(now.Add(-StaleNodeHeartbeatThreshold - time.Minute))},
+					},
+				},
+			},
+			stale: true,
+		},
+		{
+			name:  "no ready condition",
+			node:  &v1.Node{},
+			stale: false,
+		},
+	}
+
+	for _, test := range testCases {
+		if stale := NodeHeartbeatStale(test.node, StaleNodeHeartbeatThreshold, now); stale != test.stale {
+			t.Errorf("%s: expected stale %v, got %v", test.name, test.stale, stale)
+		}
+	}
+}
@@ -18,6 +18,7 @@ package priorities
 
 import (
 	"crypto/sha256"
+	"fmt"
 	"reflect"
 	"sort"
 	"testing"
@@ -197,6 +198,48 @@ func TestNormalizedImageName(t *testing.T) {
 	}
 }
 
+func BenchmarkImageLocalityPriority(b *testing.B) {
+	// A pod with a handful of reasonably sized images, scored against a larger
+	// set of nodes each holding many images; roughly the shape of a busy cluster.
+	const (
+		numNodes         = 100
+		imagesPerNode    = 20
+		containersPerPod = 4
+	)
+
+	pod := &v1.Pod{Spec: v1.PodSpec{}}
+	for i := 0; i < containersPerPod; i++ {
+		pod.Spec.Containers = append(pod.Spec.Containers, v1.Container{
+			Image: fmt.Sprintf("gcr.io/image-%d", i),
+		})
+	}
+
+	nodes := make([]*v1.Node, 0, numNodes)
+	for i := 0; i < numNodes; i++ {
+		status := v1.NodeStatus{}
+		for j := 0; j < imagesPerNode; j++ {
+			// Make sure some of the pod's images are present on some of the nodes.
+			status.Images = append(status.Images, v1.ContainerImage{
+				Names:     []string{fmt.Sprintf("gcr.io/image-%d:latest", (i+j)%imagesPerNode)},
+				SizeBytes: int64(j+1) * 50 * mb,
+			})
+		}
+		nodes = append(nodes, makeImageNode(fmt.Sprintf("machine%d", i), status))
+	}
+
+	nodeNameToInfo := schedulernodeinfo.CreateNodeNameToInfoMap(nil, nodes)
+	meta := &priorityMetadata{totalNumNodes: len(nodes)}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, node := range nodes {
+			if _, err := ImageLocalityPriorityMap(pod, meta, nodeNameToInfo[node.Name]); err != nil {
+				b.Fatalf("unexpected error: %v", err)
+			}
+		}
+	}
+}
+
 func makeImageNode(node string, status v1.NodeStatus) *v1.Node {
 	return &v1.Node{
 		ObjectMeta: metav1.ObjectMeta{Name: node},
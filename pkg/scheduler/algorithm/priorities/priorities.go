@@ -51,4 +51,10 @@ const (
 	ImageLocalityPriority = "ImageLocalityPriority"
 	// ResourceLimitsPriority defines the nodes of prioritizer function ResourceLimitsPriority.
 	ResourceLimitsPriority = "ResourceLimitsPriority"
+	// StaleNodeHeartbeatPriority defines the name of prioritizer function that penalizes nodes
+	// whose status heartbeat is stale.
+	StaleNodeHeartbeatPriority = "StaleNodeHeartbeatPriority"
+	// RecentBindingHotspotPriority defines the name of prioritizer function that penalizes nodes
+	// that received a disproportionate share of recent bindings.
+	RecentBindingHotspotPriority = "RecentBindingHotspotPriority"
 )
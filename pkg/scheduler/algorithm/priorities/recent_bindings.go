@@ -0,0 +1,115 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/api/core/v1"
+	schedulerapi "k8s.io/kubernetes/pkg/scheduler/api"
+	schedulernodeinfo "k8s.io/kubernetes/pkg/scheduler/nodeinfo"
+)
+
+// DefaultRecentBindingWindow is how long a binding counts against a node in
+// the hotspot priority. It should roughly cover the time a kubelet needs to
+// start the pods from a placement burst, including image pulls.
+const DefaultRecentBindingWindow = 2 * time.Minute
+
+// RecentBindingTracker counts successful bindings per node within a sliding
+// window. The scheduler records every binding it issues; the hotspot priority
+// reads the counts back to debias scoring away from nodes that absorbed a
+// disproportionate share of recent placements.
+type RecentBindingTracker struct {
+	mu     sync.Mutex
+	window time.Duration
+	// bindings holds, per node, the times of the bindings that are still
+	// within the window. Expired entries are pruned on access.
+	bindings map[string][]time.Time
+	now      func() time.Time
+}
+
+// NewRecentBindingTracker creates a RecentBindingTracker forgetting bindings
+// older than window.
+func NewRecentBindingTracker(window time.Duration) *RecentBindingTracker {
+	return &RecentBindingTracker{
+		window:   window,
+		bindings: make(map[string][]time.Time),
+		now:      time.Now,
+	}
+}
+
+// Record notes that a pod was just bound to the given node.
+func (t *RecentBindingTracker) Record(nodeName string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	t.bindings[nodeName] = append(t.prune(nodeName, now), now)
+}
+
+// Count returns the number of bindings to the given node within the window.
+func (t *RecentBindingTracker) Count(nodeName string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	pruned := t.prune(nodeName, t.now())
+	if len(pruned) == 0 {
+		delete(t.bindings, nodeName)
+		return 0
+	}
+	t.bindings[nodeName] = pruned
+	return len(pruned)
+}
+
+// prune drops the bindings for nodeName that fell out of the window. The
+// caller must hold t.mu.
+func (t *RecentBindingTracker) prune(nodeName string, now time.Time) []time.Time {
+	cutoff := now.Add(-t.window)
+	times := t.bindings[nodeName]
+	for len(times) > 0 && times[0].Before(cutoff) {
+		times = times[1:]
+	}
+	return times
+}
+
+// TheRecentBindingTracker is the tracker the scheduler feeds with its binding
+// decisions and the RecentBindingHotspotPriority reads from. It is shared
+// package state because priority map functions have no other channel to the
+// binding loop.
+var TheRecentBindingTracker = NewRecentBindingTracker(DefaultRecentBindingWindow)
+
+// RecentBindingHotspotPriorityMap scores each node with the number of pods the
+// scheduler recently bound to it. Combined with RecentBindingHotspotPriorityReduce
+// this penalizes nodes that received a disproportionate share of recent
+// bindings, smoothing placement bursts that would otherwise overload a single
+// kubelet with simultaneous pod starts and image pulls. The penalty decays as
+// bindings age out of the tracker window.
+func RecentBindingHotspotPriorityMap(pod *v1.Pod, meta interface{}, nodeInfo *schedulernodeinfo.NodeInfo) (schedulerapi.HostPriority, error) {
+	node := nodeInfo.Node()
+	if node == nil {
+		return schedulerapi.HostPriority{}, fmt.Errorf("node not found")
+	}
+	return schedulerapi.HostPriority{
+		Host:  node.Name,
+		Score: TheRecentBindingTracker.Count(node.Name),
+	}, nil
+}
+
+// RecentBindingHotspotPriorityReduce normalizes the recent binding counts so
+// that the hottest node scores 0 and nodes without recent bindings score the
+// maximum.
+var RecentBindingHotspotPriorityReduce = NormalizeReduce(schedulerapi.MaxPriority, true)
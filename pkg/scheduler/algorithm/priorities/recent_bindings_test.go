@@ -0,0 +1,123 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/api/core/v1"
+	schedulerapi "k8s.io/kubernetes/pkg/scheduler/api"
+	schedulernodeinfo "k8s.io/kubernetes/pkg/scheduler/nodeinfo"
+)
+
+func TestRecentBindingTracker(t *testing.T) {
+	now := time.Now()
+	tracker := NewRecentBindingTracker(time.Minute)
+	tracker.now = func() time.Time { return now }
+
+	if count := tracker.Count("machine1"); count != 0 {
+		t.Errorf("expected 0 bindings for an unknown node, got %d", count)
+	}
+
+	tracker.Record("machine1")
+	tracker.Record("machine1")
+	tracker.Record("machine2")
+	if count := tracker.Count("machine1"); count != 2 {
+		t.Errorf("expected 2 bindings for machine1, got %d", count)
+	}
+	if count := tracker.Count("machine2"); count != 1 {
+		t.Errorf("expected 1 binding for machine2, got %d", count)
+	}
+
+	// Bindings decay once they fall out of the window.
+	now = now.Add(30 * time.Second)
+	tracker.Record("machine1")
+	now = now.Add(45 * time.Second)
+	if count := tracker.Count("machine1"); count != 1 {
+		t.Errorf("expected 1 binding for machine1 after decay, got %d", count)
+	}
+	now = now.Add(time.Minute)
+	if count := tracker.Count("machine1"); count != 0 {
+		t.Errorf("expected 0 bindings for machine1 after full decay, got %d", count)
+	}
+}
+
+func TestRecentBindingHotspotPriority(t *testing.T) {
+	saved := TheRecentBindingTracker
+	defer func() { TheRecentBindingTracker = saved }()
+
+	tests := []struct {
+		name         string
+		bindings     map[string]int
+		expectedList schedulerapi.HostPriorityList
+	}{
+		{
+			name:     "no recent bindings",
+			bindings: map[string]int{},
+			expectedList: []schedulerapi.HostPriority{
+				{Host: "machine1", Score: schedulerapi.MaxPriority},
+				{Host: "machine2", Score: schedulerapi.MaxPriority},
+				{Host: "machine3", Score: schedulerapi.MaxPriority},
+			},
+		},
+		{
+			name:     "hottest node scores zero",
+			bindings: map[string]int{"machine1": 10, "machine2": 5},
+			expectedList: []schedulerapi.HostPriority{
+				{Host: "machine1", Score: 0},
+				{Host: "machine2", Score: schedulerapi.MaxPriority / 2},
+				{Host: "machine3", Score: schedulerapi.MaxPriority},
+			},
+		},
+		{
+			name:     "uniform load yields equal scores",
+			bindings: map[string]int{"machine1": 3, "machine2": 3, "machine3": 3},
+			expectedList: []schedulerapi.HostPriority{
+				{Host: "machine1", Score: 0},
+				{Host: "machine2", Score: 0},
+				{Host: "machine3", Score: 0},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			TheRecentBindingTracker = NewRecentBindingTracker(DefaultRecentBindingWindow)
+			for node, count := range test.bindings {
+				for i := 0; i < count; i++ {
+					TheRecentBindingTracker.Record(node)
+				}
+			}
+
+			nodes := []*v1.Node{
+				makeNode("machine1", 1000, 10000),
+				makeNode("machine2", 1000, 10000),
+				makeNode("machine3", 1000, 10000),
+			}
+			nodeNameToInfo := schedulernodeinfo.CreateNodeNameToInfoMap(nil, nodes)
+			list, err := priorityFunction(RecentBindingHotspotPriorityMap, RecentBindingHotspotPriorityReduce, nil)(&v1.Pod{}, nodeNameToInfo, nodes)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(test.expectedList, list) {
+				t.Errorf("expected %#v, got %#v", test.expectedList, list)
+			}
+		})
+	}
+}
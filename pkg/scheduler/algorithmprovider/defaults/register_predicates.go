@@ -118,6 +118,11 @@ func init() {
 	// Fit is determined by node conditions: not ready, network unavailable or out of disk.
 	factory.RegisterMandatoryFitPredicate(predicates.CheckNodeConditionPred, predicates.CheckNodeConditionPredicate)
 
+	// Fit is determined by the freshness of the node's status heartbeat.
+	// Register the predicate so users can opt in to excluding stale nodes via a
+	// scheduler policy, but do not include it in the default provider.
+	factory.RegisterFitPredicate(predicates.CheckNodeStaleHeartbeatPred, predicates.CheckNodeStaleHeartbeatPredicate)
+
 	// Fit is determined based on whether a pod can tolerate all of the node's taints
 	factory.RegisterFitPredicate(predicates.PodToleratesNodeTaintsPred, predicates.PodToleratesNodeTaints)
 
@@ -49,6 +49,12 @@ func init() {
 	factory.RegisterPriorityFunction2(priorities.EqualPriority, core.EqualPriorityMap, nil, 1)
 	// Optional, cluster-autoscaler friendly priority function - give used nodes higher priority.
 	factory.RegisterPriorityFunction2(priorities.MostRequestedPriority, priorities.MostRequestedPriorityMap, nil, 1)
+	// Optional priority that penalizes nodes whose status heartbeat is stale instead of
+	// excluding them outright; pick it in a policy as the soft variant of CheckNodeStaleHeartbeat.
+	factory.RegisterPriorityFunction2(priorities.StaleNodeHeartbeatPriority, priorities.StaleNodeHeartbeatPriorityMap, nil, 1)
+	// Optional priority that penalizes nodes that absorbed a disproportionate share of
+	// recent bindings, smoothing placement bursts across the cluster.
+	factory.RegisterPriorityFunction2(priorities.RecentBindingHotspotPriority, priorities.RecentBindingHotspotPriorityMap, priorities.RecentBindingHotspotPriorityReduce, 1)
 	factory.RegisterPriorityFunction2(
 		priorities.RequestedToCapacityRatioPriority,
 		priorities.RequestedToCapacityRatioResourceAllocationPriorityDefault().PriorityMap,
@@ -88,6 +88,15 @@ type KubeSchedulerConfiguration struct {
 	// If this value is nil, the default value will be used.
 	BindTimeoutSeconds *int64
 
+	// DecisionLogFile is the path of a JSON-lines file the scheduler appends a
+	// structured record of every scheduling decision to, for offline analysis.
+	// An empty path disables the decision log.
+	DecisionLogFile string
+
+	// DecisionLogSampleRate logs only every n-th decision when the decision
+	// log is enabled. Values less than or equal to 1 log every decision.
+	DecisionLogSampleRate int32
+
 	// Plugins specify the set of plugins that should be enabled or disabled. Enabled plugins are the
 	// ones that should be enabled in addition to the default plugins. Disabled plugins are any of the
 	// default plugins that should be disabled.
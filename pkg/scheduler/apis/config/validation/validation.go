@@ -47,6 +47,10 @@ func ValidateKubeSchedulerConfiguration(cc *config.KubeSchedulerConfiguration) f
 		allErrs = append(allErrs, field.Invalid(field.NewPath("percentageOfNodesToScore"),
 			cc.PercentageOfNodesToScore, "not in valid range 0-100"))
 	}
+	if cc.DecisionLogSampleRate < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("decisionLogSampleRate"),
+			cc.DecisionLogSampleRate, "must not be negative"))
+	}
 	return allErrs
 }
 
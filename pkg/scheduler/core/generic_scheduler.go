@@ -20,7 +20,9 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -149,6 +151,9 @@ type ScheduleResult struct {
 	EvaluatedNodes int
 	// Number of feasible nodes on one pod scheduled
 	FeasibleNodes int
+	// Aggregate score for each feasible node. Empty when the priority
+	// functions were skipped because only one node was feasible.
+	Scores schedulerapi.HostPriorityList
 }
 
 type genericScheduler struct {
@@ -169,6 +174,20 @@ type genericScheduler struct {
 	disablePreemption        bool
 	lastIndex                int
 	percentageOfNodesToScore int32
+	deterministicMode        bool
+}
+
+// deterministicModeEnvVar is the environment variable that enables the
+// deterministic scheduling mode. When set to true, score ties are broken by
+// node name instead of round-robin, so that integration tests and bug
+// reproductions behave consistently across runs. The default behavior is kept
+// for production, where round-robin helps spread pods across equally scored
+// nodes.
+const deterministicModeEnvVar = "KUBE_SCHEDULER_DETERMINISTIC_MODE"
+
+func deterministicModeEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(deterministicModeEnvVar))
+	return enabled
 }
 
 // snapshot snapshots scheduler cache and node infos for all fit and priority
@@ -250,6 +269,7 @@ func (g *genericScheduler) Schedule(pod *v1.Pod, nodeLister algorithm.NodeLister
 		SuggestedHost:  host,
 		EvaluatedNodes: len(filteredNodes) + len(failedPredicateMap),
 		FeasibleNodes:  len(filteredNodes),
+		Scores:         priorityList,
 	}, err
 }
 
@@ -283,12 +303,23 @@ func findMaxScores(priorityList schedulerapi.HostPriorityList) []int {
 
 // selectHost takes a prioritized list of nodes and then picks one
 // in a round-robin manner from the nodes that had the highest score.
+// In deterministic mode ties are broken by node name instead, so that
+// repeated runs produce the same placement.
 func (g *genericScheduler) selectHost(priorityList schedulerapi.HostPriorityList) (string, error) {
 	if len(priorityList) == 0 {
 		return "", fmt.Errorf("empty priorityList")
 	}
 
 	maxScores := findMaxScores(priorityList)
+	if g.deterministicMode {
+		selected := maxScores[0]
+		for _, i := range maxScores[1:] {
+			if priorityList[i].Host < priorityList[selected].Host {
+				selected = i
+			}
+		}
+		return priorityList[selected].Host, nil
+	}
 	ix := int(g.lastNodeIndex % uint64(len(maxScores)))
 	g.lastNodeIndex++
 
@@ -429,14 +460,27 @@ func (g *genericScheduler) getLowerPriorityNominatedPods(pod *v1.Pod, nodeName s
 	return lowerPriorityPods
 }
 
+// PercentageOfNodesToScoreSetter is implemented by scheduling algorithms whose
+// feasible-node sample size can be adjusted while the scheduler is running.
+type PercentageOfNodesToScoreSetter interface {
+	SetPercentageOfNodesToScore(percentage int32)
+}
+
+// SetPercentageOfNodesToScore updates the feasible-node sample size. It is
+// safe to call concurrently with scheduling cycles.
+func (g *genericScheduler) SetPercentageOfNodesToScore(percentage int32) {
+	atomic.StoreInt32(&g.percentageOfNodesToScore, percentage)
+}
+
 // numFeasibleNodesToFind returns the number of feasible nodes that once found, the scheduler stops
 // its search for more feasible nodes.
 func (g *genericScheduler) numFeasibleNodesToFind(numAllNodes int32) (numNodes int32) {
-	if numAllNodes < minFeasibleNodesToFind || g.percentageOfNodesToScore >= 100 {
+	percentageOfNodesToScore := atomic.LoadInt32(&g.percentageOfNodesToScore)
+	if numAllNodes < minFeasibleNodesToFind || percentageOfNodesToScore >= 100 {
 		return numAllNodes
 	}
 
-	adaptivePercentage := g.percentageOfNodesToScore
+	adaptivePercentage := percentageOfNodesToScore
 	if adaptivePercentage <= 0 {
 		adaptivePercentage = schedulerapi.DefaultPercentageOfNodesToScore - numAllNodes/125
 		if adaptivePercentage < minFeasibleNodesPercentageToFind {
@@ -1223,6 +1267,7 @@ func NewGenericScheduler(
 ) ScheduleAlgorithm {
 	return &genericScheduler{
 		cache:                    cache,
+		deterministicMode:        deterministicModeEnabled(),
 		schedulingQueue:          podQueue,
 		predicates:               predicates,
 		predicateMetaProducer:    predicateMetaProducer,
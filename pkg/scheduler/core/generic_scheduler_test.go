@@ -217,6 +217,26 @@ func TestSelectHost(t *testing.T) {
 	}
 }
 
+func TestSelectHostDeterministicMode(t *testing.T) {
+	scheduler := genericScheduler{deterministicMode: true}
+	list := []schedulerapi.HostPriority{
+		{Host: "machine1.2", Score: 2},
+		{Host: "machine1.1", Score: 2},
+		{Host: "machine2.1", Score: 2},
+		{Host: "machine3.1", Score: 1},
+	}
+	// the same host must be selected on every invocation
+	for i := 0; i < 10; i++ {
+		got, err := scheduler.selectHost(list)
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if got != "machine1.1" {
+			t.Errorf("got %s, expected machine1.1", got)
+		}
+	}
+}
+
 func TestGenericScheduler(t *testing.T) {
 	defer algorithmpredicates.SetPredicatesOrderingDuringTest(order)()
 	tests := []struct {
@@ -1598,3 +1618,16 @@ func TestNumFeasibleNodesToFind(t *testing.T) {
 		})
 	}
 }
+
+func TestSetPercentageOfNodesToScore(t *testing.T) {
+	g := &genericScheduler{percentageOfNodesToScore: 40}
+	if gotNumNodes := g.numFeasibleNodesToFind(1000); gotNumNodes != 400 {
+		t.Errorf("genericScheduler.numFeasibleNodesToFind() = %v, want %v", gotNumNodes, 400)
+	}
+
+	var setter PercentageOfNodesToScoreSetter = g
+	setter.SetPercentageOfNodesToScore(10)
+	if gotNumNodes := g.numFeasibleNodesToFind(1000); gotNumNodes != 100 {
+		t.Errorf("genericScheduler.numFeasibleNodesToFind() after update = %v, want %v", gotNumNodes, 100)
+	}
+}
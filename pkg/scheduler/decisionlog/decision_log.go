@@ -0,0 +1,167 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package decisionlog writes a structured record of every scheduling decision
+// to a rotating JSON-lines file, so capacity teams can analyze or replay
+// scheduling behavior offline without scraping the scheduler's text logs.
+package decisionlog
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"k8s.io/klog"
+)
+
+// Outcomes recorded in a Decision.
+const (
+	// OutcomeScheduled means a node was selected for the pod.
+	OutcomeScheduled = "Scheduled"
+	// OutcomeUnschedulable means no node fit the pod.
+	OutcomeUnschedulable = "Unschedulable"
+	// OutcomeError means the scheduling attempt failed with an internal error.
+	OutcomeError = "Error"
+)
+
+// maxFileSize is the size at which the log file is rotated. One previous
+// file is kept with a ".1" suffix.
+const maxFileSize = 100 * 1024 * 1024
+
+// NodeScore is one node's aggregate score for a scheduling attempt.
+type NodeScore struct {
+	Node  string `json:"node"`
+	Score int    `json:"score"`
+}
+
+// Decision is one scheduling outcome, serialized as a single JSON line.
+type Decision struct {
+	// Time is when the scheduling attempt finished.
+	Time time.Time `json:"time"`
+	// Pod is the namespace/name of the pod that was scheduled.
+	Pod string `json:"pod"`
+	// UID is the pod's UID, so replays can distinguish recreated pods.
+	UID string `json:"uid"`
+	// Outcome is one of OutcomeScheduled, OutcomeUnschedulable or OutcomeError.
+	Outcome string `json:"outcome"`
+	// Node is the chosen node. Empty unless the outcome is OutcomeScheduled.
+	Node string `json:"node,omitempty"`
+	// Reason records why the pod could not be scheduled.
+	Reason string `json:"reason,omitempty"`
+	// EvaluatedNodes is the number of nodes the scheduler evaluated.
+	EvaluatedNodes int `json:"evaluatedNodes"`
+	// FeasibleNodes is the number of nodes that fit the pod.
+	FeasibleNodes int `json:"feasibleNodes"`
+	// Scores is the per-node score breakdown, when the priority functions ran.
+	Scores []NodeScore `json:"scores,omitempty"`
+	// DurationMicroseconds is how long the scheduling algorithm took.
+	DurationMicroseconds int64 `json:"durationMicroseconds"`
+}
+
+// Logger appends decisions to a JSON-lines file, rotating it when it grows
+// past maxFileSize and keeping one previous file with a ".1" suffix.
+type Logger struct {
+	path       string
+	sampleRate int32
+
+	lock sync.Mutex
+	file *os.File
+	size int64
+	// seen counts decisions since the last logged one, for sampling.
+	seen int32
+}
+
+// New returns a logger appending to the file at path. A sampleRate of n logs
+// every n-th decision; values below 1 log every decision.
+func New(path string, sampleRate int32) (*Logger, error) {
+	if sampleRate < 1 {
+		sampleRate = 1
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &Logger{
+		path:       path,
+		sampleRate: sampleRate,
+		file:       file,
+		size:       info.Size(),
+	}, nil
+}
+
+// Log writes the decision as one JSON line, subject to sampling. Write
+// failures are logged and dropped so the decision log can never block or fail
+// scheduling.
+func (l *Logger) Log(decision *Decision) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.seen++
+	if l.seen < l.sampleRate {
+		return
+	}
+	l.seen = 0
+
+	line, err := json.Marshal(decision)
+	if err != nil {
+		klog.Errorf("Failed to marshal scheduling decision for pod %q: %v", decision.Pod, err)
+		return
+	}
+	line = append(line, '\n')
+
+	if l.size+int64(len(line)) > maxFileSize {
+		if err := l.rotate(); err != nil {
+			klog.Errorf("Failed to rotate scheduling decision log %q: %v", l.path, err)
+			return
+		}
+	}
+	n, err := l.file.Write(line)
+	l.size += int64(n)
+	if err != nil {
+		klog.Errorf("Failed to write scheduling decision log %q: %v", l.path, err)
+	}
+}
+
+// rotate closes the current file, moves it to path.1 and opens a fresh file.
+// Callers must hold the lock.
+func (l *Logger) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(l.path, l.path+".1"); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(l.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	l.file = file
+	l.size = 0
+	return nil
+}
+
+// Close closes the underlying file.
+func (l *Logger) Close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.file.Close()
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decisionlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoggerWritesJSONLines(t *testing.T) {
+	dir, err := ioutil.TempDir("", "decisionlog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	logger, err := New(filepath.Join(dir, "decisions.log"), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logger.Close()
+
+	logger.Log(&Decision{
+		Time:           time.Now(),
+		Pod:            "default/foo",
+		UID:            "uid-1",
+		Outcome:        OutcomeScheduled,
+		Node:           "node-1",
+		EvaluatedNodes: 3,
+		FeasibleNodes:  2,
+		Scores:         []NodeScore{{Node: "node-1", Score: 10}, {Node: "node-2", Score: 4}},
+	})
+
+	decisions := readDecisions(t, filepath.Join(dir, "decisions.log"))
+	if len(decisions) != 1 {
+		t.Fatalf("expected 1 decision, got %d", len(decisions))
+	}
+	if decisions[0].Pod != "default/foo" || decisions[0].Node != "node-1" || decisions[0].Outcome != OutcomeScheduled {
+		t.Errorf("unexpected decision: %+v", decisions[0])
+	}
+	if len(decisions[0].Scores) != 2 {
+		t.Errorf("expected 2 scores, got %v", decisions[0].Scores)
+	}
+}
+
+func TestLoggerSampling(t *testing.T) {
+	dir, err := ioutil.TempDir("", "decisionlog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	logger, err := New(filepath.Join(dir, "decisions.log"), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logger.Close()
+
+	for i := 0; i < 9; i++ {
+		logger.Log(&Decision{Pod: "default/foo", Outcome: OutcomeUnschedulable})
+	}
+
+	decisions := readDecisions(t, filepath.Join(dir, "decisions.log"))
+	if len(decisions) != 3 {
+		t.Errorf("expected every 3rd of 9 decisions to be logged, got %d", len(decisions))
+	}
+}
+
+func readDecisions(t *testing.T, path string) []Decision {
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var decisions []Decision
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var decision Decision
+		if err := json.Unmarshal(scanner.Bytes(), &decision); err != nil {
+			t.Fatalf("invalid JSON line %q: %v", scanner.Text(), err)
+		}
+		decisions = append(decisions, decision)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return decisions
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/klog"
+	"k8s.io/kubernetes/pkg/scheduler/core"
+)
+
+const (
+	// SchedulingDiagnosisAnnotationKey is the annotation a user puts on a pod
+	// to request a scheduling diagnosis. When a pod carrying this annotation
+	// with the value "true" fails to schedule, the scheduler records an event
+	// describing, per node, which predicates failed and which pods preemption
+	// would select as victims, without actually preempting anything extra.
+	SchedulingDiagnosisAnnotationKey = "scheduler.alpha.kubernetes.io/diagnose"
+
+	// diagnosisMaxNodes bounds the number of nodes detailed in a single
+	// diagnosis message.
+	diagnosisMaxNodes = 10
+	// diagnosisMaxVictims bounds the number of victims named in a single
+	// diagnosis message.
+	diagnosisMaxVictims = 5
+	// diagnosisMaxMessage bounds the total size of a diagnosis message so it
+	// stays well within the event note length limit.
+	diagnosisMaxMessage = 1000
+)
+
+// podRequestsDiagnosis returns true if the pod asked for a scheduling
+// diagnosis via the diagnose annotation.
+func podRequestsDiagnosis(pod *v1.Pod) bool {
+	return pod.Annotations[SchedulingDiagnosisAnnotationKey] == "true"
+}
+
+// diagnoseSchedulingFailure records a "SchedulingDiagnosis" event for an
+// unschedulable pod that requested one, listing the failed predicates per
+// node and the victims a preemption dry run would select. The dry run uses
+// the same algorithm as real preemption but performs no API mutations, so it
+// is safe to run even when preemption is disabled.
+func (sched *Scheduler) diagnoseSchedulingFailure(pod *v1.Pod, fitError *core.FitError) {
+	if !podRequestsDiagnosis(pod) {
+		return
+	}
+
+	node, victims, _, err := sched.config.Algorithm.Preempt(pod, sched.config.NodeLister, fitError)
+	if err != nil {
+		klog.Errorf("Error in preemption dry run while diagnosing pod %v/%v: %v", pod.Namespace, pod.Name, err)
+	}
+
+	message := formatSchedulingDiagnosis(fitError, node, victims)
+	sched.config.Recorder.Event(pod, v1.EventTypeNormal, "SchedulingDiagnosis", message)
+}
+
+// formatSchedulingDiagnosis renders the per-node predicate failures and the
+// outcome of a preemption dry run as a single bounded message.
+func formatSchedulingDiagnosis(fitError *core.FitError, node *v1.Node, victims []*v1.Pod) string {
+	nodeNames := make([]string, 0, len(fitError.FailedPredicates))
+	for name := range fitError.FailedPredicates {
+		nodeNames = append(nodeNames, name)
+	}
+	sort.Strings(nodeNames)
+
+	lines := []string{}
+	for i, name := range nodeNames {
+		if i == diagnosisMaxNodes {
+			lines = append(lines, fmt.Sprintf("... and %d other node(s)", len(nodeNames)-diagnosisMaxNodes))
+			break
+		}
+		reasons := []string{}
+		for _, reason := range fitError.FailedPredicates[name] {
+			reasons = append(reasons, reason.GetReason())
+		}
+		lines = append(lines, fmt.Sprintf("node %s: %s", name, strings.Join(reasons, ", ")))
+	}
+
+	switch {
+	case node == nil:
+		lines = append(lines, "preemption would not help schedule this pod on any node")
+	case len(victims) == 0:
+		lines = append(lines, fmt.Sprintf("preemption would nominate node %s without evicting any pod", node.Name))
+	default:
+		names := make([]string, 0, len(victims))
+		for i, victim := range victims {
+			if i == diagnosisMaxVictims {
+				names = append(names, fmt.Sprintf("and %d more", len(victims)-diagnosisMaxVictims))
+				break
+			}
+			names = append(names, fmt.Sprintf("%s/%s", victim.Namespace, victim.Name))
+		}
+		lines = append(lines, fmt.Sprintf("preemption would nominate node %s, evicting: %s", node.Name, strings.Join(names, ", ")))
+	}
+
+	message := strings.Join(lines, "; ")
+	if len(message) > diagnosisMaxMessage {
+		message = message[:diagnosisMaxMessage] + "..."
+	}
+	return message
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/scheduler/algorithm/predicates"
+	"k8s.io/kubernetes/pkg/scheduler/core"
+)
+
+func TestPodRequestsDiagnosis(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    bool
+	}{
+		{
+			name:     "no annotations",
+			expected: false,
+		},
+		{
+			name:        "annotation set to true",
+			annotations: map[string]string{SchedulingDiagnosisAnnotationKey: "true"},
+			expected:    true,
+		},
+		{
+			name:        "annotation set to false",
+			annotations: map[string]string{SchedulingDiagnosisAnnotationKey: "false"},
+			expected:    false,
+		},
+	}
+	for _, test := range tests {
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "foo", Annotations: test.annotations}}
+		if got := podRequestsDiagnosis(pod); got != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, got)
+		}
+	}
+}
+
+func TestFormatSchedulingDiagnosis(t *testing.T) {
+	fitError := &core.FitError{
+		NumAllNodes: 2,
+		FailedPredicates: core.FailedPredicateMap{
+			"machine1": []predicates.PredicateFailureReason{predicates.ErrNodeSelectorNotMatch},
+			"machine2": []predicates.PredicateFailureReason{predicates.ErrPodNotMatchHostName, predicates.ErrTaintsTolerationsNotMatch},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		node               *v1.Node
+		victims            []*v1.Pod
+		expectedSubstrings []string
+	}{
+		{
+			name: "preemption would not help",
+			expectedSubstrings: []string{
+				"node machine1: " + predicates.ErrNodeSelectorNotMatch.GetReason(),
+				"node machine2: " + predicates.ErrPodNotMatchHostName.GetReason() + ", " + predicates.ErrTaintsTolerationsNotMatch.GetReason(),
+				"preemption would not help schedule this pod on any node",
+			},
+		},
+		{
+			name: "preemption without victims",
+			node: &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "machine1"}},
+			expectedSubstrings: []string{
+				"preemption would nominate node machine1 without evicting any pod",
+			},
+		},
+		{
+			name: "preemption with victims",
+			node: &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "machine2"}},
+			victims: []*v1.Pod{
+				{ObjectMeta: metav1.ObjectMeta{Name: "low-1", Namespace: "default"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "low-2", Namespace: "default"}},
+			},
+			expectedSubstrings: []string{
+				"preemption would nominate node machine2, evicting: default/low-1, default/low-2",
+			},
+		},
+	}
+	for _, test := range tests {
+		message := formatSchedulingDiagnosis(fitError, test.node, test.victims)
+		for _, substring := range test.expectedSubstrings {
+			if !strings.Contains(message, substring) {
+				t.Errorf("%s: expected message to contain %q, got %q", test.name, substring, message)
+			}
+		}
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package factory
+
+import (
+	"fmt"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/scheduler/algorithm/predicates"
+	schedulernodeinfo "k8s.io/kubernetes/pkg/scheduler/nodeinfo"
+)
+
+// PodFitSimulator evaluates the registered fit predicates against a set of
+// nodes without running a full scheduler. It lets out-of-process components
+// such as deschedulers and capacity planners answer "would this pod fit and
+// where" using the same predicate implementations the scheduler uses.
+//
+// A simulator holds no caches; every call to Filter evaluates against the
+// node and pod state the caller passes in.
+type PodFitSimulator struct {
+	predicates       map[string]predicates.FitPredicate
+	metadataProducer predicates.PredicateMetadataProducer
+}
+
+// NewPodFitSimulator builds a simulator from the given registered predicate
+// keys. Mandatory predicates (for example node taint tolerance) are always
+// included, matching scheduler behavior. The args provide the listers the
+// predicate factories need; args fields unused by the selected predicates may
+// be left nil.
+func NewPodFitSimulator(predicateKeys sets.String, args PluginFactoryArgs) (*PodFitSimulator, error) {
+	predicateFuncs, err := getFitPredicateFunctions(predicateKeys, args)
+	if err != nil {
+		return nil, err
+	}
+	metadataProducer, err := getPredicateMetadataProducer(args)
+	if err != nil {
+		return nil, err
+	}
+	return &PodFitSimulator{
+		predicates:       predicateFuncs,
+		metadataProducer: metadataProducer,
+	}, nil
+}
+
+// NewPodFitSimulatorFromProvider builds a simulator with the predicate set of
+// a registered algorithm provider, e.g. factory.DefaultProvider.
+func NewPodFitSimulatorFromProvider(providerName string, args PluginFactoryArgs) (*PodFitSimulator, error) {
+	provider, err := GetAlgorithmProvider(providerName)
+	if err != nil {
+		return nil, err
+	}
+	return NewPodFitSimulator(provider.FitPredicateKeys, args)
+}
+
+// Filter evaluates all predicates for the pod against every node and returns
+// the names of the nodes the pod fits on, plus the failure reasons for each
+// node it does not fit on. The given pods describe the assumed cluster state;
+// pods with an empty NodeName are ignored.
+func (s *PodFitSimulator) Filter(pod *v1.Pod, nodes []*v1.Node, pods []*v1.Pod) ([]string, map[string][]predicates.PredicateFailureReason, error) {
+	nodeNameToInfo := schedulernodeinfo.CreateNodeNameToInfoMap(pods, nodes)
+	meta := s.metadataProducer(pod, nodeNameToInfo)
+
+	fits := []string{}
+	failures := map[string][]predicates.PredicateFailureReason{}
+	for _, node := range nodes {
+		nodeInfo, ok := nodeNameToInfo[node.Name]
+		if !ok {
+			return nil, nil, fmt.Errorf("no node info constructed for node %q", node.Name)
+		}
+		reasons, err := s.podFitsOnNode(pod, meta, nodeInfo)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(reasons) == 0 {
+			fits = append(fits, node.Name)
+		} else {
+			failures[node.Name] = reasons
+		}
+	}
+	return fits, failures, nil
+}
+
+// podFitsOnNode runs the simulator's predicates in the canonical predicate
+// order and collects all failure reasons instead of short-circuiting, so
+// callers get a complete picture per node.
+func (s *PodFitSimulator) podFitsOnNode(pod *v1.Pod, meta predicates.PredicateMetadata, nodeInfo *schedulernodeinfo.NodeInfo) ([]predicates.PredicateFailureReason, error) {
+	var failedPredicates []predicates.PredicateFailureReason
+	for _, predicateKey := range predicates.Ordering() {
+		predicate, exist := s.predicates[predicateKey]
+		if !exist {
+			continue
+		}
+		fit, reasons, err := predicate(pod, meta, nodeInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !fit {
+			failedPredicates = append(failedPredicates, reasons...)
+		}
+	}
+	return failedPredicates, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package factory
+
+import (
+	"testing"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/scheduler/algorithm/predicates"
+)
+
+func TestPodFitSimulatorFilter(t *testing.T) {
+	RegisterFitPredicate(predicates.HostNamePred, predicates.PodFitsHost)
+	RegisterFitPredicate(predicates.MatchNodeSelectorPred, predicates.PodMatchNodeSelector)
+	defer func() {
+		RemoveFitPredicate(predicates.HostNamePred)
+		RemoveFitPredicate(predicates.MatchNodeSelectorPred)
+	}()
+
+	simulator, err := NewPodFitSimulator(sets.NewString(predicates.HostNamePred, predicates.MatchNodeSelectorPred), PluginFactoryArgs{})
+	if err != nil {
+		t.Fatalf("unexpected error building simulator: %v", err)
+	}
+
+	nodes := []*v1.Node{
+		{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{"zone": "east"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "node-2", Labels: map[string]string{"zone": "west"}}},
+	}
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-pod"},
+		Spec: v1.PodSpec{
+			NodeSelector: map[string]string{"zone": "east"},
+		},
+	}
+
+	fits, failures, err := simulator.Filter(pod, nodes, nil)
+	if err != nil {
+		t.Fatalf("unexpected error from Filter: %v", err)
+	}
+	if len(fits) != 1 || fits[0] != "node-1" {
+		t.Errorf("expected the pod to fit only on node-1, got %v", fits)
+	}
+	reasons, ok := failures["node-2"]
+	if !ok || len(reasons) == 0 {
+		t.Fatalf("expected failure reasons for node-2, got %v", failures)
+	}
+	if reasons[0] != predicates.ErrNodeSelectorNotMatch {
+		t.Errorf("expected %v, got %v", predicates.ErrNodeSelectorNotMatch, reasons[0])
+	}
+
+	// A pod pinned to a node fails the host name predicate everywhere else.
+	pinned := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pinned-pod"},
+		Spec: v1.PodSpec{
+			NodeName:     "node-2",
+			NodeSelector: map[string]string{"zone": "west"},
+		},
+	}
+	fits, failures, err = simulator.Filter(pinned, nodes, nil)
+	if err != nil {
+		t.Fatalf("unexpected error from Filter: %v", err)
+	}
+	if len(fits) != 1 || fits[0] != "node-2" {
+		t.Errorf("expected the pinned pod to fit only on node-2, got %v", fits)
+	}
+	// Both predicates fail on node-1 and the simulator reports them all.
+	if len(failures["node-1"]) != 2 {
+		t.Errorf("expected both failure reasons for node-1, got %v", failures["node-1"])
+	}
+}
+
+func TestNewPodFitSimulatorUnknownPredicate(t *testing.T) {
+	if _, err := NewPodFitSimulator(sets.NewString("NoSuchPredicate"), PluginFactoryArgs{}); err == nil {
+		t.Errorf("expected an error for an unregistered predicate key")
+	}
+}
@@ -75,6 +75,9 @@ type schedulerCache struct {
 	nodeTree *NodeTree
 	// A map from image name to its imageState.
 	imageStates map[string]*imageState
+	// A map from the string form of an affinity term's label selector to its
+	// shared parsed state, reference counted by the cached pods using it.
+	selectorStates map[string]*selectorState
 }
 
 type podState struct {
@@ -106,11 +109,12 @@ func newSchedulerCache(ttl, period time.Duration, stop <-chan struct{}) *schedul
 		period: period,
 		stop:   stop,
 
-		nodes:       make(map[string]*nodeInfoListItem),
-		nodeTree:    newNodeTree(nil),
-		assumedPods: make(map[string]bool),
-		podStates:   make(map[string]*podState),
-		imageStates: make(map[string]*imageState),
+		nodes:          make(map[string]*nodeInfoListItem),
+		nodeTree:       newNodeTree(nil),
+		assumedPods:    make(map[string]bool),
+		podStates:      make(map[string]*podState),
+		imageStates:    make(map[string]*imageState),
+		selectorStates: make(map[string]*selectorState),
 	}
 }
 
@@ -353,6 +357,7 @@ func (cache *schedulerCache) addPod(pod *v1.Pod) {
 		cache.nodes[pod.Spec.NodeName] = n
 	}
 	n.info.AddPod(pod)
+	cache.addPodSelectorStates(pod)
 	cache.moveNodeInfoToHead(pod.Spec.NodeName)
 }
 
@@ -374,6 +379,7 @@ func (cache *schedulerCache) removePod(pod *v1.Pod) error {
 	if err := n.info.RemovePod(pod); err != nil {
 		return err
 	}
+	cache.removePodSelectorStates(pod)
 	if len(n.info.Pods()) == 0 && n.info.Node() == nil {
 		cache.removeNodeInfoFromList(pod.Spec.NodeName)
 	} else {
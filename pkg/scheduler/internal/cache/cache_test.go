@@ -1395,6 +1395,37 @@ func BenchmarkUpdate1kNodes30kPods(b *testing.B) {
 	}
 }
 
+// BenchmarkIncrementalUpdate1kNodes30kPods measures the steady-state cost of
+// refreshing a reused snapshot when only a handful of nodes changed since the
+// last scheduling cycle. Compare with BenchmarkUpdate1kNodes30kPods, which
+// rebuilds the snapshot from scratch each iteration.
+func BenchmarkIncrementalUpdate1kNodes30kPods(b *testing.B) {
+	// Enable volumesOnNodeForBalancing to do balanced resource allocation
+	defer featuregatetesting.SetFeatureGateDuringTest(nil, utilfeature.DefaultFeatureGate, features.BalanceAttachedNodeVolumes, true)()
+	cache := setupCacheOf1kNodes30kPods(b)
+	cachedNodes := NewNodeInfoSnapshot()
+	if err := cache.UpdateNodeInfoSnapshot(cachedNodes); err != nil {
+		b.Fatalf("UpdateNodeInfoSnapshot failed: %v", err)
+	}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		// Dirty a small fraction of the nodes, as a scheduling cycle would.
+		for i := 0; i < 10; i++ {
+			nodeName := fmt.Sprintf("node-%d", (n*10+i)%1000)
+			objName := fmt.Sprintf("%s-extra-pod-%d", nodeName, n)
+			pod := makeBasePod(b, nodeName, objName, "0", "0", "", nil)
+			if err := cache.AddPod(pod); err != nil {
+				b.Fatalf("AddPod failed: %v", err)
+			}
+		}
+		b.StartTimer()
+		if err := cache.UpdateNodeInfoSnapshot(cachedNodes); err != nil {
+			b.Fatalf("UpdateNodeInfoSnapshot failed: %v", err)
+		}
+	}
+}
+
 func BenchmarkExpirePods(b *testing.B) {
 	podNums := []int{
 		100,
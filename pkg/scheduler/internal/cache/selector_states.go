@@ -0,0 +1,121 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog"
+)
+
+// selectorState holds a parsed label selector shared by all cached pods whose
+// affinity terms use the same selector. Identical selectors are common when
+// many pods come from the same workload controller, so sharing the parsed
+// form avoids keeping one copy per pod at large cluster sizes.
+type selectorState struct {
+	selector labels.Selector
+	// refs is the number of affinity terms of cached pods referencing this selector.
+	refs int
+}
+
+// affinityTermSelectors returns the label selectors of all pod affinity and
+// anti-affinity terms of the given pod, both required and preferred.
+func affinityTermSelectors(pod *v1.Pod) []*metav1.LabelSelector {
+	affinity := pod.Spec.Affinity
+	if affinity == nil {
+		return nil
+	}
+	var selectors []*metav1.LabelSelector
+	appendTerms := func(terms []v1.PodAffinityTerm) {
+		for i := range terms {
+			if terms[i].LabelSelector != nil {
+				selectors = append(selectors, terms[i].LabelSelector)
+			}
+		}
+	}
+	appendWeightedTerms := func(terms []v1.WeightedPodAffinityTerm) {
+		for i := range terms {
+			if terms[i].PodAffinityTerm.LabelSelector != nil {
+				selectors = append(selectors, terms[i].PodAffinityTerm.LabelSelector)
+			}
+		}
+	}
+	if affinity.PodAffinity != nil {
+		appendTerms(affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+		appendWeightedTerms(affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
+	}
+	if affinity.PodAntiAffinity != nil {
+		appendTerms(affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+		appendWeightedTerms(affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
+	}
+	return selectors
+}
+
+// addPodSelectorStates interns the parsed selectors of the pod's affinity
+// terms in selectorStates, incrementing the reference count of entries that
+// already exist. Assumes that lock is already acquired.
+func (cache *schedulerCache) addPodSelectorStates(pod *v1.Pod) {
+	for _, labelSelector := range affinityTermSelectors(pod) {
+		key := labelSelector.String()
+		state, ok := cache.selectorStates[key]
+		if !ok {
+			selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+			if err != nil {
+				// Invalid selectors never match; predicates report the error
+				// when evaluating the term, so just skip interning here.
+				klog.V(5).Infof("Skipping invalid affinity term selector of pod %s/%s: %v", pod.Namespace, pod.Name, err)
+				continue
+			}
+			state = &selectorState{selector: selector}
+			cache.selectorStates[key] = state
+		}
+		state.refs++
+	}
+}
+
+// removePodSelectorStates decrements the reference counts taken by
+// addPodSelectorStates and drops entries that are no longer referenced by any
+// cached pod. Assumes that lock is already acquired.
+func (cache *schedulerCache) removePodSelectorStates(pod *v1.Pod) {
+	for _, labelSelector := range affinityTermSelectors(pod) {
+		key := labelSelector.String()
+		state, ok := cache.selectorStates[key]
+		if !ok {
+			continue
+		}
+		state.refs--
+		if state.refs <= 0 {
+			delete(cache.selectorStates, key)
+		}
+	}
+}
+
+// AffinitySelector returns the shared parsed selector for the given affinity
+// term selector, if a cached pod references it.
+func (cache *schedulerCache) AffinitySelector(labelSelector *metav1.LabelSelector) (labels.Selector, bool) {
+	if labelSelector == nil {
+		return nil, false
+	}
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+	state, ok := cache.selectorStates[labelSelector.String()]
+	if !ok {
+		return nil, false
+	}
+	return state.selector, true
+}
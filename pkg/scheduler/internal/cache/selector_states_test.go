@@ -0,0 +1,152 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func makePodWithAffinitySelector(name, nodeName string, labelSelector *metav1.LabelSelector) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "node_info_cache_test",
+			Name:      name,
+			UID:       types.UID(name),
+		},
+		Spec: v1.PodSpec{
+			NodeName: nodeName,
+			Affinity: &v1.Affinity{
+				PodAntiAffinity: &v1.PodAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{
+						{
+							LabelSelector: labelSelector,
+							TopologyKey:   "kubernetes.io/hostname",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestPodSelectorStates tests that pods sharing the same affinity term
+// selector share one parsed selector entry, and that entries are dropped once
+// no cached pod references them.
+func TestPodSelectorStates(t *testing.T) {
+	labelSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+	pod1 := makePodWithAffinitySelector("test-1", "node-1", labelSelector)
+	pod2 := makePodWithAffinitySelector("test-2", "node-1", labelSelector.DeepCopy())
+
+	cache := newSchedulerCache(time.Second, time.Second, nil)
+	if err := cache.AddPod(pod1); err != nil {
+		t.Fatalf("AddPod failed: %v", err)
+	}
+	if err := cache.AddPod(pod2); err != nil {
+		t.Fatalf("AddPod failed: %v", err)
+	}
+
+	if len(cache.selectorStates) != 1 {
+		t.Fatalf("expected a single interned selector, got %d", len(cache.selectorStates))
+	}
+	state := cache.selectorStates[labelSelector.String()]
+	if state == nil || state.refs != 2 {
+		t.Fatalf("expected 2 references to the interned selector, got %#v", state)
+	}
+
+	selector, ok := cache.AffinitySelector(labelSelector)
+	if !ok {
+		t.Fatal("expected the interned selector to be found")
+	}
+	if !selector.Matches(map[string]string{"app": "web"}) {
+		t.Errorf("interned selector %v does not match the term's labels", selector)
+	}
+
+	if err := cache.RemovePod(pod1); err != nil {
+		t.Fatalf("RemovePod failed: %v", err)
+	}
+	if state.refs != 1 {
+		t.Fatalf("expected 1 reference after removing a pod, got %d", state.refs)
+	}
+	if err := cache.RemovePod(pod2); err != nil {
+		t.Fatalf("RemovePod failed: %v", err)
+	}
+	if len(cache.selectorStates) != 0 {
+		t.Fatalf("expected no interned selectors after removing all pods, got %d", len(cache.selectorStates))
+	}
+	if _, ok := cache.AffinitySelector(labelSelector); ok {
+		t.Error("expected the interned selector to be gone")
+	}
+}
+
+// TestPodSelectorStatesInvalidSelector tests that invalid selectors are
+// skipped without leaving entries behind.
+func TestPodSelectorStatesInvalidSelector(t *testing.T) {
+	labelSelector := &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "app", Operator: "BadOperator", Values: []string{"web"}},
+		},
+	}
+	pod := makePodWithAffinitySelector("test-1", "node-1", labelSelector)
+
+	cache := newSchedulerCache(time.Second, time.Second, nil)
+	if err := cache.AddPod(pod); err != nil {
+		t.Fatalf("AddPod failed: %v", err)
+	}
+	if len(cache.selectorStates) != 0 {
+		t.Fatalf("expected no interned selectors for an invalid selector, got %d", len(cache.selectorStates))
+	}
+	if err := cache.RemovePod(pod); err != nil {
+		t.Fatalf("RemovePod failed: %v", err)
+	}
+}
+
+// BenchmarkSelectorStates compares looking up the shared parsed selector
+// against re-parsing the term's selector for every pod, which is what callers
+// without the interning table have to do.
+func BenchmarkSelectorStates(b *testing.B) {
+	labelSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+	cache := newSchedulerCache(time.Second, time.Second, nil)
+	for i := 0; i < 1000; i++ {
+		pod := makePodWithAffinitySelector(fmt.Sprintf("test-%d", i), "node-1", labelSelector.DeepCopy())
+		if err := cache.AddPod(pod); err != nil {
+			b.Fatalf("AddPod failed: %v", err)
+		}
+	}
+
+	b.Run("parse-per-pod", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := metav1.LabelSelectorAsSelector(labelSelector); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("interned", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, ok := cache.AffinitySelector(labelSelector); !ok {
+				b.Fatal("interned selector not found")
+			}
+		}
+	})
+}
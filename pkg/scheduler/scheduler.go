@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sync"
 	"time"
 
 	"k8s.io/klog"
@@ -34,14 +35,17 @@ import (
 	storageinformers "k8s.io/client-go/informers/storage/v1"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/scheduler/algorithm/priorities"
 	schedulerapi "k8s.io/kubernetes/pkg/scheduler/api"
 	latestschedulerapi "k8s.io/kubernetes/pkg/scheduler/api/latest"
 	kubeschedulerconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
 	"k8s.io/kubernetes/pkg/scheduler/core"
+	"k8s.io/kubernetes/pkg/scheduler/decisionlog"
 	"k8s.io/kubernetes/pkg/scheduler/factory"
 	framework "k8s.io/kubernetes/pkg/scheduler/framework/v1alpha1"
 	internalcache "k8s.io/kubernetes/pkg/scheduler/internal/cache"
 	"k8s.io/kubernetes/pkg/scheduler/metrics"
+	schedulernodeinfo "k8s.io/kubernetes/pkg/scheduler/nodeinfo"
 	"k8s.io/kubernetes/pkg/scheduler/util"
 )
 
@@ -56,6 +60,17 @@ const (
 // nodes that they fit on and writes bindings back to the api server.
 type Scheduler struct {
 	config *factory.Config
+
+	// decisionLogger, when non-nil, records every scheduling outcome for
+	// offline analysis.
+	decisionLogger *decisionlog.Logger
+
+	// termMu guards termStopCh.
+	termMu sync.Mutex
+	// termStopCh, when non-nil, stops the scheduling loop started by the
+	// most recent call to Run. It is closed by StopScheduling so a demoted
+	// leader can halt scheduling without tearing down the shared informers.
+	termStopCh chan struct{}
 }
 
 // Cache returns the cache in scheduler for test to check the data in scheduler.
@@ -69,6 +84,8 @@ type schedulerOptions struct {
 	disablePreemption              bool
 	percentageOfNodesToScore       int32
 	bindTimeoutSeconds             int64
+	decisionLogFile                string
+	decisionLogSampleRate          int32
 }
 
 // Option configures a Scheduler
@@ -109,6 +126,16 @@ func WithBindTimeoutSeconds(bindTimeoutSeconds int64) Option {
 	}
 }
 
+// WithDecisionLog enables the scheduling decision log, appending to the file
+// at decisionLogFile and logging every decisionLogSampleRate-th decision. An
+// empty file path leaves the decision log disabled.
+func WithDecisionLog(decisionLogFile string, decisionLogSampleRate int32) Option {
+	return func(o *schedulerOptions) {
+		o.decisionLogFile = decisionLogFile
+		o.decisionLogSampleRate = decisionLogSampleRate
+	}
+}
+
 var defaultSchedulerOptions = schedulerOptions{
 	schedulerName:                  v1.DefaultSchedulerName,
 	hardPodAffinitySymmetricWeight: v1.DefaultHardPodAffinitySymmetricWeight,
@@ -202,6 +229,14 @@ func New(client clientset.Interface,
 	// Create the scheduler.
 	sched := NewFromConfig(config)
 
+	if options.decisionLogFile != "" {
+		decisionLogger, err := decisionlog.New(options.decisionLogFile, options.decisionLogSampleRate)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't open scheduling decision log %q: %v", options.decisionLogFile, err)
+		}
+		sched.decisionLogger = decisionLogger
+	}
+
 	AddAllEventHandlers(sched, options.schedulerName, nodeInformer, podInformer, pvInformer, pvcInformer, serviceInformer, storageClassInformer)
 	return sched, nil
 }
@@ -256,7 +291,54 @@ func (sched *Scheduler) Run() {
 		return
 	}
 
-	go wait.Until(sched.scheduleOne, 0, sched.config.StopEverything)
+	sched.termMu.Lock()
+	termStopCh := make(chan struct{})
+	sched.termStopCh = termStopCh
+	sched.termMu.Unlock()
+
+	// The loop stops either when the whole scheduler shuts down or when
+	// StopScheduling ends the current leadership term.
+	loopStopCh := make(chan struct{})
+	go func() {
+		defer close(loopStopCh)
+		select {
+		case <-sched.config.StopEverything:
+		case <-termStopCh:
+		}
+	}()
+
+	go wait.Until(sched.scheduleOne, 0, loopStopCh)
+}
+
+// StopScheduling performs an ordered shutdown of the current leadership term.
+// It stops the scheduling loop started by Run, forgets all assumed pods so
+// that stale assumptions cannot leak into a later term, and resets the
+// metrics that are only meaningful within a single term. The shared informers
+// and the scheduling queue keep running, so a caller may re-acquire the
+// leader lease and call Run again. A scheduling cycle that is already in
+// flight finishes normally; only new pods stop being popped.
+func (sched *Scheduler) StopScheduling() {
+	sched.termMu.Lock()
+	if sched.termStopCh != nil {
+		close(sched.termStopCh)
+		sched.termStopCh = nil
+	}
+	sched.termMu.Unlock()
+
+	snapshot := sched.config.SchedulerCache.Snapshot()
+	for _, nodeInfo := range snapshot.Nodes {
+		for _, pod := range nodeInfo.Pods() {
+			key, err := schedulernodeinfo.GetPodKey(pod)
+			if err != nil || !snapshot.AssumedPods[key] {
+				continue
+			}
+			if err := sched.config.SchedulerCache.ForgetPod(pod); err != nil {
+				klog.Errorf("scheduler cache ForgetPod failed while releasing assumed pods: %v", err)
+			}
+		}
+	}
+
+	metrics.Reset()
 }
 
 // Config returns scheduler's config pointer. It is exposed for testing purposes.
@@ -264,6 +346,18 @@ func (sched *Scheduler) Config() *factory.Config {
 	return sched.config
 }
 
+// SetPercentageOfNodesToScore updates the feasible-node sample size at
+// runtime. It returns false if the configured algorithm does not support
+// runtime adjustment.
+func (sched *Scheduler) SetPercentageOfNodesToScore(percentage int32) bool {
+	setter, ok := sched.config.Algorithm.(core.PercentageOfNodesToScoreSetter)
+	if !ok {
+		return false
+	}
+	setter.SetPercentageOfNodesToScore(percentage)
+	return true
+}
+
 // recordFailedSchedulingEvent records an event for the pod that indicates the
 // pod has failed to schedule.
 // NOTE: This function modifies "pod". "pod" should be copied before being passed.
@@ -367,12 +461,6 @@ func (sched *Scheduler) bindVolumes(assumed *v1.Pod) error {
 	err := sched.config.VolumeBinder.Binder.BindPodVolumes(assumed)
 	if err != nil {
 		klog.V(1).Infof("Failed to bind volumes for pod \"%v/%v\": %v", assumed.Namespace, assumed.Name, err)
-
-		// Unassume the Pod and retry scheduling
-		if forgetErr := sched.config.SchedulerCache.ForgetPod(assumed); forgetErr != nil {
-			klog.Errorf("scheduler cache ForgetPod failed: %v", forgetErr)
-		}
-
 		sched.recordSchedulingFailure(assumed, err, "VolumeBindingFailed", err.Error())
 		return err
 	}
@@ -410,6 +498,21 @@ func (sched *Scheduler) assume(assumed *v1.Pod, host string) error {
 	return nil
 }
 
+// unreserve rolls back everything that was reserved for an assumed pod after a
+// failure anywhere in the binding flow: it runs the "unreserve" plugins,
+// deletes the pod's cached volume bindings and, if forgetAssumed is true,
+// forgets the pod in the scheduler cache. Keeping the rollback in one place
+// guarantees that no failure path leaks assumed resources.
+func (sched *Scheduler) unreserve(pluginContext *framework.PluginContext, assumed *v1.Pod, host string, forgetAssumed bool) {
+	sched.config.Framework.RunUnreservePlugins(pluginContext, assumed, host)
+	sched.config.VolumeBinder.DeletePodBindings(assumed)
+	if forgetAssumed {
+		if forgetErr := sched.config.SchedulerCache.ForgetPod(assumed); forgetErr != nil {
+			klog.Errorf("scheduler cache ForgetPod failed: %v", forgetErr)
+		}
+	}
+}
+
 // bind binds a pod to a given node defined in a binding object.  We expect this to run asynchronously, so we
 // handle binding metrics internally.
 func (sched *Scheduler) bind(assumed *v1.Pod, b *v1.Binding) error {
@@ -422,14 +525,15 @@ func (sched *Scheduler) bind(assumed *v1.Pod, b *v1.Binding) error {
 	}
 	if err != nil {
 		klog.V(1).Infof("Failed to bind pod: %v/%v", assumed.Namespace, assumed.Name)
-		if err := sched.config.SchedulerCache.ForgetPod(assumed); err != nil {
-			klog.Errorf("scheduler cache ForgetPod failed: %v", err)
-		}
 		sched.recordSchedulingFailure(assumed, err, SchedulerError,
 			fmt.Sprintf("Binding rejected: %v", err))
 		return err
 	}
 
+	// Feed the hotspot priority so that follow-up scheduling cycles spread
+	// placement bursts away from this node.
+	priorities.TheRecentBindingTracker.Record(b.Target.Name)
+
 	metrics.BindingLatency.Observe(metrics.SinceInSeconds(bindingStart))
 	metrics.DeprecatedBindingLatency.Observe(metrics.SinceInMicroseconds(bindingStart))
 	metrics.SchedulingLatency.WithLabelValues(metrics.Binding).Observe(metrics.SinceInSeconds(bindingStart))
@@ -438,6 +542,29 @@ func (sched *Scheduler) bind(assumed *v1.Pod, b *v1.Binding) error {
 	return nil
 }
 
+// logDecision records the outcome of a scheduling attempt in the decision
+// log, if one is configured.
+func (sched *Scheduler) logDecision(pod *v1.Pod, result core.ScheduleResult, outcome, reason string, start time.Time) {
+	if sched.decisionLogger == nil {
+		return
+	}
+	decision := &decisionlog.Decision{
+		Time:                 time.Now(),
+		Pod:                  pod.Namespace + "/" + pod.Name,
+		UID:                  string(pod.UID),
+		Outcome:              outcome,
+		Node:                 result.SuggestedHost,
+		Reason:               reason,
+		EvaluatedNodes:       result.EvaluatedNodes,
+		FeasibleNodes:        result.FeasibleNodes,
+		DurationMicroseconds: time.Since(start).Nanoseconds() / int64(time.Microsecond),
+	}
+	for _, hostPriority := range result.Scores {
+		decision.Scores = append(decision.Scores, decisionlog.NodeScore{Node: hostPriority.Host, Score: hostPriority.Score})
+	}
+	sched.decisionLogger.Log(decision)
+}
+
 // scheduleOne does the entire scheduling workflow for a single pod.  It is serialized on the scheduling algorithm's host fitting.
 func (sched *Scheduler) scheduleOne() {
 	fwk := sched.config.Framework
@@ -465,6 +592,7 @@ func (sched *Scheduler) scheduleOne() {
 		// will fit due to the preemption. It is also possible that a different pod will schedule
 		// into the resources that were preempted, but this is harmless.
 		if fitError, ok := err.(*core.FitError); ok {
+			sched.diagnoseSchedulingFailure(pod, fitError)
 			if !util.PodPriorityEnabled() || sched.config.DisablePreemption {
 				klog.V(3).Infof("Pod priority feature is not enabled or preemption is disabled by scheduler configuration." +
 					" No preemption is performed.")
@@ -481,14 +609,17 @@ func (sched *Scheduler) scheduleOne() {
 			// succeeds, the pod should get counted as a success the next time we try to
 			// schedule it. (hopefully)
 			metrics.PodScheduleFailures.Inc()
+			sched.logDecision(pod, scheduleResult, decisionlog.OutcomeUnschedulable, err.Error(), start)
 		} else {
 			klog.Errorf("error selecting node for pod: %v", err)
 			metrics.PodScheduleErrors.Inc()
+			sched.logDecision(pod, scheduleResult, decisionlog.OutcomeError, err.Error(), start)
 		}
 		return
 	}
 	metrics.SchedulingAlgorithmLatency.Observe(metrics.SinceInSeconds(start))
 	metrics.DeprecatedSchedulingAlgorithmLatency.Observe(metrics.SinceInMicroseconds(start))
+	sched.logDecision(pod, scheduleResult, decisionlog.OutcomeScheduled, "", start)
 	// Tell the cache to assume that a pod now is running on a given node, even though it hasn't been bound yet.
 	// This allows us to keep scheduling without waiting on binding to occur.
 	assumedPod := pod.DeepCopy()
@@ -504,6 +635,7 @@ func (sched *Scheduler) scheduleOne() {
 	if err != nil {
 		klog.Errorf("error assuming volumes: %v", err)
 		metrics.PodScheduleErrors.Inc()
+		sched.unreserve(pluginContext, assumedPod, scheduleResult.SuggestedHost, false)
 		return
 	}
 
@@ -511,6 +643,8 @@ func (sched *Scheduler) scheduleOne() {
 	if sts := fwk.RunReservePlugins(pluginContext, assumedPod, scheduleResult.SuggestedHost); !sts.IsSuccess() {
 		sched.recordSchedulingFailure(assumedPod, sts.AsError(), SchedulerError, sts.Message())
 		metrics.PodScheduleErrors.Inc()
+		// roll back the assumed volumes along with any partially reserved plugin state
+		sched.unreserve(pluginContext, assumedPod, scheduleResult.SuggestedHost, false)
 		return
 	}
 
@@ -519,8 +653,7 @@ func (sched *Scheduler) scheduleOne() {
 	if err != nil {
 		klog.Errorf("error assuming pod: %v", err)
 		metrics.PodScheduleErrors.Inc()
-		// trigger un-reserve plugins to clean up state associated with the reserved Pod
-		fwk.RunUnreservePlugins(pluginContext, assumedPod, scheduleResult.SuggestedHost)
+		sched.unreserve(pluginContext, assumedPod, scheduleResult.SuggestedHost, false)
 		return
 	}
 	// bind the pod to its host asynchronously (we can do this b/c of the assumption step above).
@@ -531,8 +664,7 @@ func (sched *Scheduler) scheduleOne() {
 			if err != nil {
 				klog.Errorf("error binding volumes: %v", err)
 				metrics.PodScheduleErrors.Inc()
-				// trigger un-reserve plugins to clean up state associated with the reserved Pod
-				fwk.RunUnreservePlugins(pluginContext, assumedPod, scheduleResult.SuggestedHost)
+				sched.unreserve(pluginContext, assumedPod, scheduleResult.SuggestedHost, true)
 				return
 			}
 		}
@@ -547,12 +679,8 @@ func (sched *Scheduler) scheduleOne() {
 				metrics.PodScheduleErrors.Inc()
 				reason = SchedulerError
 			}
-			if forgetErr := sched.Cache().ForgetPod(assumedPod); forgetErr != nil {
-				klog.Errorf("scheduler cache ForgetPod failed: %v", forgetErr)
-			}
 			sched.recordSchedulingFailure(assumedPod, permitStatus.AsError(), reason, permitStatus.Message())
-			// trigger un-reserve plugins to clean up state associated with the reserved Pod
-			fwk.RunUnreservePlugins(pluginContext, assumedPod, scheduleResult.SuggestedHost)
+			sched.unreserve(pluginContext, assumedPod, scheduleResult.SuggestedHost, true)
 			return
 		}
 
@@ -566,12 +694,8 @@ func (sched *Scheduler) scheduleOne() {
 				metrics.PodScheduleErrors.Inc()
 				reason = SchedulerError
 			}
-			if forgetErr := sched.Cache().ForgetPod(assumedPod); forgetErr != nil {
-				klog.Errorf("scheduler cache ForgetPod failed: %v", forgetErr)
-			}
 			sched.recordSchedulingFailure(assumedPod, prebindStatus.AsError(), reason, prebindStatus.Message())
-			// trigger un-reserve plugins to clean up state associated with the reserved Pod
-			fwk.RunUnreservePlugins(pluginContext, assumedPod, scheduleResult.SuggestedHost)
+			sched.unreserve(pluginContext, assumedPod, scheduleResult.SuggestedHost, true)
 			return
 		}
 
@@ -587,8 +711,7 @@ func (sched *Scheduler) scheduleOne() {
 		if err != nil {
 			klog.Errorf("error binding pod: %v", err)
 			metrics.PodScheduleErrors.Inc()
-			// trigger un-reserve plugins to clean up state associated with the reserved Pod
-			fwk.RunUnreservePlugins(pluginContext, assumedPod, scheduleResult.SuggestedHost)
+			sched.unreserve(pluginContext, assumedPod, scheduleResult.SuggestedHost, true)
 		} else {
 			klog.V(2).Infof("pod %v/%v is bound successfully on node %v, %d nodes evaluated, %d nodes were found feasible", assumedPod.Namespace, assumedPod.Name, scheduleResult.SuggestedHost, scheduleResult.EvaluatedNodes, scheduleResult.FeasibleNodes)
 			metrics.PodScheduleSuccesses.Inc()
@@ -1039,3 +1039,31 @@ priorities:
 		}
 	}
 }
+
+func TestStopSchedulingReleasesAssumedPods(t *testing.T) {
+	stop := make(chan struct{})
+	defer close(stop)
+	scache := internalcache.New(10*time.Minute, stop)
+	scache.AddNode(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "machine1"}})
+
+	assumed := podWithID("foo", "machine1")
+	if err := scache.AssumePod(assumed); err != nil {
+		t.Fatalf("AssumePod failed: %v", err)
+	}
+
+	sched := NewFromConfig(&factory.Config{
+		SchedulerCache:   scache,
+		StopEverything:   stop,
+		WaitForCacheSync: func() bool { return true },
+	})
+
+	sched.StopScheduling()
+
+	snapshot := scache.Snapshot()
+	if len(snapshot.AssumedPods) != 0 {
+		t.Errorf("expected no assumed pods after demotion, got %v", snapshot.AssumedPods)
+	}
+	if n := snapshot.Nodes["machine1"]; n != nil && len(n.Pods()) != 0 {
+		t.Errorf("expected the assumed pod to be forgotten from its node, got %d pods", len(n.Pods()))
+	}
+}
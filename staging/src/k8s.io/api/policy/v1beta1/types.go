@@ -77,6 +77,51 @@ type PodDisruptionBudgetStatus struct {
 
 	// total number of pods counted by this disruption budget
 	ExpectedPods int32 `json:"expectedPods" protobuf:"varint,6,opt,name=expectedPods"`
+
+	// Conditions contains conditions for the PDB. The disruption controller
+	// sets the DisruptionAllowed condition, whose reason and message explain
+	// why disruptions are currently blocked.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []PodDisruptionBudgetCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,7,rep,name=conditions"`
+}
+
+// PodDisruptionBudgetConditionType is a valid value for the type of a PodDisruptionBudgetCondition.
+type PodDisruptionBudgetConditionType string
+
+const (
+	// DisruptionAllowed indicates whether the PDB currently allows any disruption.
+	// When it is false, the reason and message explain what is blocking evictions.
+	DisruptionAllowed PodDisruptionBudgetConditionType = "DisruptionAllowed"
+)
+
+// Reasons for PDB conditions.
+const (
+	// SyncFailedReason indicates the controller was unable to compute the
+	// number of allowed disruptions, so no disruptions are allowed.
+	SyncFailedReason = "SyncFailed"
+	// SufficientPodsReason means enough pods are healthy to allow disruptions.
+	SufficientPodsReason = "SufficientPods"
+	// InsufficientPodsReason means too few pods are healthy to allow disruptions.
+	InsufficientPodsReason = "InsufficientHealthyPods"
+)
+
+// PodDisruptionBudgetCondition describes the state of a PodDisruptionBudget at a certain point.
+type PodDisruptionBudgetCondition struct {
+	// Type of PodDisruptionBudget condition.
+	Type PodDisruptionBudgetConditionType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=PodDisruptionBudgetConditionType"`
+	// Status of the condition, one of True, False, Unknown.
+	Status v1.ConditionStatus `json:"status" protobuf:"bytes,2,opt,name=status,casttype=k8s.io/api/core/v1.ConditionStatus"`
+	// Last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
+	// The reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,4,opt,name=reason"`
+	// A human readable message indicating details about the transition.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
 }
 
 // +genclient
@@ -173,3 +173,20 @@ func (p configProvider) ConfigFor(pluginName string) (io.Reader, error) {
 	// there is no registered config that matches on plugin name.
 	return nil, nil
 }
+
+// PluginOrder returns the explicit plugin order declared in the admission
+// configuration, or nil when the configuration does not declare one.
+func (p configProvider) PluginOrder() []string {
+	return p.config.PluginOrder
+}
+
+// ConfiguredPlugins returns the names of the plugins the admission
+// configuration carries configuration sections for, so callers can reject
+// configuration for plugins that are not registered.
+func (p configProvider) ConfiguredPlugins() []string {
+	names := make([]string, 0, len(p.config.Plugins))
+	for _, plugin := range p.config.Plugins {
+		names = append(names, plugin.Name)
+	}
+	return names
+}
@@ -105,6 +105,7 @@ type AdmissionMetrics struct {
 	step       *metricSet
 	controller *metricSet
 	webhook    *metricSet
+	rejections *prometheus.CounterVec
 }
 
 // newAdmissionMetrics create a new AdmissionMetrics, configured with default metric names.
@@ -125,16 +126,31 @@ func newAdmissionMetrics() *AdmissionMetrics {
 		[]string{"name", "type", "operation", "rejected"},
 		"Admission webhook %s, identified by name and broken out for each operation and API resource and type (validate or admit).", false)
 
+	// Rejections per plugin. The latency histograms above carry a "rejected"
+	// label too, but a plain counter keyed by resource is much cheaper to
+	// query when tracking down which plugin is rejecting what.
+	rejections := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "rejections_total",
+			Help:      "Admission rejections, identified by plugin name and broken out for each operation, API resource and type (validate or admit).",
+		},
+		[]string{"name", "type", "operation", "resource"},
+	)
+
 	step.mustRegister()
 	controller.mustRegister()
 	webhook.mustRegister()
-	return &AdmissionMetrics{step: step, controller: controller, webhook: webhook}
+	prometheus.MustRegister(rejections)
+	return &AdmissionMetrics{step: step, controller: controller, webhook: webhook, rejections: rejections}
 }
 
 func (m *AdmissionMetrics) reset() {
 	m.step.reset()
 	m.controller.reset()
 	m.webhook.reset()
+	m.rejections.Reset()
 }
 
 // ObserveAdmissionStep records admission related metrics for a admission step, identified by step type.
@@ -145,11 +161,22 @@ func (m *AdmissionMetrics) ObserveAdmissionStep(elapsed time.Duration, rejected
 // ObserveAdmissionController records admission related metrics for a built-in admission controller, identified by it's plugin handler name.
 func (m *AdmissionMetrics) ObserveAdmissionController(elapsed time.Duration, rejected bool, attr admission.Attributes, stepType string, extraLabels ...string) {
 	m.controller.observe(elapsed, append(extraLabels, stepType, string(attr.GetOperation()), strconv.FormatBool(rejected))...)
+	m.observeRejection(rejected, attr, stepType, extraLabels...)
 }
 
 // ObserveWebhook records admission related metrics for a admission webhook.
 func (m *AdmissionMetrics) ObserveWebhook(elapsed time.Duration, rejected bool, attr admission.Attributes, stepType string, extraLabels ...string) {
 	m.webhook.observe(elapsed, append(extraLabels, stepType, string(attr.GetOperation()), strconv.FormatBool(rejected))...)
+	m.observeRejection(rejected, attr, stepType, extraLabels...)
+}
+
+// observeRejection counts a rejection for the plugin named by the first extra
+// label, if the observation was a rejection at all.
+func (m *AdmissionMetrics) observeRejection(rejected bool, attr admission.Attributes, stepType string, extraLabels ...string) {
+	if !rejected || len(extraLabels) == 0 {
+		return
+	}
+	m.rejections.WithLabelValues(extraLabels[0], stepType, string(attr.GetOperation()), attr.GetResource().Resource).Inc()
 }
 
 type metricSet struct {
@@ -243,3 +243,33 @@ func (h *mutatingFakeHandler) Admit(a admission.Attributes, o admission.ObjectIn
 	}
 	return fmt.Errorf("don't admit")
 }
+
+func TestObserveRejection(t *testing.T) {
+	Metrics.reset()
+	handler := WithControllerMetrics(&mutatingAndValidatingFakeHandler{admission.NewHandler(admission.Create), false, true}, "a")
+	handler.(admission.MutationInterface).Admit(attr, nil)
+	handler.(admission.ValidationInterface).Validate(attr, nil)
+	wantLabels := map[string]string{
+		"name":      "a",
+		"operation": string(admission.Create),
+		"type":      "admit",
+		"resource":  "resource",
+	}
+	expectCounterValue(t, "apiserver_admission_rejections_total", wantLabels, 1)
+
+	// The successful validation must not count as a rejection.
+	wantLabels["type"] = "validate"
+	expectCounterValue(t, "apiserver_admission_rejections_total", wantLabels, 0)
+}
+
+func TestObserveWebhookRejection(t *testing.T) {
+	Metrics.reset()
+	Metrics.ObserveWebhook(2*time.Second, true, attr, stepAdmit, "x")
+	wantLabels := map[string]string{
+		"name":      "x",
+		"operation": string(admission.Create),
+		"type":      "admit",
+		"resource":  "resource",
+	}
+	expectCounterValue(t, "apiserver_admission_rejections_total", wantLabels, 1)
+}
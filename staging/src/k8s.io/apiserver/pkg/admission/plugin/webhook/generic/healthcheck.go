@@ -0,0 +1,66 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generic
+
+import (
+	"fmt"
+	"net/http"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apiserver/pkg/server/healthz"
+)
+
+// HealthzCheck returns a healthz checker that reports whether the services
+// referenced by the configured admission webhooks have ready endpoints. The
+// check never sends admission traffic; webhooks addressed by URL rather than
+// by a service reference cannot be resolved through the cluster and are
+// skipped.
+func (a *Webhook) HealthzCheck(name string) healthz.HealthzChecker {
+	return healthz.NamedCheck(name, func(r *http.Request) error {
+		if a.hookSource == nil || a.endpointsLister == nil {
+			// The plugin has not been initialized with an informer factory
+			// yet; there is nothing to check.
+			return nil
+		}
+		if !a.hookSource.HasSynced() {
+			return fmt.Errorf("webhook configurations have not been synced")
+		}
+		var errs []error
+		for _, hook := range a.hookSource.Webhooks() {
+			svc := hook.ClientConfig.Service
+			if svc == nil {
+				continue
+			}
+			endpoints, err := a.endpointsLister.Endpoints(svc.Namespace).Get(svc.Name)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("webhook %q: %v", hook.Name, err))
+				continue
+			}
+			ready := false
+			for _, subset := range endpoints.Subsets {
+				if len(subset.Addresses) > 0 {
+					ready = true
+					break
+				}
+			}
+			if !ready {
+				errs = append(errs, fmt.Errorf("webhook %q: service %s/%s has no ready endpoints", hook.Name, svc.Namespace, svc.Name))
+			}
+		}
+		return utilerrors.NewAggregate(errs)
+	})
+}
@@ -21,6 +21,7 @@ import (
 
 	"k8s.io/api/admissionregistration/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apiserver/pkg/admission"
 )
 
@@ -36,6 +37,17 @@ type VersionedAttributes struct {
 	admission.Attributes
 	VersionedOldObject runtime.Object
 	VersionedObject    runtime.Object
+	// VersionedKind is the group/version/kind the versioned object and old object
+	// were converted to. It equals the attributes' kind unless a webhook with
+	// matchPolicy Equivalent matched via another API group or version.
+	VersionedKind schema.GroupVersionKind
+}
+
+// WebhookInvocation describes how a webhook should be called for a given request,
+// including the kind the request object must be converted to before dispatch.
+type WebhookInvocation struct {
+	Webhook *v1beta1.Webhook
+	Kind    schema.GroupVersionKind
 }
 
 // Dispatcher dispatches webhook call to a list of webhooks with admission attributes as argument.
@@ -24,14 +24,17 @@ import (
 	admissionv1beta1 "k8s.io/api/admission/v1beta1"
 	"k8s.io/api/admissionregistration/v1beta1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apiserver/pkg/admission"
 	genericadmissioninit "k8s.io/apiserver/pkg/admission/initializer"
 	"k8s.io/apiserver/pkg/admission/plugin/webhook/config"
 	"k8s.io/apiserver/pkg/admission/plugin/webhook/namespace"
+	"k8s.io/apiserver/pkg/admission/plugin/webhook/object"
 	"k8s.io/apiserver/pkg/admission/plugin/webhook/rules"
 	"k8s.io/apiserver/pkg/util/webhook"
 	"k8s.io/client-go/informers"
 	clientset "k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
 )
 
 // Webhook is an abstract admission plugin with all the infrastructure to define Admit or Validate on-top.
@@ -43,7 +46,9 @@ type Webhook struct {
 	hookSource       Source
 	clientManager    *webhook.ClientManager
 	namespaceMatcher *namespace.Matcher
+	objectMatcher    *object.Matcher
 	dispatcher       Dispatcher
+	endpointsLister  corelisters.EndpointsLister
 }
 
 var (
@@ -78,6 +83,7 @@ func NewWebhook(handler *admission.Handler, configFile io.Reader, sourceFactory
 		sourceFactory:    sourceFactory,
 		clientManager:    &cm,
 		namespaceMatcher: &namespace.Matcher{},
+		objectMatcher:    &object.Matcher{},
 		dispatcher:       dispatcherFactory(&cm),
 	}, nil
 }
@@ -105,6 +111,7 @@ func (a *Webhook) SetExternalKubeClientSet(client clientset.Interface) {
 func (a *Webhook) SetExternalKubeInformerFactory(f informers.SharedInformerFactory) {
 	namespaceInformer := f.Core().V1().Namespaces()
 	a.namespaceMatcher.NamespaceLister = namespaceInformer.Lister()
+	a.endpointsLister = f.Core().V1().Endpoints().Lister()
 	a.hookSource = a.sourceFactory(f)
 	a.SetReadyFunc(func() bool {
 		return namespaceInformer.Informer().HasSynced() && a.hookSource.HasSynced()
@@ -126,22 +133,82 @@ func (a *Webhook) ValidateInitialization() error {
 }
 
 // ShouldCallHook makes a decision on whether to call the webhook or not by the attribute.
-func (a *Webhook) ShouldCallHook(h *v1beta1.Webhook, attr admission.Attributes) (bool, *apierrors.StatusError) {
-	var matches bool
+// A non-nil WebhookInvocation means the hook should be called; its Kind is the
+// group/version/kind the request object must be converted to before dispatch.
+func (a *Webhook) ShouldCallHook(h *v1beta1.Webhook, attr admission.Attributes, o admission.ObjectInterfaces) (*WebhookInvocation, *apierrors.StatusError) {
+	var invocation *WebhookInvocation
 	for _, r := range h.Rules {
 		m := rules.Matcher{Rule: r, Attr: attr}
 		if m.Matches() {
-			matches = true
+			invocation = &WebhookInvocation{Webhook: h, Kind: attr.GetKind()}
 			break
 		}
 	}
-	if !matches {
-		return false, nil
+	if invocation == nil && h.MatchPolicy != nil && *h.MatchPolicy == v1beta1.Equivalent {
+		if kind, ok := a.equivalentKind(h, attr, o); ok {
+			invocation = &WebhookInvocation{Webhook: h, Kind: kind}
+		}
+	}
+	if invocation == nil {
+		return nil, nil
+	}
+
+	matches, err := a.namespaceMatcher.MatchNamespaceSelector(h, attr)
+	if !matches || err != nil {
+		return nil, err
+	}
+
+	matches, err = a.objectMatcher.MatchObjectSelector(h, attr)
+	if !matches || err != nil {
+		return nil, err
+	}
+	return invocation, nil
+}
+
+// equivalentKind returns a group/version/kind covered by one of the hook's rules
+// that the request object can be converted to, for hooks with matchPolicy
+// Equivalent whose rules did not match the request's own group and version.
+func (a *Webhook) equivalentKind(h *v1beta1.Webhook, attr admission.Attributes, o admission.ObjectInterfaces) (schema.GroupVersionKind, bool) {
+	requestKind := attr.GetKind()
+	for _, r := range h.Rules {
+		equivalentAttr := &attrWithResourceOverride{Attributes: attr}
+		for _, group := range r.APIGroups {
+			for _, version := range r.APIVersions {
+				if group == "*" || version == "*" {
+					// wildcard rules match the request's own group or version and
+					// are already handled by exact matching.
+					continue
+				}
+				kind := schema.GroupVersionKind{Group: group, Version: version, Kind: requestKind.Kind}
+				if kind == requestKind {
+					continue
+				}
+				// only consider group/versions the request object can actually be
+				// converted to.
+				if _, err := o.GetObjectCreater().New(kind); err != nil {
+					continue
+				}
+				equivalentAttr.resource = schema.GroupVersionResource{Group: group, Version: version, Resource: attr.GetResource().Resource}
+				m := rules.Matcher{Rule: r, Attr: equivalentAttr}
+				if m.Matches() {
+					return kind, true
+				}
+			}
+		}
 	}
+	return schema.GroupVersionKind{}, false
+}
 
-	return a.namespaceMatcher.MatchNamespaceSelector(h, attr)
+// attrWithResourceOverride overrides the resource of admission attributes, so
+// rules can be evaluated as if the request had arrived via an equivalent API
+// group and version.
+type attrWithResourceOverride struct {
+	admission.Attributes
+	resource schema.GroupVersionResource
 }
 
+func (a *attrWithResourceOverride) GetResource() schema.GroupVersionResource { return a.resource }
+
 // Dispatch is called by the downstream Validate or Admit methods.
 func (a *Webhook) Dispatch(attr admission.Attributes, o admission.ObjectInterfaces) error {
 	if rules.IsWebhookConfigurationResource(attr) {
@@ -154,39 +221,57 @@ func (a *Webhook) Dispatch(attr admission.Attributes, o admission.ObjectInterfac
 	// TODO: Figure out if adding one second timeout make sense here.
 	ctx := context.TODO()
 
-	var relevantHooks []*v1beta1.Webhook
+	var invocations []*WebhookInvocation
 	for i := range hooks {
-		call, err := a.ShouldCallHook(&hooks[i], attr)
+		invocation, err := a.ShouldCallHook(&hooks[i], attr, o)
 		if err != nil {
 			return err
 		}
-		if call {
-			relevantHooks = append(relevantHooks, &hooks[i])
+		if invocation != nil {
+			invocations = append(invocations, invocation)
 		}
 	}
 
-	if len(relevantHooks) == 0 {
+	if len(invocations) == 0 {
 		// no matching hooks
 		return nil
 	}
 
-	// convert the object to the external version before sending it to the webhook
-	versionedAttr := VersionedAttributes{
-		Attributes: attr,
-	}
-	if oldObj := attr.GetOldObject(); oldObj != nil {
-		out, err := ConvertToGVK(oldObj, attr.GetKind(), o)
-		if err != nil {
-			return apierrors.NewInternalError(err)
+	// group the invocations by the kind the request object must be converted to,
+	// so each distinct version is converted and dispatched once. Groups keep the
+	// order in which their first hook appeared.
+	var kinds []schema.GroupVersionKind
+	hooksByKind := map[schema.GroupVersionKind][]*v1beta1.Webhook{}
+	for _, invocation := range invocations {
+		if _, ok := hooksByKind[invocation.Kind]; !ok {
+			kinds = append(kinds, invocation.Kind)
 		}
-		versionedAttr.VersionedOldObject = out
+		hooksByKind[invocation.Kind] = append(hooksByKind[invocation.Kind], invocation.Webhook)
 	}
-	if obj := attr.GetObject(); obj != nil {
-		out, err := ConvertToGVK(obj, attr.GetKind(), o)
-		if err != nil {
-			return apierrors.NewInternalError(err)
+
+	for _, kind := range kinds {
+		// convert the object to the external version before sending it to the webhook
+		versionedAttr := VersionedAttributes{
+			Attributes:    attr,
+			VersionedKind: kind,
+		}
+		if oldObj := attr.GetOldObject(); oldObj != nil {
+			out, err := ConvertToGVK(oldObj, kind, o)
+			if err != nil {
+				return apierrors.NewInternalError(err)
+			}
+			versionedAttr.VersionedOldObject = out
+		}
+		if obj := attr.GetObject(); obj != nil {
+			out, err := ConvertToGVK(obj, kind, o)
+			if err != nil {
+				return apierrors.NewInternalError(err)
+			}
+			versionedAttr.VersionedObject = out
+		}
+		if err := a.dispatcher.Dispatch(ctx, &versionedAttr, o, hooksByKind[kind]); err != nil {
+			return err
 		}
-		versionedAttr.VersionedObject = out
 	}
-	return a.dispatcher.Dispatch(ctx, &versionedAttr, o, relevantHooks)
+	return nil
 }
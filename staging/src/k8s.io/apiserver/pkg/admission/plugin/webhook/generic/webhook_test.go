@@ -0,0 +1,88 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generic
+
+import (
+	"testing"
+
+	"k8s.io/api/admissionregistration/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+func TestEquivalentKind(t *testing.T) {
+	scheme := initiateScheme(t)
+	o := &admission.SchemeBasedObjectInterfaces{Scheme: scheme}
+	a := &Webhook{}
+
+	newHook := func(group, version, resource string) *v1beta1.Webhook {
+		return &v1beta1.Webhook{
+			Rules: []v1beta1.RuleWithOperations{{
+				Operations: []v1beta1.OperationType{v1beta1.OperationAll},
+				Rule: v1beta1.Rule{
+					APIGroups:   []string{group},
+					APIVersions: []string{version},
+					Resources:   []string{resource},
+				},
+			}},
+		}
+	}
+	attr := admission.NewAttributesRecord(nil, nil,
+		schema.GroupVersionKind{Group: "example.apiserver.k8s.io", Version: "v1", Kind: "ReplicaSet"},
+		"ns", "name",
+		schema.GroupVersionResource{Group: "example.apiserver.k8s.io", Version: "v1", Resource: "replicasets"},
+		"", admission.Create, nil, false, nil)
+
+	tests := []struct {
+		name         string
+		hook         *v1beta1.Webhook
+		expectedKind schema.GroupVersionKind
+		expectMatch  bool
+	}{
+		{
+			name:         "rule for a convertible group matches",
+			hook:         newHook("example2.apiserver.k8s.io", "v1", "replicasets"),
+			expectedKind: schema.GroupVersionKind{Group: "example2.apiserver.k8s.io", Version: "v1", Kind: "ReplicaSet"},
+			expectMatch:  true,
+		},
+		{
+			name:        "rule for an unknown group does not match",
+			hook:        newHook("other.k8s.io", "v1", "replicasets"),
+			expectMatch: false,
+		},
+		{
+			name:        "rule for another resource does not match",
+			hook:        newHook("example2.apiserver.k8s.io", "v1", "deployments"),
+			expectMatch: false,
+		},
+		{
+			name:        "wildcard rules are left to exact matching",
+			hook:        newHook("*", "v1", "replicasets"),
+			expectMatch: false,
+		},
+	}
+	for _, test := range tests {
+		kind, ok := a.equivalentKind(test.hook, attr, o)
+		if ok != test.expectMatch {
+			t.Errorf("%s: expected match=%v, got %v", test.name, test.expectMatch, ok)
+			continue
+		}
+		if ok && kind != test.expectedKind {
+			t.Errorf("%s: expected kind %v, got %v", test.name, test.expectedKind, kind)
+		}
+	}
+}
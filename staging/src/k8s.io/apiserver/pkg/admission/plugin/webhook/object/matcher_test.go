@@ -0,0 +1,119 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"testing"
+
+	"k8s.io/api/admissionregistration/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+func TestObjectSelector(t *testing.T) {
+	nodeLevel1 := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				"runlevel": "1",
+			},
+		},
+	}
+	nodeLevel2 := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				"runlevel": "2",
+			},
+		},
+	}
+	matcher := &Matcher{}
+	allScopes := v1beta1.AllScopes
+	testcases := []struct {
+		name string
+
+		objectSelector *metav1.LabelSelector
+		attrs          admission.Attributes
+
+		expectCall bool
+	}{
+		{
+			name:           "empty object selector matches everything",
+			objectSelector: &metav1.LabelSelector{},
+			attrs:          admission.NewAttributesRecord(nodeLevel1, nil, schema.GroupVersionKind{}, "", "name", schema.GroupVersionResource{}, "", admission.Create, nil, false, nil),
+			expectCall:     true,
+		},
+		{
+			name:           "matches new object",
+			objectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"runlevel": "1"}},
+			attrs:          admission.NewAttributesRecord(nodeLevel1, nil, schema.GroupVersionKind{}, "", "name", schema.GroupVersionResource{}, "", admission.Create, nil, false, nil),
+			expectCall:     true,
+		},
+		{
+			name:           "matches old object",
+			objectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"runlevel": "1"}},
+			attrs:          admission.NewAttributesRecord(nil, nodeLevel1, schema.GroupVersionKind{}, "", "name", schema.GroupVersionResource{}, "", admission.Delete, nil, false, nil),
+			expectCall:     true,
+		},
+		{
+			name:           "matches one of new object and old object",
+			objectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"runlevel": "1"}},
+			attrs:          admission.NewAttributesRecord(nodeLevel1, nodeLevel2, schema.GroupVersionKind{}, "", "name", schema.GroupVersionResource{}, "", admission.Update, nil, false, nil),
+			expectCall:     true,
+		},
+		{
+			name:           "does not match new object nor old object",
+			objectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"runlevel": "3"}},
+			attrs:          admission.NewAttributesRecord(nodeLevel1, nodeLevel2, schema.GroupVersionKind{}, "", "name", schema.GroupVersionResource{}, "", admission.Update, nil, false, nil),
+			expectCall:     false,
+		},
+		{
+			name:           "does not match a nil object",
+			objectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"runlevel": "1"}},
+			attrs:          admission.NewAttributesRecord(nil, nil, schema.GroupVersionKind{}, "", "name", schema.GroupVersionResource{}, "", admission.Connect, nil, false, nil),
+			expectCall:     false,
+		},
+		{
+			name:           "does not match an object that cannot have labels",
+			objectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"runlevel": "1"}},
+			attrs:          admission.NewAttributesRecord(&runtime.Unknown{}, nil, schema.GroupVersionKind{}, "", "name", schema.GroupVersionResource{}, "", admission.Create, nil, false, nil),
+			expectCall:     false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			hook := &v1beta1.Webhook{
+				NamespaceSelector: &metav1.LabelSelector{},
+				ObjectSelector:    testcase.objectSelector,
+				Rules: []v1beta1.RuleWithOperations{{
+					Operations: []v1beta1.OperationType{"*"},
+					Rule:       v1beta1.Rule{APIGroups: []string{"*"}, APIVersions: []string{"*"}, Resources: []string{"*"}, Scope: &allScopes},
+				}},
+			}
+
+			matches, err := matcher.MatchObjectSelector(hook, testcase.attrs)
+			if err != nil {
+				t.Error(err)
+			}
+			if matches != testcase.expectCall {
+				t.Errorf("expected match %v, got %v", testcase.expectCall, matches)
+			}
+		})
+	}
+}
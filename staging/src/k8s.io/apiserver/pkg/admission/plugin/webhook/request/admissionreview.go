@@ -28,6 +28,10 @@ import (
 // CreateAdmissionReview creates an AdmissionReview for the provided admission.Attributes
 func CreateAdmissionReview(attr *generic.VersionedAttributes) admissionv1beta1.AdmissionReview {
 	gvk := attr.GetKind()
+	if !attr.VersionedKind.Empty() {
+		// the object was converted to an equivalent kind the webhook registered for
+		gvk = attr.VersionedKind
+	}
 	gvr := attr.GetResource()
 	aUserInfo := attr.GetUserInfo()
 	userInfo := authenticationv1.UserInfo{
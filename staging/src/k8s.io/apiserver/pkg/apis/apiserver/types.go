@@ -30,6 +30,13 @@ type AdmissionConfiguration struct {
 	// Plugins allows specifying a configuration per admission control plugin.
 	// +optional
 	Plugins []AdmissionPluginConfiguration
+
+	// PluginOrder explicitly declares the order the enabled admission plugins
+	// run in, overriding the server's recommended order. When set, it must
+	// list every enabled plugin exactly once; unknown plugin names are
+	// rejected at startup.
+	// +optional
+	PluginOrder []string
 }
 
 // AdmissionPluginConfiguration provides the configuration for a single plug-in.
@@ -35,6 +35,11 @@ func (in *AdmissionConfiguration) DeepCopyInto(out *AdmissionConfiguration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PluginOrder != nil {
+		in, out := &in.PluginOrder, &out.PluginOrder
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -0,0 +1,67 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"fmt"
+
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+)
+
+// NewNamespaceFilterBackend returns a backend which forwards only events that refer to
+// an object in one of the given namespaces to delegate. It allows sensitive namespaces
+// to be routed to a dedicated sink without receiving the rest of the cluster's events.
+func NewNamespaceFilterBackend(delegate Backend, namespaces []string) Backend {
+	filter := &namespaceFilterBackend{
+		delegate:   delegate,
+		namespaces: make(map[string]bool, len(namespaces)),
+	}
+	for _, namespace := range namespaces {
+		filter.namespaces[namespace] = true
+	}
+	return filter
+}
+
+type namespaceFilterBackend struct {
+	delegate   Backend
+	namespaces map[string]bool
+}
+
+func (b *namespaceFilterBackend) ProcessEvents(events ...*auditinternal.Event) bool {
+	filtered := make([]*auditinternal.Event, 0, len(events))
+	for _, event := range events {
+		if event.ObjectRef != nil && b.namespaces[event.ObjectRef.Namespace] {
+			filtered = append(filtered, event)
+		}
+	}
+	if len(filtered) == 0 {
+		return true
+	}
+	return b.delegate.ProcessEvents(filtered...)
+}
+
+func (b *namespaceFilterBackend) Run(stopCh <-chan struct{}) error {
+	return b.delegate.Run(stopCh)
+}
+
+func (b *namespaceFilterBackend) Shutdown() {
+	b.delegate.Shutdown()
+}
+
+func (b *namespaceFilterBackend) String() string {
+	return fmt.Sprintf("namespace[%s]", b.delegate)
+}
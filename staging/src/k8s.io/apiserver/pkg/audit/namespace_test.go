@@ -0,0 +1,54 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"testing"
+
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+)
+
+func TestNamespaceFilterBackend(t *testing.T) {
+	events := []*auditinternal.Event{
+		{AuditID: "0", ObjectRef: &auditinternal.ObjectReference{Namespace: "kube-system"}},
+		{AuditID: "1", ObjectRef: &auditinternal.ObjectReference{Namespace: "default"}},
+		{AuditID: "2", ObjectRef: &auditinternal.ObjectReference{Namespace: "payments"}},
+		{AuditID: "3"}, // no object reference, e.g. a non-resource request
+	}
+
+	delegate := new(fakeBackend)
+	b := NewNamespaceFilterBackend(delegate, []string{"kube-system", "payments"})
+
+	if ok := b.ProcessEvents(events...); !ok {
+		t.Fatal("expected ProcessEvents to succeed")
+	}
+	if got := len(delegate.events); got != 2 {
+		t.Fatalf("wanted 2 events, got %d", got)
+	}
+	if delegate.events[0].AuditID != "0" || delegate.events[1].AuditID != "2" {
+		t.Errorf("wanted events 0 and 2, got %s and %s", delegate.events[0].AuditID, delegate.events[1].AuditID)
+	}
+
+	// Events entirely outside the listed namespaces never reach the delegate.
+	delegate.events = nil
+	if ok := b.ProcessEvents(events[1], events[3]); !ok {
+		t.Fatal("expected ProcessEvents to succeed")
+	}
+	if got := len(delegate.events); got != 0 {
+		t.Errorf("wanted no events, got %d", got)
+	}
+}
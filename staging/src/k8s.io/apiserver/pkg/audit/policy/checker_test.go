@@ -345,3 +345,63 @@ func TestUnionStages(t *testing.T) {
 		}
 	}
 }
+
+func TestNamespaceOverrideChecker(t *testing.T) {
+	testCases := []struct {
+		name          string
+		delegateLevel audit.Level
+		overrides     map[string]audit.Level
+		req           string
+		expLevel      audit.Level
+	}{
+		{
+			name:          "override raises the level for a listed namespace",
+			delegateLevel: audit.LevelMetadata,
+			overrides:     map[string]audit.Level{"default": audit.LevelRequestResponse},
+			req:           "namespaced",
+			expLevel:      audit.LevelRequestResponse,
+		},
+		{
+			name:          "override never lowers the level",
+			delegateLevel: audit.LevelRequestResponse,
+			overrides:     map[string]audit.Level{"default": audit.LevelMetadata},
+			req:           "namespaced",
+			expLevel:      audit.LevelRequestResponse,
+		},
+		{
+			name:          "unlisted namespace keeps the delegate level",
+			delegateLevel: audit.LevelMetadata,
+			overrides:     map[string]audit.Level{"kube-system": audit.LevelRequestResponse},
+			req:           "namespaced",
+			expLevel:      audit.LevelMetadata,
+		},
+		{
+			name:          "cluster scoped resources cannot be overridden",
+			delegateLevel: audit.LevelMetadata,
+			overrides:     map[string]audit.Level{"": audit.LevelRequestResponse},
+			req:           "cluster",
+			expLevel:      audit.LevelMetadata,
+		},
+		{
+			name:          "non-resource requests cannot be overridden",
+			delegateLevel: audit.LevelMetadata,
+			overrides:     map[string]audit.Level{"default": audit.LevelRequestResponse},
+			req:           "nonResource",
+			expLevel:      audit.LevelMetadata,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checker := NewNamespaceOverrideChecker(FakeChecker(tc.delegateLevel, nil), tc.overrides)
+			level, _ := checker.LevelAndStages(attrs[tc.req])
+			assert.Equal(t, tc.expLevel, level)
+		})
+	}
+}
+
+func TestNamespaceOverrideCheckerNoOverrides(t *testing.T) {
+	delegate := FakeChecker(audit.LevelMetadata, nil)
+	if checker := NewNamespaceOverrideChecker(delegate, nil); checker != delegate {
+		t.Error("expected the delegate to be returned unchanged when no overrides are set")
+	}
+}
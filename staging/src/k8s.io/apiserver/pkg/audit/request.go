@@ -18,6 +18,7 @@ package audit
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -35,6 +36,7 @@ import (
 	auditinternal "k8s.io/apiserver/pkg/apis/audit"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
 )
 
 const (
@@ -230,6 +232,16 @@ func LogAnnotation(ae *auditinternal.Event, key, value string) {
 	ae.Annotations[key] = value
 }
 
+// AddAuditAnnotation attaches a key/value annotation to the audit event of
+// the request in flight on ctx, if any. Admission plugins and other handlers
+// running below the audit filter can use it to make their policy decisions
+// traceable in the audit log; the annotation is included in every event
+// emitted for the request from that point on. It is a no-op when the request
+// is not audited or its audit level is below Metadata.
+func AddAuditAnnotation(ctx context.Context, key, value string) {
+	LogAnnotation(genericapirequest.AuditEventFrom(ctx), key, value)
+}
+
 // LogAnnotations fills in the Annotations according to the annotations map.
 func LogAnnotations(ae *auditinternal.Event, annotations map[string]string) {
 	if ae == nil || ae.Level.Less(auditinternal.LevelMetadata) {
@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
 )
 
 func TestLogAnnotation(t *testing.T) {
@@ -54,3 +55,16 @@ func TestMaybeTruncateUserAgent(t *testing.T) {
 	req.Header.Set("User-Agent", ua)
 	assert.NotEqual(t, ua, maybeTruncateUserAgent(req))
 }
+
+func TestAddAuditAnnotation(t *testing.T) {
+	ev := &auditinternal.Event{
+		Level:   auditinternal.LevelMetadata,
+		AuditID: "fake id",
+	}
+	ctx := genericapirequest.WithAuditEvent(genericapirequest.NewContext(), ev)
+	AddAuditAnnotation(ctx, "foo", "bar")
+	assert.Equal(t, "bar", ev.Annotations["foo"], "audit annotation should be attached to the event in the context.")
+
+	// A context without an audit event must not panic.
+	AddAuditAnnotation(genericapirequest.NewContext(), "foo", "bar")
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"reflect"
 	"strings"
@@ -1088,3 +1089,51 @@ want: %#+v`, got, converted)
 		})
 	}
 }
+
+func TestLimitedReadBody(t *testing.T) {
+	testcases := []struct {
+		desc        string
+		requestBody io.Reader
+		limit       int64
+		expectedErr bool
+	}{
+		{
+			desc:        "aaaa with limit 1",
+			requestBody: strings.NewReader("aaaa"),
+			limit:       1,
+			expectedErr: true,
+		},
+		{
+			desc:        "aaaa with limit 5",
+			requestBody: strings.NewReader("aaaa"),
+			limit:       5,
+			expectedErr: false,
+		},
+		{
+			desc:        "aaaa with no limit",
+			requestBody: strings.NewReader("aaaa"),
+			limit:       0,
+			expectedErr: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req, err := http.NewRequest("POST", "/", tc.requestBody)
+			if err != nil {
+				t.Errorf("err not expected: got %v", err)
+			}
+			_, err = limitedReadBody(req, tc.limit)
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("err expected: got nil")
+				} else if !apierrors.IsRequestEntityTooLargeError(err) {
+					t.Errorf("expected requestEntityTooLarge error: got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("err not expected: got %v", err)
+			}
+		})
+	}
+}
@@ -27,6 +27,22 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/audit"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+const (
+	// DeletionProtectionAnnotation marks an object as protected from deletion.
+	// The generic registry rejects DELETE requests for objects carrying this
+	// annotation with any value other than "false"; the annotation has to be
+	// removed (or set to "false") before the object can be deleted. This gives
+	// operators an advisory guardrail against accidental deletion of critical
+	// objects such as namespaces, CRDs or persistent volumes.
+	DeletionProtectionAnnotation = "kubernetes.io/deletion-protection"
+
+	// deletionProtectionAuditAnnotationKey is the audit annotation recorded
+	// when a delete request is rejected because of deletion protection.
+	deletionProtectionAuditAnnotationKey = "apiserver.k8s.io/deletion-protection-denied"
 )
 
 // RESTDeleteStrategy defines deletion behavior on an object that follows Kubernetes
@@ -86,6 +102,13 @@ func BeforeDelete(strategy RESTDeleteStrategy, ctx context.Context, obj runtime.
 			return false, false, errors.NewConflict(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, objectMeta.GetName(), fmt.Errorf("the ResourceVersion in the precondition (%s) does not match the ResourceVersion in record (%s). The object might have been modified", *options.Preconditions.ResourceVersion, objectMeta.GetResourceVersion()))
 		}
 	}
+	// Reject deletes of protected objects. Objects already being deleted are
+	// exempt so that finalization and garbage collection can proceed.
+	if objectMeta.GetDeletionTimestamp() == nil {
+		if err := checkDeletionProtection(ctx, objectMeta, gvk); err != nil {
+			return false, false, err
+		}
+	}
 	gracefulStrategy, ok := strategy.(RESTGracefulDeleteStrategy)
 	if !ok {
 		// If we're not deleting gracefully there's no point in updating Generation, as we won't update
@@ -142,6 +165,23 @@ func BeforeDelete(strategy RESTDeleteStrategy, ctx context.Context, obj runtime.
 	return true, false, nil
 }
 
+// checkDeletionProtection returns a forbidden error if the object carries the
+// deletion protection annotation, recording the rejection in the audit log.
+func checkDeletionProtection(ctx context.Context, objectMeta metav1.Object, gvk schema.GroupVersionKind) error {
+	value, ok := objectMeta.GetAnnotations()[DeletionProtectionAnnotation]
+	if !ok || value == "false" {
+		return nil
+	}
+	if ae := request.AuditEventFrom(ctx); ae != nil {
+		audit.LogAnnotation(ae, deletionProtectionAuditAnnotationKey, objectMeta.GetName())
+	}
+	return errors.NewForbidden(
+		schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind},
+		objectMeta.GetName(),
+		fmt.Errorf("the object is protected from deletion: remove the %s annotation to allow it to be deleted", DeletionProtectionAnnotation),
+	)
+}
+
 // AdmissionToValidateObjectDeleteFunc returns a admission validate func for object deletion
 func AdmissionToValidateObjectDeleteFunc(admit admission.Interface, staticAttributes admission.Attributes, objInterfaces admission.ObjectInterfaces) ValidateObjectFunc {
 	mutatingAdmission, isMutatingAdmission := admit.(admission.MutationInterface)
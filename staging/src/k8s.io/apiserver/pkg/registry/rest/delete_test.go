@@ -0,0 +1,74 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rest
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestCheckDeletionProtection(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Namespace"}
+	testcases := []struct {
+		name        string
+		annotations map[string]string
+		protected   bool
+	}{
+		{
+			name:        "no annotations",
+			annotations: nil,
+			protected:   false,
+		},
+		{
+			name:        "protection disabled",
+			annotations: map[string]string{DeletionProtectionAnnotation: "false"},
+			protected:   false,
+		},
+		{
+			name:        "protection enabled",
+			annotations: map[string]string{DeletionProtectionAnnotation: "true"},
+			protected:   true,
+		},
+		{
+			name:        "protection enabled with arbitrary value",
+			annotations: map[string]string{DeletionProtectionAnnotation: "do not delete"},
+			protected:   true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			objectMeta := &metav1.ObjectMeta{Name: "protected", Annotations: tc.annotations}
+			err := checkDeletionProtection(context.TODO(), objectMeta, gvk)
+			if !tc.protected {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected a forbidden error, got none")
+			}
+			if !errors.IsForbidden(err) {
+				t.Fatalf("expected a forbidden error, got: %v", err)
+			}
+		})
+	}
+}
@@ -37,6 +37,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
 	utilwaitgroup "k8s.io/apimachinery/pkg/util/waitgroup"
 	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/apiserver/pkg/admission"
@@ -171,6 +172,18 @@ type Config struct {
 	// Predicate which is true for paths of long-running http requests
 	LongRunningFunc apirequest.LongRunningRequestCheck
 
+	// RequestCostSink, if set, enables the request cost accounting filter and
+	// receives its periodic per-identity, per-namespace summaries.
+	RequestCostSink genericfilters.RequestCostSink
+	// RequestCostFlushPeriod is how often accumulated request cost summaries
+	// are flushed to RequestCostSink.
+	RequestCostFlushPeriod time.Duration
+
+	// ReadOnlyMode, if set, enables the read-only mode filter, which rejects
+	// mutating requests while the mode is enabled, e.g. during etcd
+	// maintenance. The mode can be toggled while the server is running.
+	ReadOnlyMode *genericfilters.ReadOnlyMode
+
 	// EnableAPIResponseCompression indicates whether API Responses should support compression
 	// if the client requests it via Accept-Encoding
 	EnableAPIResponseCompression bool
@@ -265,6 +278,7 @@ func NewConfig(codecs serializer.CodecFactory) *Config {
 		MaxRequestsInFlight:         400,
 		MaxMutatingRequestsInFlight: 200,
 		RequestTimeout:              time.Duration(60) * time.Second,
+		RequestCostFlushPeriod:      time.Minute,
 		MinRequestTimeout:           1800,
 		// 10MB is the recommended maximum client request size in bytes
 		// the etcd server should accept. See
@@ -542,7 +556,13 @@ func (c completedConfig) New(name string, delegationTarget DelegationTarget) (*G
 
 func DefaultBuildHandlerChain(apiHandler http.Handler, c *Config) http.Handler {
 	handler := genericapifilters.WithAuthorization(apiHandler, c.Authorization.Authorizer, c.Serializer)
+	if c.RequestCostSink != nil {
+		handler = genericfilters.WithRequestCostAccounting(handler, c.RequestCostSink, c.RequestCostFlushPeriod, wait.NeverStop)
+	}
 	handler = genericfilters.WithMaxInFlightLimit(handler, c.MaxRequestsInFlight, c.MaxMutatingRequestsInFlight, c.LongRunningFunc)
+	if c.ReadOnlyMode != nil {
+		handler = genericfilters.WithReadOnlyMode(handler, c.ReadOnlyMode, c.LongRunningFunc)
+	}
 	handler = genericapifilters.WithImpersonation(handler, c.Authorization.Authorizer, c.Serializer)
 	handler = genericapifilters.WithAudit(handler, c.AuditBackend, c.AuditPolicyChecker, c.LongRunningFunc)
 	failedHandler := genericapifilters.Unauthorized(c.Serializer, c.Authentication.SupportsBasicAuth)
@@ -566,7 +586,7 @@ func installAPI(s *GenericAPIServer, c *Config) {
 			goruntime.SetBlockProfileRate(1)
 		}
 		// so far, only logging related endpoints are considered valid to add for these debug flags.
-		routes.DebugFlags{}.Install(s.Handler.NonGoRestfulMux, "v", routes.StringFlagPutHandler(logs.GlogSetter))
+		routes.DebugFlags{}.InstallWithValue(s.Handler.NonGoRestfulMux, "v", logs.GlogGetter, logs.GlogSetter)
 	}
 	if c.EnableMetrics {
 		if c.EnableProfiling {
@@ -0,0 +1,213 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filters
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	"k8s.io/klog"
+)
+
+// RequestCostSummary is the accumulated cost of the requests made by one
+// authenticated identity against one namespace between two flushes.
+type RequestCostSummary struct {
+	// User is the name of the authenticated user, or empty if the request
+	// was not authenticated.
+	User string
+	// Namespace is the namespace the requests targeted, or empty for
+	// cluster scoped and non-resource requests.
+	Namespace string
+
+	// Requests is the total number of requests.
+	Requests int64
+	// RequestBytes is the total of the request body sizes, as declared by
+	// the clients.
+	RequestBytes int64
+	// ResponseBytes is the total number of bytes written in responses.
+	ResponseBytes int64
+	// StorageOps is the number of requests that result in storage writes
+	// (mutating resource requests).
+	StorageOps int64
+}
+
+// RequestCostSink receives periodic cost summaries from the accounting
+// filter. Flush is called from a single goroutine.
+type RequestCostSink interface {
+	Flush(summaries []RequestCostSummary)
+}
+
+// LogRequestCostSink writes cost summaries to the server log.
+type LogRequestCostSink struct{}
+
+// Flush implements RequestCostSink
+func (LogRequestCostSink) Flush(summaries []RequestCostSummary) {
+	for _, s := range summaries {
+		klog.Infof("request cost: user=%q namespace=%q requests=%d requestBytes=%d responseBytes=%d storageOps=%d",
+			s.User, s.Namespace, s.Requests, s.RequestBytes, s.ResponseBytes, s.StorageOps)
+	}
+}
+
+type costKey struct {
+	user      string
+	namespace string
+}
+
+// requestCostAccountant accumulates request costs between flushes.
+type requestCostAccountant struct {
+	lock      sync.Mutex
+	summaries map[costKey]*RequestCostSummary
+}
+
+func newRequestCostAccountant() *requestCostAccountant {
+	return &requestCostAccountant{summaries: map[costKey]*RequestCostSummary{}}
+}
+
+func (a *requestCostAccountant) record(user, namespace string, requestBytes, responseBytes int64, storageOp bool) {
+	key := costKey{user: user, namespace: namespace}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	summary, ok := a.summaries[key]
+	if !ok {
+		summary = &RequestCostSummary{User: user, Namespace: namespace}
+		a.summaries[key] = summary
+	}
+	summary.Requests++
+	summary.RequestBytes += requestBytes
+	summary.ResponseBytes += responseBytes
+	if storageOp {
+		summary.StorageOps++
+	}
+}
+
+// flush returns the accumulated summaries and resets the accountant.
+func (a *requestCostAccountant) flush() []RequestCostSummary {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if len(a.summaries) == 0 {
+		return nil
+	}
+	summaries := make([]RequestCostSummary, 0, len(a.summaries))
+	for _, summary := range a.summaries {
+		summaries = append(summaries, *summary)
+	}
+	a.summaries = map[costKey]*RequestCostSummary{}
+	return summaries
+}
+
+// WithRequestCostAccounting accumulates per-identity, per-namespace request
+// counts, bytes and storage operations, and flushes summaries to the given
+// sink every flushPeriod. It must be placed after authentication and request
+// info resolution in the filter chain.
+func WithRequestCostAccounting(handler http.Handler, sink RequestCostSink, flushPeriod time.Duration, stopCh <-chan struct{}) http.Handler {
+	if sink == nil {
+		return handler
+	}
+
+	accountant := newRequestCostAccountant()
+	go wait.Until(func() {
+		if summaries := accountant.flush(); summaries != nil {
+			sink.Flush(summaries)
+		}
+	}, flushPeriod, stopCh)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+
+		var userName string
+		if user, ok := apirequest.UserFrom(ctx); ok {
+			userName = user.GetName()
+		}
+
+		var namespace string
+		storageOp := false
+		if requestInfo, ok := apirequest.RequestInfoFrom(ctx); ok {
+			namespace = requestInfo.Namespace
+			storageOp = requestInfo.IsResourceRequest && !nonMutatingRequestVerbs.Has(requestInfo.Verb)
+		}
+
+		var requestBytes int64
+		if req.ContentLength > 0 {
+			requestBytes = req.ContentLength
+		}
+
+		counter := &countingResponseWriter{ResponseWriter: w}
+		handler.ServeHTTP(decorateCountingResponseWriter(counter, w), req)
+
+		accountant.record(userName, namespace, requestBytes, counter.bytes, storageOp)
+	})
+}
+
+// decorateCountingResponseWriter returns the counting wrapper itself, or a
+// delegator that additionally forwards CloseNotify, Flush and Hijack when the
+// inner ResponseWriter supports them, so upgrade requests (exec, attach,
+// port-forward) keep working behind the accounting filter.
+func decorateCountingResponseWriter(counter *countingResponseWriter, w http.ResponseWriter) http.ResponseWriter {
+	// check if the ResponseWriter we're wrapping is the fancy one we need
+	// or if the basic is sufficient
+	_, cn := w.(http.CloseNotifier)
+	_, fl := w.(http.Flusher)
+	_, hj := w.(http.Hijacker)
+	if cn && fl && hj {
+		return &fancyCountingResponseWriterDelegator{counter}
+	}
+	return counter
+}
+
+// countingResponseWriter counts the bytes written in the response.
+type countingResponseWriter struct {
+	http.ResponseWriter
+	bytes int64
+}
+
+var _ http.ResponseWriter = &countingResponseWriter{}
+
+func (c *countingResponseWriter) Write(p []byte) (int, error) {
+	n, err := c.ResponseWriter.Write(p)
+	c.bytes += int64(n)
+	return n, err
+}
+
+// fancyCountingResponseWriterDelegator delegates CloseNotify, Flush and
+// Hijack to the underlying ResponseWriter, to make watch and upgrade
+// requests possible.
+type fancyCountingResponseWriterDelegator struct {
+	*countingResponseWriter
+}
+
+var _ http.CloseNotifier = &fancyCountingResponseWriterDelegator{}
+var _ http.Flusher = &fancyCountingResponseWriterDelegator{}
+var _ http.Hijacker = &fancyCountingResponseWriterDelegator{}
+
+func (f *fancyCountingResponseWriterDelegator) CloseNotify() <-chan bool {
+	return f.ResponseWriter.(http.CloseNotifier).CloseNotify()
+}
+
+func (f *fancyCountingResponseWriterDelegator) Flush() {
+	f.ResponseWriter.(http.Flusher).Flush()
+}
+
+func (f *fancyCountingResponseWriterDelegator) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return f.ResponseWriter.(http.Hijacker).Hijack()
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/authentication/user"
+	apifilters "k8s.io/apiserver/pkg/endpoints/filters"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+type fakeCostSink struct {
+	flushed chan []RequestCostSummary
+}
+
+func (s *fakeCostSink) Flush(summaries []RequestCostSummary) {
+	s.flushed <- summaries
+}
+
+func TestRequestCostAccounting(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	sink := &fakeCostSink{flushed: make(chan []RequestCostSummary, 1)}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+	accounted := WithRequestCostAccounting(handler, sink, time.Millisecond, stopCh)
+	withUser := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := apirequest.WithUser(r.Context(), &user.DefaultInfo{Name: "alice"})
+		accounted.ServeHTTP(w, r.WithContext(ctx))
+	})
+	server := httptest.NewServer(apifilters.WithRequestInfo(withUser, newTestRequestInfoResolver()))
+	defer server.Close()
+
+	requests := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{"GET", "/api/v1/namespaces/chargeback/pods", ""},
+		{"POST", "/api/v1/namespaces/chargeback/pods", `{"kind":"Pod"}`},
+	}
+	for _, r := range requests {
+		req, err := http.NewRequest(r.method, server.URL+r.path, strings.NewReader(r.body))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	select {
+	case summaries := <-sink.flushed:
+		if len(summaries) != 1 {
+			t.Fatalf("expected a single summary, got %#v", summaries)
+		}
+		summary := summaries[0]
+		if summary.User != "alice" || summary.Namespace != "chargeback" {
+			t.Errorf("unexpected identity: %#v", summary)
+		}
+		if summary.Requests != 2 {
+			t.Errorf("expected 2 requests, got %d", summary.Requests)
+		}
+		if summary.StorageOps != 1 {
+			t.Errorf("expected 1 storage op, got %d", summary.StorageOps)
+		}
+		if summary.RequestBytes != int64(len(`{"kind":"Pod"}`)) {
+			t.Errorf("unexpected request bytes: %d", summary.RequestBytes)
+		}
+		if summary.ResponseBytes != int64(2*len("ok")) {
+			t.Errorf("unexpected response bytes: %d", summary.ResponseBytes)
+		}
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatalf("timed out waiting for a flush")
+	}
+}
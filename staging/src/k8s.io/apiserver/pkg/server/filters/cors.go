@@ -19,6 +19,7 @@ package filters
 import (
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"k8s.io/klog"
@@ -30,6 +31,45 @@ import (
 // Or, for a more detailed implementation use https://github.com/martini-contrib/cors
 // or implement CORS at your proxy layer.
 
+// CORSRule describes the CORS behavior for requests whose path matches
+// PathPrefix. Empty AllowedMethods, AllowedHeaders and ExposedHeaders use the
+// historic defaults of WithCORS.
+type CORSRule struct {
+	// PathPrefix restricts the rule to request paths with this prefix. An
+	// empty prefix matches every path.
+	PathPrefix string
+	// AllowedOriginPatterns is a list of anchored regular expressions matched
+	// against the request Origin. A rule with no patterns never matches.
+	AllowedOriginPatterns []string
+	AllowedMethods        []string
+	AllowedHeaders        []string
+	ExposedHeaders        []string
+	// AllowCredentials is sent verbatim as Access-Control-Allow-Credentials.
+	AllowCredentials string
+	// MaxAge, if positive, is sent as Access-Control-Max-Age on preflight
+	// responses so browsers can cache the preflight result.
+	MaxAge int
+	// AllowPrivateNetwork answers the Access-Control-Request-Private-Network
+	// preflight header with Access-Control-Allow-Private-Network, as required
+	// for requests from public origins to private network addresses.
+	AllowPrivateNetwork bool
+
+	compiledOriginPatterns []*regexp.Regexp
+}
+
+// matches reports whether the rule applies to the given request path and origin.
+func (r *CORSRule) matches(path, origin string) bool {
+	if !strings.HasPrefix(path, r.PathPrefix) {
+		return false
+	}
+	for _, re := range r.compiledOriginPatterns {
+		if re.MatchString(origin) {
+			return true
+		}
+	}
+	return false
+}
+
 // WithCORS is a simple CORS implementation that wraps an http Handler.
 // Pass nil for allowedMethods and allowedHeaders to use the defaults. If allowedOriginPatterns
 // is empty or nil, no CORS support is installed.
@@ -37,17 +77,48 @@ func WithCORS(handler http.Handler, allowedOriginPatterns []string, allowedMetho
 	if len(allowedOriginPatterns) == 0 {
 		return handler
 	}
-	allowedOriginPatternsREs := allowedOriginRegexps(allowedOriginPatterns)
+	return WithCORSRules(handler, []CORSRule{
+		{
+			AllowedOriginPatterns: allowedOriginPatterns,
+			AllowedMethods:        allowedMethods,
+			AllowedHeaders:        allowedHeaders,
+			ExposedHeaders:        exposedHeaders,
+			AllowCredentials:      allowCredentials,
+		},
+	})
+}
+
+// WithCORSRules wraps an http Handler with CORS support where each rule can
+// scope its allowed origins, methods and headers to a request path prefix.
+// The first rule matching both the request path and origin wins. If rules is
+// empty, no CORS support is installed.
+func WithCORSRules(handler http.Handler, rules []CORSRule) http.Handler {
+	if len(rules) == 0 {
+		return handler
+	}
+	for i := range rules {
+		// Anchor the patterns so a rule for example.com cannot be matched by
+		// dashboard.example.com and bypass a narrower rule further down.
+		anchored := make([]string, 0, len(rules[i].AllowedOriginPatterns))
+		for _, pattern := range rules[i].AllowedOriginPatterns {
+			anchored = append(anchored, "^(?:"+pattern+")$")
+		}
+		rules[i].compiledOriginPatterns = allowedOriginRegexps(anchored)
+	}
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		origin := req.Header.Get("Origin")
 		if origin != "" {
-			allowed := false
-			for _, re := range allowedOriginPatternsREs {
-				if allowed = re.MatchString(origin); allowed {
+			var rule *CORSRule
+			for i := range rules {
+				if rules[i].matches(req.URL.Path, origin) {
+					rule = &rules[i]
 					break
 				}
 			}
-			if allowed {
+			if rule != nil {
+				allowedMethods := rule.AllowedMethods
+				allowedHeaders := rule.AllowedHeaders
+				exposedHeaders := rule.ExposedHeaders
 				w.Header().Set("Access-Control-Allow-Origin", origin)
 				// Set defaults for methods and headers if nothing was passed
 				if allowedMethods == nil {
@@ -62,10 +133,16 @@ func WithCORS(handler http.Handler, allowedOriginPatterns []string, allowedMetho
 				w.Header().Set("Access-Control-Allow-Methods", strings.Join(allowedMethods, ", "))
 				w.Header().Set("Access-Control-Allow-Headers", strings.Join(allowedHeaders, ", "))
 				w.Header().Set("Access-Control-Expose-Headers", strings.Join(exposedHeaders, ", "))
-				w.Header().Set("Access-Control-Allow-Credentials", allowCredentials)
+				w.Header().Set("Access-Control-Allow-Credentials", rule.AllowCredentials)
 
 				// Stop here if its a preflight OPTIONS request
 				if req.Method == "OPTIONS" {
+					if rule.MaxAge > 0 {
+						w.Header().Set("Access-Control-Max-Age", strconv.Itoa(rule.MaxAge))
+					}
+					if rule.AllowPrivateNetwork && req.Header.Get("Access-Control-Request-Private-Network") == "true" {
+						w.Header().Set("Access-Control-Allow-Private-Network", "true")
+					}
 					w.WriteHeader(http.StatusNoContent)
 					return
 				}
@@ -184,3 +184,78 @@ func TestCompileRegex(t *testing.T) {
 		t.Errorf("Wrong regex returned: '%v': %v", uncompiledRegexes[1], regexes[1])
 	}
 }
+
+func TestCORSRules(t *testing.T) {
+	rules := []CORSRule{
+		{
+			PathPrefix:            "/apis/dashboard",
+			AllowedOriginPatterns: []string{"dashboard.example.com"},
+			AllowedMethods:        []string{"GET"},
+			MaxAge:                600,
+			AllowPrivateNetwork:   true,
+		},
+		{
+			AllowedOriginPatterns: []string{"example.com"},
+			AllowCredentials:      "true",
+		},
+	}
+
+	table := []struct {
+		path           string
+		origin         string
+		allowed        bool
+		allowedMethods string
+		maxAge         string
+		privateNetwork string
+	}{
+		// the per-path rule wins on its prefix
+		{"/apis/dashboard/v1", "dashboard.example.com", true, "GET", "600", "true"},
+		// outside the prefix, the per-path rule does not match its origin
+		{"/version", "dashboard.example.com", false, "", "", ""},
+		// the catch-all rule applies everywhere with defaults
+		{"/apis/dashboard/v1", "example.com", true, "POST, GET, OPTIONS, PUT, DELETE, PATCH", "", ""},
+		{"/version", "example.com", true, "POST, GET, OPTIONS, PUT, DELETE, PATCH", "", ""},
+		{"/version", "not-allowed.com", false, "", "", ""},
+	}
+
+	for _, item := range table {
+		handler := WithCORSRules(
+			http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}),
+			rules,
+		)
+		var response *http.Response
+		func() {
+			server := httptest.NewServer(handler)
+			defer server.Close()
+
+			request, err := http.NewRequest("OPTIONS", server.URL+item.path, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			request.Header.Set("Origin", item.origin)
+			request.Header.Set("Access-Control-Request-Private-Network", "true")
+
+			response, err = http.DefaultClient.Do(request)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}()
+
+		if item.allowed {
+			if response.Header.Get("Access-Control-Allow-Origin") != item.origin {
+				t.Errorf("%s from %s: expected %q as allowed origin, got: %v", item.path, item.origin, item.origin, response.Header)
+			}
+			if actual := response.Header.Get("Access-Control-Allow-Methods"); actual != item.allowedMethods {
+				t.Errorf("%s from %s: expected allowed methods %q, got %q", item.path, item.origin, item.allowedMethods, actual)
+			}
+			if actual := response.Header.Get("Access-Control-Max-Age"); actual != item.maxAge {
+				t.Errorf("%s from %s: expected max age %q, got %q", item.path, item.origin, item.maxAge, actual)
+			}
+			if actual := response.Header.Get("Access-Control-Allow-Private-Network"); actual != item.privateNetwork {
+				t.Errorf("%s from %s: expected private network %q, got %q", item.path, item.origin, item.privateNetwork, actual)
+			}
+		} else if response.Header.Get("Access-Control-Allow-Origin") != "" {
+			t.Errorf("%s from %s: origin should not be allowed, got: %v", item.path, item.origin, response.Header)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filters
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/user"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/klog"
+)
+
+// readOnlyRetryAfter is the Retry-After value returned with read-only mode
+// rejections. Maintenance windows last minutes, so clients should not retry
+// aggressively.
+const readOnlyRetryAfter = "60"
+
+var readOnlyRejectedRequests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "apiserver_read_only_mode_rejected_requests_total",
+		Help: "Number of mutating requests rejected because the server is in read-only mode, partitioned by request verb.",
+	},
+	[]string{"verb"},
+)
+
+var registerReadOnlyMetrics sync.Once
+
+// ReadOnlyMode holds whether the server currently rejects mutating requests,
+// along with the users and groups that are exempt from the restriction. It is
+// safe to toggle while the server is running, so operators can enter and
+// leave maintenance (e.g. an etcd migration) without restarting the server.
+type ReadOnlyMode struct {
+	enabled int32
+
+	exemptUsers  sets.String
+	exemptGroups sets.String
+}
+
+// NewReadOnlyMode returns a ReadOnlyMode with the given initial state. The
+// exempt users and groups may still issue mutating requests while the mode is
+// enabled; members of system:masters are always exempt.
+func NewReadOnlyMode(enabled bool, exemptUsers, exemptGroups []string) *ReadOnlyMode {
+	registerReadOnlyMetrics.Do(func() {
+		prometheus.MustRegister(readOnlyRejectedRequests)
+	})
+	mode := &ReadOnlyMode{
+		exemptUsers:  sets.NewString(exemptUsers...),
+		exemptGroups: sets.NewString(exemptGroups...),
+	}
+	mode.Set(enabled)
+	return mode
+}
+
+// Set enables or disables read-only mode.
+func (m *ReadOnlyMode) Set(enabled bool) {
+	if enabled {
+		atomic.StoreInt32(&m.enabled, 1)
+		klog.Warning("Read-only mode enabled, mutating requests will be rejected")
+	} else {
+		atomic.StoreInt32(&m.enabled, 0)
+		klog.Info("Read-only mode disabled")
+	}
+}
+
+// Enabled returns whether mutating requests are currently rejected.
+func (m *ReadOnlyMode) Enabled() bool {
+	return atomic.LoadInt32(&m.enabled) == 1
+}
+
+// ToggleOnSignal flips read-only mode each time one of the given signals is
+// received, so operators can control maintenance windows without restarting
+// the server.
+func (m *ReadOnlyMode) ToggleOnSignal(signals ...os.Signal) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, signals...)
+	go func() {
+		for range ch {
+			m.Set(!m.Enabled())
+		}
+	}()
+}
+
+func (m *ReadOnlyMode) exempt(u user.Info) bool {
+	if m.exemptUsers.Has(u.GetName()) {
+		return true
+	}
+	for _, group := range u.GetGroups() {
+		// system:masters is always allowed through, consistent with the
+		// max-in-flight filter, so a super-admin can end the maintenance.
+		if group == user.SystemPrivilegedGroup || m.exemptGroups.Has(group) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithReadOnlyMode rejects mutating requests with 503 and a Retry-After
+// header while the given mode is enabled, except for requests from exempt
+// users or groups. Long-running requests such as exec and attach are passed
+// through, since they do not write to storage.
+func WithReadOnlyMode(handler http.Handler, mode *ReadOnlyMode, longRunningRequestCheck apirequest.LongRunningRequestCheck) http.Handler {
+	if mode == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !mode.Enabled() {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		ctx := r.Context()
+		requestInfo, ok := apirequest.RequestInfoFrom(ctx)
+		if !ok {
+			handleError(w, r, fmt.Errorf("no RequestInfo found in context, handler chain must be wrong"))
+			return
+		}
+		if nonMutatingRequestVerbs.Has(requestInfo.Verb) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		if longRunningRequestCheck != nil && longRunningRequestCheck(r, requestInfo) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		if currUser, ok := apirequest.UserFrom(ctx); ok && mode.exempt(currUser) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		readOnlyRejectedRequests.WithLabelValues(requestInfo.Verb).Inc()
+		w.Header().Set("Retry-After", readOnlyRetryAfter)
+		http.Error(w, "The cluster is in read-only mode for maintenance, please try again later.", http.StatusServiceUnavailable)
+	})
+}
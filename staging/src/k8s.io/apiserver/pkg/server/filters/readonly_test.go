@@ -0,0 +1,143 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/user"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+func newReadOnlyModeHandler(mode *ReadOnlyMode, u user.Info, verb string) http.Handler {
+	longRunningRequestCheck := BasicLongRunningRequestCheck(sets.NewString("watch"), sets.NewString("proxy"))
+	handler := WithReadOnlyMode(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+		mode,
+		longRunningRequestCheck,
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := apirequest.WithRequestInfo(r.Context(), &apirequest.RequestInfo{IsResourceRequest: true, Verb: verb})
+		if u != nil {
+			ctx = apirequest.WithUser(ctx, u)
+		}
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func TestReadOnlyMode(t *testing.T) {
+	tests := []struct {
+		name           string
+		enabled        bool
+		verb           string
+		user           user.Info
+		expectedStatus int
+	}{
+		{
+			name:           "mode disabled passes mutating requests",
+			enabled:        false,
+			verb:           "create",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "mode enabled passes read requests",
+			enabled:        true,
+			verb:           "get",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "mode enabled rejects mutating requests",
+			enabled:        true,
+			verb:           "create",
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "mode enabled passes long-running requests",
+			enabled:        true,
+			verb:           "watch",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "exempt user may mutate",
+			enabled:        true,
+			verb:           "delete",
+			user:           &user.DefaultInfo{Name: "migrator"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "exempt group may mutate",
+			enabled:        true,
+			verb:           "update",
+			user:           &user.DefaultInfo{Name: "bob", Groups: []string{"maintenance"}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "system:masters may always mutate",
+			enabled:        true,
+			verb:           "create",
+			user:           &user.DefaultInfo{Name: "admin", Groups: []string{user.SystemPrivilegedGroup}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "non-exempt user is rejected",
+			enabled:        true,
+			verb:           "create",
+			user:           &user.DefaultInfo{Name: "alice", Groups: []string{"developers"}},
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mode := NewReadOnlyMode(test.enabled, []string{"migrator"}, []string{"maintenance"})
+			server := httptest.NewServer(newReadOnlyModeHandler(mode, test.user, test.verb))
+			defer server.Close()
+
+			resp, err := http.Get(server.URL)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != test.expectedStatus {
+				t.Errorf("expected status %d, got %d", test.expectedStatus, resp.StatusCode)
+			}
+			if test.expectedStatus == http.StatusServiceUnavailable && resp.Header.Get("Retry-After") == "" {
+				t.Error("expected a Retry-After header on rejected requests")
+			}
+		})
+	}
+}
+
+func TestReadOnlyModeToggle(t *testing.T) {
+	mode := NewReadOnlyMode(false, nil, nil)
+	if mode.Enabled() {
+		t.Error("expected mode to start disabled")
+	}
+	mode.Set(true)
+	if !mode.Enabled() {
+		t.Error("expected mode to be enabled after Set(true)")
+	}
+	mode.Set(false)
+	if mode.Enabled() {
+		t.Error("expected mode to be disabled after Set(false)")
+	}
+}
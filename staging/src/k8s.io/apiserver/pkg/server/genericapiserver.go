@@ -128,6 +128,11 @@ type GenericAPIServer struct {
 	// It is set during PrepareRun.
 	OpenAPIVersionedService *handler.OpenAPIService
 
+	// GroupedOpenAPIVersionedService controls the /openapi/v3 per-group/version
+	// documents, and can be used to update the spec they are filtered from.
+	// It is set during PrepareRun.
+	GroupedOpenAPIVersionedService *routes.GroupedOpenAPIService
+
 	// StaticOpenAPISpec is the spec derived from the restful container endpoints.
 	// It is set during PrepareRun.
 	StaticOpenAPISpec *spec.Swagger
@@ -252,6 +257,7 @@ func (s *GenericAPIServer) PrepareRun() preparedGenericAPIServer {
 		s.OpenAPIVersionedService, s.StaticOpenAPISpec = routes.OpenAPI{
 			Config: s.openAPIConfig,
 		}.Install(s.Handler.GoRestfulContainer, s.Handler.NonGoRestfulMux)
+		s.GroupedOpenAPIVersionedService = routes.RegisterOpenAPIGroupedService(s.StaticOpenAPISpec, "/openapi/v3", s.Handler.NonGoRestfulMux)
 	}
 
 	s.installHealthz()
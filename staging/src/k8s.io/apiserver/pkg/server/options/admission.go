@@ -34,6 +34,7 @@ import (
 	apiserverapi "k8s.io/apiserver/pkg/apis/apiserver"
 	apiserverapiv1alpha1 "k8s.io/apiserver/pkg/apis/apiserver/v1alpha1"
 	"k8s.io/apiserver/pkg/server"
+	"k8s.io/apiserver/pkg/server/healthz"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -135,6 +136,19 @@ func (a *AdmissionOptions) ApplyTo(
 		return fmt.Errorf("failed to read plugin config: %v", err)
 	}
 
+	if source, ok := pluginsConfigProvider.(admissionConfigurationSource); ok {
+		registeredPlugins := sets.NewString(a.Plugins.Registered()...)
+		if unknown := sets.NewString(source.ConfiguredPlugins()...).Difference(registeredPlugins); unknown.Len() > 0 {
+			return fmt.Errorf("admission control configuration file %q contains configuration for unknown plugins: %v", a.ConfigFile, unknown.List())
+		}
+		if order := source.PluginOrder(); len(order) > 0 {
+			pluginNames, err = explicitPluginOrder(order, pluginNames, registeredPlugins)
+			if err != nil {
+				return fmt.Errorf("invalid pluginOrder in admission control configuration file %q: %v", a.ConfigFile, err)
+			}
+		}
+	}
+
 	clientset, err := kubernetes.NewForConfig(kubeAPIServerClientConfig)
 	if err != nil {
 		return err
@@ -144,7 +158,18 @@ func (a *AdmissionOptions) ApplyTo(
 	pluginInitializers = append(pluginInitializers, genericInitializer)
 	initializersChain = append(initializersChain, pluginInitializers...)
 
-	admissionChain, err := a.Plugins.NewFromPlugins(pluginNames, pluginsConfigProvider, initializersChain, a.Decorators)
+	// Collect healthz checks from plugins that can report the availability of
+	// their webhooks, so that an apiserver surfaces degraded health when
+	// cluster-critical webhooks are down instead of failing all writes
+	// silently.
+	decorators := append(admission.Decorators{admission.DecoratorFunc(func(handler admission.Interface, name string) admission.Interface {
+		if checker, ok := handler.(webhookHealthChecker); ok {
+			c.HealthzChecks = append(c.HealthzChecks, checker.HealthzCheck(fmt.Sprintf("admission-webhook-%s", strings.ToLower(name))))
+		}
+		return handler
+	})}, a.Decorators...)
+
+	admissionChain, err := a.Plugins.NewFromPlugins(pluginNames, pluginsConfigProvider, initializersChain, decorators)
 	if err != nil {
 		return err
 	}
@@ -153,6 +178,47 @@ func (a *AdmissionOptions) ApplyTo(
 	return nil
 }
 
+// admissionConfigurationSource is implemented by config providers that were
+// loaded from an AdmissionConfiguration file and can report the plugins the
+// file declares configuration or an explicit order for.
+type admissionConfigurationSource interface {
+	PluginOrder() []string
+	ConfiguredPlugins() []string
+}
+
+// explicitPluginOrder reorders the enabled plugins to the order declared in
+// the admission configuration. The declared order must only name registered
+// plugins and must cover every enabled plugin, so that declaring an order can
+// never silently drop part of the chain.
+func explicitPluginOrder(order, enabledPluginNames []string, registeredPlugins sets.String) ([]string, error) {
+	orderSet := sets.NewString(order...)
+	if len(order) != orderSet.Len() {
+		return nil, fmt.Errorf("plugin names must not be listed more than once")
+	}
+	if unknown := orderSet.Difference(registeredPlugins); unknown.Len() > 0 {
+		return nil, fmt.Errorf("unknown plugins: %v", unknown.List())
+	}
+	enabledSet := sets.NewString(enabledPluginNames...)
+	if missing := enabledSet.Difference(orderSet); missing.Len() > 0 {
+		return nil, fmt.Errorf("enabled plugins %v are missing from the declared order", missing.List())
+	}
+
+	orderedPlugins := []string{}
+	for _, plugin := range order {
+		if enabledSet.Has(plugin) {
+			orderedPlugins = append(orderedPlugins, plugin)
+		}
+	}
+	return orderedPlugins, nil
+}
+
+// webhookHealthChecker is implemented by admission plugins that can report
+// the availability of the webhooks they are configured with, without sending
+// admission traffic.
+type webhookHealthChecker interface {
+	HealthzCheck(name string) healthz.HealthzChecker
+}
+
 // Validate verifies flags passed to AdmissionOptions.
 func (a *AdmissionOptions) Validate() []error {
 	if a == nil {
@@ -217,6 +283,12 @@ func (a *AdmissionOptions) enabledPluginNames() []string {
 	return orderedPlugins
 }
 
+// EnabledPluginNames returns the ordered admission chain that would run with
+// the current options, for use in startup diagnostics.
+func (a *AdmissionOptions) EnabledPluginNames() []string {
+	return a.enabledPluginNames()
+}
+
 //Return names of plugins which are enabled by default
 func (a *AdmissionOptions) defaultEnabledPluginNames() []string {
 	defaultOnPluginNames := []string{}
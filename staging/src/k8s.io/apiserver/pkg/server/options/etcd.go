@@ -44,6 +44,19 @@ type EtcdOptions struct {
 
 	EtcdServersOverrides []string
 
+	// EtcdServersOverrideCAFile, EtcdServersOverrideCertFile and
+	// EtcdServersOverrideKeyFile are the TLS credentials used to talk to the
+	// etcd servers specified via EtcdServersOverrides, e.g. a dedicated
+	// events cluster. When unset, the main etcd credentials are reused.
+	EtcdServersOverrideCAFile   string
+	EtcdServersOverrideCertFile string
+	EtcdServersOverrideKeyFile  string
+
+	// StorageVersionOverrides pins the version at which individual resources
+	// are written to storage, e.g. "batch/cronjobs#v1beta1". The group is
+	// empty for the core API.
+	StorageVersionOverrides []string
+
 	// To enable protobuf as storage format, it is enough
 	// to set it to "application/vnd.kubernetes.protobuf".
 	DefaultStorageMediaType string
@@ -104,6 +117,46 @@ func (s *EtcdOptions) Validate() []error {
 
 	}
 
+	for _, override := range s.StorageVersionOverrides {
+		tokens := strings.Split(override, "#")
+		if len(tokens) != 2 || len(tokens[1]) == 0 {
+			allErrors = append(allErrors, fmt.Errorf("--storage-version-overrides invalid, must be of format: group/resource#version"))
+			continue
+		}
+
+		apiresource := strings.Split(tokens[0], "/")
+		if len(apiresource) != 2 {
+			allErrors = append(allErrors, fmt.Errorf("--storage-version-overrides invalid, must be of format: group/resource#version"))
+			continue
+		}
+	}
+
+	if (len(s.EtcdServersOverrideCertFile) == 0) != (len(s.EtcdServersOverrideKeyFile) == 0) {
+		allErrors = append(allErrors, fmt.Errorf("--etcd-servers-overrides-certfile and --etcd-servers-overrides-keyfile must be specified together"))
+	}
+
+	if s.StorageConfig.Transport.DialTimeout < 0 {
+		allErrors = append(allErrors, fmt.Errorf("--etcd-dial-timeout must not be negative"))
+	}
+	if s.StorageConfig.Transport.KeepaliveTime < 0 {
+		allErrors = append(allErrors, fmt.Errorf("--etcd-keepalive-time must not be negative"))
+	}
+	if s.StorageConfig.Transport.KeepaliveTimeout < 0 {
+		allErrors = append(allErrors, fmt.Errorf("--etcd-keepalive-timeout must not be negative"))
+	}
+	if s.StorageConfig.Transport.CallTimeout < 0 {
+		allErrors = append(allErrors, fmt.Errorf("--etcd-request-timeout must not be negative"))
+	}
+	if s.StorageConfig.Transport.MaxCallSendMsgSize < 0 {
+		allErrors = append(allErrors, fmt.Errorf("--etcd-max-call-send-msg-size must not be negative"))
+	}
+	if s.StorageConfig.Transport.MaxCallRecvMsgSize < 0 {
+		allErrors = append(allErrors, fmt.Errorf("--etcd-max-call-recv-msg-size must not be negative"))
+	}
+	if s.StorageConfig.LeaseReuseDurationSeconds < 0 {
+		allErrors = append(allErrors, fmt.Errorf("--etcd-lease-reuse-duration-seconds must not be negative"))
+	}
+
 	return allErrors
 }
 
@@ -117,6 +170,24 @@ func (s *EtcdOptions) AddFlags(fs *pflag.FlagSet) {
 		"Per-resource etcd servers overrides, comma separated. The individual override "+
 		"format: group/resource#servers, where servers are URLs, semicolon separated.")
 
+	fs.StringVar(&s.EtcdServersOverrideCAFile, "etcd-servers-overrides-cafile", s.EtcdServersOverrideCAFile,
+		"SSL Certificate Authority file used to secure communication with the etcd servers "+
+			"specified via --etcd-servers-overrides. If unset, --etcd-cafile is used.")
+
+	fs.StringVar(&s.EtcdServersOverrideCertFile, "etcd-servers-overrides-certfile", s.EtcdServersOverrideCertFile,
+		"SSL certification file used to secure communication with the etcd servers "+
+			"specified via --etcd-servers-overrides. If unset, --etcd-certfile is used.")
+
+	fs.StringVar(&s.EtcdServersOverrideKeyFile, "etcd-servers-overrides-keyfile", s.EtcdServersOverrideKeyFile,
+		"SSL key file used to secure communication with the etcd servers "+
+			"specified via --etcd-servers-overrides. If unset, --etcd-keyfile is used.")
+
+	fs.StringSliceVar(&s.StorageVersionOverrides, "storage-version-overrides", s.StorageVersionOverrides, ""+
+		"Per-resource storage version overrides, comma separated. The individual override "+
+		"format: group/resource#version, e.g. batch/cronjobs#v1beta1. The group is empty "+
+		"for the core API. Objects of the resource are written to storage at the pinned "+
+		"version instead of the default storage version of the group.")
+
 	fs.StringVar(&s.DefaultStorageMediaType, "storage-media-type", s.DefaultStorageMediaType, ""+
 		"The media type to use to store objects in storage. "+
 		"Some resources or storage backends may only support a specific media type and will ignore this setting.")
@@ -175,6 +246,29 @@ func (s *EtcdOptions) AddFlags(fs *pflag.FlagSet) {
 
 	fs.DurationVar(&s.StorageConfig.CountMetricPollPeriod, "etcd-count-metric-poll-period", s.StorageConfig.CountMetricPollPeriod, ""+
 		"Frequency of polling etcd for number of resources per type. 0 disables the metric collection.")
+
+	fs.DurationVar(&s.StorageConfig.Transport.DialTimeout, "etcd-dial-timeout", s.StorageConfig.Transport.DialTimeout,
+		"Timeout for establishing a connection to an etcd server. 0 means the default of 20s.")
+
+	fs.DurationVar(&s.StorageConfig.Transport.KeepaliveTime, "etcd-keepalive-time", s.StorageConfig.Transport.KeepaliveTime,
+		"Interval of client keepalive pings sent to detect a failed etcd server. 0 means the default of 30s.")
+
+	fs.DurationVar(&s.StorageConfig.Transport.KeepaliveTimeout, "etcd-keepalive-timeout", s.StorageConfig.Transport.KeepaliveTimeout,
+		"Time the client waits for a keepalive response before closing the etcd connection. 0 means the default of 10s.")
+
+	fs.DurationVar(&s.StorageConfig.Transport.CallTimeout, "etcd-request-timeout", s.StorageConfig.Transport.CallTimeout,
+		"Upper bound on the duration of every non-streaming etcd request. Watches are not affected. "+
+			"0 means requests are only bounded by the calling context.")
+
+	fs.IntVar(&s.StorageConfig.Transport.MaxCallSendMsgSize, "etcd-max-call-send-msg-size", s.StorageConfig.Transport.MaxCallSendMsgSize,
+		"Client-side etcd request send limit in bytes. 0 means the etcd client default.")
+
+	fs.IntVar(&s.StorageConfig.Transport.MaxCallRecvMsgSize, "etcd-max-call-recv-msg-size", s.StorageConfig.Transport.MaxCallRecvMsgSize,
+		"Client-side etcd response receive limit in bytes. 0 means the etcd client default.")
+
+	fs.Int64Var(&s.StorageConfig.LeaseReuseDurationSeconds, "etcd-lease-reuse-duration-seconds", s.StorageConfig.LeaseReuseDurationSeconds,
+		"Time window within which an etcd lease created for TTL'd objects (e.g. events) is reused "+
+			"for writes with a similar expiration time. 0 means the default of 60s.")
 }
 
 func (s *EtcdOptions) ApplyTo(c *server.Config) error {
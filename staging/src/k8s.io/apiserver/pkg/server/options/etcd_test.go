@@ -104,6 +104,56 @@ func TestEtcdOptionsValidate(t *testing.T) {
 			},
 			expectErr: "--etcd-servers-overrides invalid, must be of format: group/resource#servers, where servers are URLs, semicolon separated",
 		},
+		{
+			name: "test when storage-version-overrides is invalid",
+			testOptions: &EtcdOptions{
+				StorageConfig: storagebackend.Config{
+					Type: "etcd3",
+					Transport: storagebackend.TransportConfig{
+						ServerList: []string{"http://127.0.0.1"},
+						KeyFile:    "/var/run/kubernetes/etcd.key",
+						CAFile:     "/var/run/kubernetes/etcdca.crt",
+						CertFile:   "/var/run/kubernetes/etcdce.crt",
+					},
+					Prefix:                "/registry",
+					CompactionInterval:    storagebackend.DefaultCompactInterval,
+					CountMetricPollPeriod: time.Minute,
+				},
+				DefaultStorageMediaType: "application/vnd.kubernetes.protobuf",
+				DeleteCollectionWorkers: 1,
+				EnableGarbageCollection: true,
+				EnableWatchCache:        true,
+				DefaultWatchCacheSize:   100,
+				EtcdServersOverrides:    []string{"/events#http://127.0.0.1:4002"},
+				StorageVersionOverrides: []string{"batch/cronjobs"},
+			},
+			expectErr: "--storage-version-overrides invalid, must be of format: group/resource#version",
+		},
+		{
+			name: "test when etcd-request-timeout is negative",
+			testOptions: &EtcdOptions{
+				StorageConfig: storagebackend.Config{
+					Type:   "etcd3",
+					Prefix: "/registry",
+					Transport: storagebackend.TransportConfig{
+						ServerList:  []string{"http://127.0.0.1"},
+						KeyFile:     "/var/run/kubernetes/etcd.key",
+						CAFile:      "/var/run/kubernetes/etcdca.crt",
+						CertFile:    "/var/run/kubernetes/etcdce.crt",
+						CallTimeout: -time.Second,
+					},
+					CompactionInterval:    storagebackend.DefaultCompactInterval,
+					CountMetricPollPeriod: time.Minute,
+				},
+				DefaultStorageMediaType: "application/vnd.kubernetes.protobuf",
+				DeleteCollectionWorkers: 1,
+				EnableGarbageCollection: true,
+				EnableWatchCache:        true,
+				DefaultWatchCacheSize:   100,
+				EtcdServersOverrides:    []string{"/events#http://127.0.0.1:4002"},
+			},
+			expectErr: "--etcd-request-timeout must not be negative",
+		},
 		{
 			name: "test when EtcdOptions is valid",
 			testOptions: &EtcdOptions{
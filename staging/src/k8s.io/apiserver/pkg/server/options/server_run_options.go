@@ -24,6 +24,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apiserver/pkg/server"
+	serverfilters "k8s.io/apiserver/pkg/server/filters"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	// add the generic feature gates
@@ -52,6 +53,15 @@ type ServerRunOptions struct {
 	MaxRequestBodyBytes       int64
 	TargetRAMMB               int
 	EnableInfightQuotaHandler bool
+
+	// EnableReadOnlyMode starts the server in read-only mode, rejecting
+	// mutating requests until the mode is disabled again, e.g. by the
+	// signal the server owner wired via ReadOnlyMode.ToggleOnSignal.
+	EnableReadOnlyMode bool
+	// ReadOnlyModeExemptUsers and ReadOnlyModeExemptGroups may still issue
+	// mutating requests while read-only mode is enabled.
+	ReadOnlyModeExemptUsers  []string
+	ReadOnlyModeExemptGroups []string
 }
 
 func NewServerRunOptions() *ServerRunOptions {
@@ -77,6 +87,9 @@ func (s *ServerRunOptions) ApplyTo(c *server.Config) error {
 	c.JSONPatchMaxCopyBytes = s.JSONPatchMaxCopyBytes
 	c.MaxRequestBodyBytes = s.MaxRequestBodyBytes
 	c.PublicAddress = s.AdvertiseAddress
+	if s.EnableReadOnlyMode || len(s.ReadOnlyModeExemptUsers) > 0 || len(s.ReadOnlyModeExemptGroups) > 0 {
+		c.ReadOnlyMode = serverfilters.NewReadOnlyMode(s.EnableReadOnlyMode, s.ReadOnlyModeExemptUsers, s.ReadOnlyModeExemptGroups)
+	}
 
 	return nil
 }
@@ -194,5 +207,15 @@ func (s *ServerRunOptions) AddUniversalFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&s.EnableInfightQuotaHandler, "enable-inflight-quota-handler", s.EnableInfightQuotaHandler, ""+
 		"If true, replace the max-in-flight handler with an enhanced one that queues and dispatches with priority and fairness")
 
+	fs.BoolVar(&s.EnableReadOnlyMode, "read-only-mode", s.EnableReadOnlyMode, ""+
+		"If true, start the server in read-only mode, rejecting mutating requests with 503 until the mode "+
+		"is disabled. Useful during etcd maintenance or migrations.")
+
+	fs.StringSliceVar(&s.ReadOnlyModeExemptUsers, "read-only-mode-exempt-users", s.ReadOnlyModeExemptUsers, ""+
+		"Comma separated list of users that may still issue mutating requests while read-only mode is enabled.")
+
+	fs.StringSliceVar(&s.ReadOnlyModeExemptGroups, "read-only-mode-exempt-groups", s.ReadOnlyModeExemptGroups, ""+
+		"Comma separated list of groups that may still issue mutating requests while read-only mode is enabled.")
+
 	utilfeature.DefaultMutableFeatureGate.AddFlag(fs)
 }
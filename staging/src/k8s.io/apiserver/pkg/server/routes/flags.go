@@ -32,6 +32,7 @@ import (
 var (
 	lock            = &sync.RWMutex{}
 	registeredFlags = map[string]debugFlag{}
+	flagGetters     = map[string]StringFlagGetterFunc{}
 )
 
 // DebugFlags adds handlers for flags under /debug/flags.
@@ -49,6 +50,22 @@ func (f DebugFlags) Install(c *mux.PathRecorderMux, flag string, handler func(ht
 	f.addFlag(flag)
 }
 
+// InstallWithValue registers a flags handler like Install and additionally
+// records a getter for the flag, so that the index page and GET requests on
+// the flag's endpoint report its current value.
+func (f DebugFlags) InstallWithValue(c *mux.PathRecorderMux, flag string, getter StringFlagGetterFunc, setter StringFlagSetterFunc) {
+	c.UnlistedHandle("/debug/flags", http.HandlerFunc(f.Index))
+	c.UnlistedHandlePrefix("/debug/flags/", http.HandlerFunc(f.Index))
+
+	url := path.Join("/debug/flags", flag)
+	c.UnlistedHandleFunc(url, StringFlagHandler(getter, setter))
+
+	f.addFlag(flag)
+	lock.Lock()
+	defer lock.Unlock()
+	flagGetters[flag] = getter
+}
+
 // Index responds with the `/debug/flags` request.
 // For example, "/debug/flags/v" serves the "--v" flag.
 // Index responds to a request for "/debug/flags/" with an HTML page
@@ -56,7 +73,14 @@ func (f DebugFlags) Install(c *mux.PathRecorderMux, flag string, handler func(ht
 func (f DebugFlags) Index(w http.ResponseWriter, r *http.Request) {
 	lock.RLock()
 	defer lock.RUnlock()
-	if err := indexTmpl.Execute(w, registeredFlags); err != nil {
+	flags := make(map[string]debugFlag, len(registeredFlags))
+	for name, flag := range registeredFlags {
+		if getter, ok := flagGetters[name]; ok {
+			flag.Value = getter()
+		}
+		flags[name] = flag
+	}
+	if err := indexTmpl.Execute(w, flags); err != nil {
 		klog.Error(err)
 	}
 }
@@ -71,7 +95,7 @@ var indexTmpl = template.Must(template.New("index").Parse(`<html>
 flags:<br>
 <table>
 {{range .}}
-<tr>{{.Flag}}<br>
+<tr>{{.Flag}}{{if .Value}}={{.Value}}{{end}}<br>
 {{end}}
 </table>
 <br>
@@ -81,7 +105,8 @@ full flags configurable<br>
 `))
 
 type debugFlag struct {
-	Flag string
+	Flag  string
+	Value string
 }
 
 func (f DebugFlags) addFlag(flag string) {
@@ -93,6 +118,23 @@ func (f DebugFlags) addFlag(flag string) {
 // StringFlagSetterFunc is a func used for setting string type flag.
 type StringFlagSetterFunc func(string) (string, error)
 
+// StringFlagGetterFunc is a func used for reading the current value of a
+// string type flag.
+type StringFlagGetterFunc func() string
+
+// StringFlagHandler wraps an http Handler that serves the current value of a
+// string type flag on GET and updates it through the setter on PUT.
+func StringFlagHandler(getter StringFlagGetterFunc, setter StringFlagSetterFunc) http.HandlerFunc {
+	putHandler := StringFlagPutHandler(setter)
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == "GET" {
+			writePlainText(http.StatusOK, getter(), w)
+			return
+		}
+		putHandler(w, req)
+	})
+}
+
 // StringFlagPutHandler wraps an http Handler to set string type flag.
 func StringFlagPutHandler(setter StringFlagSetterFunc) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
@@ -42,5 +42,9 @@ func (oa OpenAPI) Install(c *restful.Container, mux *mux.PathRecorderMux) (*hand
 	if err != nil {
 		klog.Fatalf("Failed to register versioned open api spec for root: %v", err)
 	}
+	// The legacy path is backed by the lazy per-group service so aggregated
+	// servers don't pay for a second full spec build, and so it can answer
+	// with 304 Not Modified when the spec is unchanged.
+	RegisterLazyOpenAPIService(c, oa.Config, "/swagger.json", mux)
 	return openAPIVersionedService, spec
 }
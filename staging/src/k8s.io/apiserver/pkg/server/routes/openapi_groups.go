@@ -0,0 +1,157 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/spec"
+
+	"k8s.io/apiserver/pkg/server/mux"
+	"k8s.io/kube-openapi/pkg/aggregator"
+)
+
+// GroupedOpenAPIService serves per-group/version OpenAPI documents cut from
+// the full spec, following the /openapi/v3 discovery layout: an index listing
+// the available group-versions and one document per group-version containing
+// only that group's paths and the definitions they reference. Because the
+// documents are filtered from whatever the server currently serves, they
+// include dynamically registered resources along with their validation
+// schemas when present.
+type GroupedOpenAPIService struct {
+	servePath string
+
+	lock sync.RWMutex
+	// full is the spec the per-group documents are filtered from.
+	full *spec.Swagger
+	// specs caches the filtered documents, keyed by the relative group-version
+	// path (e.g. "apis/apps/v1" or "api/v1"). Entries are built on first
+	// request and invalidated by UpdateSpec.
+	specs map[string]*spec.Swagger
+}
+
+// RegisterOpenAPIGroupedService registers per-group/version OpenAPI documents
+// filtered from the given spec under servePath on the given mux.
+func RegisterOpenAPIGroupedService(sw *spec.Swagger, servePath string, pathHandler *mux.PathRecorderMux) *GroupedOpenAPIService {
+	o := &GroupedOpenAPIService{
+		servePath: servePath,
+		full:      sw,
+		specs:     map[string]*spec.Swagger{},
+	}
+	pathHandler.Handle(servePath, http.HandlerFunc(o.handleIndex))
+	pathHandler.UnlistedHandlePrefix(servePath+"/", http.HandlerFunc(o.handleGroupVersion))
+	return o
+}
+
+// UpdateSpec replaces the full spec the per-group documents are built from
+// and drops the cached documents.
+func (o *GroupedOpenAPIService) UpdateSpec(sw *spec.Swagger) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	o.full = sw
+	o.specs = map[string]*spec.Swagger{}
+}
+
+// groupVersionPaths returns the relative group-version paths present in the
+// full spec, sorted.
+func (o *GroupedOpenAPIService) groupVersionPaths() []string {
+	o.lock.RLock()
+	defer o.lock.RUnlock()
+	seen := map[string]bool{}
+	if o.full != nil && o.full.Paths != nil {
+		for path := range o.full.Paths.Paths {
+			if gv := groupVersionOf(path); len(gv) > 0 {
+				seen[gv] = true
+			}
+		}
+	}
+	paths := make([]string, 0, len(seen))
+	for gv := range seen {
+		paths = append(paths, gv)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// groupVersionOf extracts the relative group-version path from a spec path,
+// e.g. "/apis/apps/v1/deployments" -> "apis/apps/v1" and "/api/v1/pods" ->
+// "api/v1". It returns "" for paths outside the API groups.
+func groupVersionOf(path string) string {
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	switch {
+	case len(parts) >= 2 && parts[0] == "api":
+		return strings.Join(parts[:2], "/")
+	case len(parts) >= 3 && parts[0] == "apis":
+		return strings.Join(parts[:3], "/")
+	}
+	return ""
+}
+
+// specForGroupVersion returns the filtered document for the given relative
+// group-version path, building and caching it if needed. It returns nil if
+// the group-version is unknown.
+func (o *GroupedOpenAPIService) specForGroupVersion(gvPath string) *spec.Swagger {
+	o.lock.RLock()
+	cached, ok := o.specs[gvPath]
+	o.lock.RUnlock()
+	if ok {
+		return cached
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if cached, ok := o.specs[gvPath]; ok {
+		return cached
+	}
+	if o.full == nil || len(groupVersionOf("/"+gvPath+"/")) == 0 {
+		return nil
+	}
+	filtered := aggregator.FilterSpecByPathsWithoutSideEffects(o.full, []string{"/" + gvPath + "/"})
+	if filtered.Paths == nil || len(filtered.Paths.Paths) == 0 {
+		return nil
+	}
+	o.specs[gvPath] = filtered
+	return filtered
+}
+
+func (o *GroupedOpenAPIService) handleIndex(w http.ResponseWriter, r *http.Request) {
+	writeOpenAPIJSON(w, map[string][]string{"paths": o.groupVersionPaths()})
+}
+
+func (o *GroupedOpenAPIService) handleGroupVersion(w http.ResponseWriter, r *http.Request) {
+	gvPath := strings.Trim(strings.TrimPrefix(r.URL.Path, o.servePath), "/")
+	sw := o.specForGroupVersion(gvPath)
+	if sw == nil {
+		http.NotFound(w, r)
+		return
+	}
+	writeOpenAPIJSON(w, sw)
+}
+
+func writeOpenAPIJSON(w http.ResponseWriter, obj interface{}) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routes
+
+import (
+	"crypto/sha512"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	restful "github.com/emicklei/go-restful"
+	"github.com/go-openapi/spec"
+
+	"k8s.io/apiserver/pkg/server/mux"
+	"k8s.io/kube-openapi/pkg/aggregator"
+	"k8s.io/kube-openapi/pkg/builder"
+	"k8s.io/kube-openapi/pkg/common"
+)
+
+// LazyOpenAPIService serves an aggregated OpenAPI v2 document that is built
+// lazily, one web service root path at a time. Unlike OpenAPI.Install, which
+// builds the whole spec up front, nothing is generated until the first
+// request, and each group's spec is cached independently so invalidating one
+// group only rebuilds that group on the next request. The marshalled
+// aggregate is cached together with its ETag so unchanged specs are answered
+// with 304 Not Modified.
+type LazyOpenAPIService struct {
+	config    *common.Config
+	container *restful.Container
+
+	lock sync.Mutex
+	// groupSpecs caches the spec built for each web service root path.
+	groupSpecs map[string]*spec.Swagger
+	// specBytes and specETag cache the marshalled aggregate. They are
+	// dropped whenever a group is invalidated.
+	specBytes []byte
+	specETag  string
+}
+
+// RegisterLazyOpenAPIService registers a lazily built aggregated OpenAPI v2
+// document for the container's web services at servePath on the given mux.
+func RegisterLazyOpenAPIService(c *restful.Container, config *common.Config, servePath string, pathHandler *mux.PathRecorderMux) *LazyOpenAPIService {
+	o := &LazyOpenAPIService{
+		config:     config,
+		container:  c,
+		groupSpecs: map[string]*spec.Swagger{},
+	}
+	pathHandler.Handle(servePath, http.HandlerFunc(o.handleSpec))
+	return o
+}
+
+// InvalidateGroup drops the cached spec for the given web service root path,
+// along with the marshalled aggregate, so both are rebuilt on the next
+// request. Call it when the services under that root path change.
+func (o *LazyOpenAPIService) InvalidateGroup(rootPath string) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	delete(o.groupSpecs, rootPath)
+	o.specBytes = nil
+	o.specETag = ""
+}
+
+// aggregateBytes returns the marshalled aggregated spec and its ETag,
+// building any missing group specs first.
+func (o *LazyOpenAPIService) aggregateBytes() ([]byte, string, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if o.specBytes != nil {
+		return o.specBytes, o.specETag, nil
+	}
+
+	groups := map[string][]*restful.WebService{}
+	for _, ws := range o.container.RegisteredWebServices() {
+		groups[ws.RootPath()] = append(groups[ws.RootPath()], ws)
+	}
+	rootPaths := make([]string, 0, len(groups))
+	for rootPath := range groups {
+		rootPaths = append(rootPaths, rootPath)
+	}
+	sort.Strings(rootPaths)
+
+	var aggregate *spec.Swagger
+	for _, rootPath := range rootPaths {
+		groupSpec, ok := o.groupSpecs[rootPath]
+		if !ok {
+			var err error
+			groupSpec, err = builder.BuildOpenAPISpec(groups[rootPath], o.config)
+			if err != nil {
+				return nil, "", err
+			}
+			o.groupSpecs[rootPath] = groupSpec
+		}
+		if aggregate == nil {
+			// Copy so merging further groups does not mutate the cached group spec.
+			aggregate = &spec.Swagger{}
+			*aggregate = *groupSpec
+			continue
+		}
+		if err := aggregator.MergeSpecsIgnorePathConflict(aggregate, groupSpec); err != nil {
+			return nil, "", err
+		}
+	}
+
+	specBytes, err := json.Marshal(aggregate)
+	if err != nil {
+		return nil, "", err
+	}
+	o.specBytes = specBytes
+	o.specETag = fmt.Sprintf("\"%X\"", sha512.Sum512(specBytes))
+	return o.specBytes, o.specETag, nil
+}
+
+func (o *LazyOpenAPIService) handleSpec(w http.ResponseWriter, r *http.Request) {
+	specBytes, etag, err := o.aggregateBytes()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Etag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(specBytes)
+}
@@ -57,6 +57,15 @@ func (o *DefaultResourceEncodingConfig) SetResourceEncoding(resourceBeingStored
 	}
 }
 
+// ResourceOverrides returns the per-resource encoding overrides that have been set.
+func (o *DefaultResourceEncodingConfig) ResourceOverrides() map[schema.GroupResource]OverridingResourceEncoding {
+	overrides := make(map[schema.GroupResource]OverridingResourceEncoding, len(o.resources))
+	for resource, encoding := range o.resources {
+		overrides[resource] = *encoding
+	}
+	return overrides
+}
+
 func (o *DefaultResourceEncodingConfig) StorageEncodingFor(resource schema.GroupResource) (schema.GroupVersion, error) {
 	if !o.scheme.IsGroupRegistered(resource.Group) {
 		return schema.GroupVersion{}, fmt.Errorf("group %q is not registered in scheme", resource.Group)
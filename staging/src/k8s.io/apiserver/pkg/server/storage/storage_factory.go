@@ -93,6 +93,10 @@ type groupResourceOverrides struct {
 	// etcdLocation contains the list of "special" locations that are used for particular GroupResources
 	// These are merged on top of the StorageConfig when requesting the storage.Interface for a given GroupResource
 	etcdLocation []string
+	// etcdTransport, if set, replaces the whole transport configuration for a GroupResource,
+	// including the TLS credentials, so it can point at a separate etcd cluster.
+	// It takes precedence over etcdLocation.
+	etcdTransport *storagebackend.TransportConfig
 	// etcdPrefix is the base location for a GroupResource.
 	etcdPrefix string
 	// etcdResourcePrefix is the location to use to store a particular type under the `etcdPrefix` location
@@ -123,6 +127,9 @@ func (o groupResourceOverrides) Apply(config *storagebackend.Config, options *St
 	if len(o.etcdLocation) > 0 {
 		config.Transport.ServerList = o.etcdLocation
 	}
+	if o.etcdTransport != nil {
+		config.Transport = *o.etcdTransport
+	}
 	if len(o.etcdPrefix) > 0 {
 		config.Prefix = o.etcdPrefix
 	}
@@ -182,6 +189,15 @@ func (s *DefaultStorageFactory) SetEtcdLocation(groupResource schema.GroupResour
 	s.Overrides[groupResource] = overrides
 }
 
+// SetEtcdTransport overrides the whole transport for a resource, including the
+// TLS credentials, so the resource can be served from a separate etcd cluster
+// that does not share credentials with the main one.
+func (s *DefaultStorageFactory) SetEtcdTransport(groupResource schema.GroupResource, transport storagebackend.TransportConfig) {
+	overrides := s.Overrides[groupResource]
+	overrides.etcdTransport = &transport
+	s.Overrides[groupResource] = overrides
+}
+
 func (s *DefaultStorageFactory) SetEtcdPrefix(groupResource schema.GroupResource, prefix string) {
 	overrides := s.Overrides[groupResource]
 	overrides.etcdPrefix = prefix
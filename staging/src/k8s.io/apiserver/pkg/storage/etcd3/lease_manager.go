@@ -57,8 +57,9 @@ func newLeaseManager(client *clientv3.Client, leaseReuseDurationSeconds int64, l
 	}
 }
 
-// setLeaseReuseDurationSeconds is used for testing purpose. It is used to
-// reduce the extra lease duration to avoid unnecessary timeout in testing.
+// setLeaseReuseDurationSeconds overrides the lease reuse window. It is used to
+// tune the window from storage configuration and by tests to reduce the extra
+// lease duration and avoid unnecessary timeouts.
 func (l *leaseManager) setLeaseReuseDurationSeconds(duration int64) {
 	l.leaseMu.Lock()
 	defer l.leaseMu.Unlock()
@@ -82,8 +82,15 @@ type objState struct {
 }
 
 // New returns an etcd3 implementation of storage.Interface.
-func New(c *clientv3.Client, codec runtime.Codec, prefix string, transformer value.Transformer, pagingEnabled bool) storage.Interface {
-	return newStore(c, pagingEnabled, codec, prefix, transformer)
+// New returns an etcd3 implementation of storage.Interface. If
+// leaseReuseDurationSeconds is positive it overrides the default time window
+// within which leases created for TTL'd objects are reused.
+func New(c *clientv3.Client, codec runtime.Codec, prefix string, transformer value.Transformer, pagingEnabled bool, leaseReuseDurationSeconds int64) storage.Interface {
+	s := newStore(c, pagingEnabled, codec, prefix, transformer)
+	if leaseReuseDurationSeconds > 0 {
+		s.leaseManager.setLeaseReuseDurationSeconds(leaseReuseDurationSeconds)
+	}
+	return s
 }
 
 func newStore(c *clientv3.Client, pagingEnabled bool, codec runtime.Codec, prefix string, transformer value.Transformer) *store {
@@ -38,6 +38,25 @@ type TransportConfig struct {
 	KeyFile  string
 	CertFile string
 	CAFile   string
+	// DialTimeout is the timeout for failing to establish a connection.
+	// Zero means the default of 20 seconds.
+	DialTimeout time.Duration
+	// KeepaliveTime is the interval at which client keepalive pings are sent
+	// to detect a failed server. Zero means the default of 30 seconds.
+	KeepaliveTime time.Duration
+	// KeepaliveTimeout is how long the client waits for a keepalive response
+	// before closing the connection. Zero means the default of 10 seconds.
+	KeepaliveTimeout time.Duration
+	// CallTimeout bounds every unary call issued on the connection. Watches
+	// are streaming calls and are not affected. Zero means calls are only
+	// bounded by the caller's context.
+	CallTimeout time.Duration
+	// MaxCallSendMsgSize is the client-side request send limit in bytes.
+	// Zero means the etcd client default.
+	MaxCallSendMsgSize int
+	// MaxCallRecvMsgSize is the client-side response receive limit in bytes.
+	// Zero means the etcd client default.
+	MaxCallRecvMsgSize int
 }
 
 // Config is configuration for creating a storage backend.
@@ -68,6 +87,10 @@ type Config struct {
 	CompactionInterval time.Duration
 	// CountMetricPollPeriod specifies how often should count metric be updated
 	CountMetricPollPeriod time.Duration
+	// LeaseReuseDurationSeconds specifies the time window a lease created for
+	// TTL'd objects (e.g. events) is reused for subsequent writes with a
+	// similar expiration time. Zero means the default of 60 seconds.
+	LeaseReuseDurationSeconds int64
 }
 
 func NewDefaultConfig(prefix string, codec runtime.Codec) *Config {
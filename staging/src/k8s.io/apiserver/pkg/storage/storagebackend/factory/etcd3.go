@@ -17,7 +17,9 @@ limitations under the License.
 package factory
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"path"
 	"sync"
@@ -29,6 +31,7 @@ import (
 	grpcprom "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"google.golang.org/grpc"
 
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apiserver/pkg/storage"
 	"k8s.io/apiserver/pkg/storage/etcd3"
@@ -97,12 +100,26 @@ func newETCD3Client(c storagebackend.TransportConfig) (*clientv3.Client, error)
 	if len(c.CertFile) == 0 && len(c.KeyFile) == 0 && len(c.CAFile) == 0 {
 		tlsConfig = nil
 	}
+	unaryInterceptor := grpc.UnaryClientInterceptor(grpcprom.UnaryClientInterceptor)
+	if c.CallTimeout > 0 {
+		// The metrics interceptor runs inside the timeout so that calls failing
+		// the deadline are still recorded.
+		metricsInterceptor := unaryInterceptor
+		callTimeout := c.CallTimeout
+		unaryInterceptor = func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			ctx, cancel := context.WithTimeout(ctx, callTimeout)
+			defer cancel()
+			return metricsInterceptor(ctx, method, req, reply, cc, invoker, opts...)
+		}
+	}
 	cfg := clientv3.Config{
-		DialTimeout:          dialTimeout,
-		DialKeepAliveTime:    keepaliveTime,
-		DialKeepAliveTimeout: keepaliveTimeout,
+		DialTimeout:          durationOrDefault(c.DialTimeout, dialTimeout),
+		DialKeepAliveTime:    durationOrDefault(c.KeepaliveTime, keepaliveTime),
+		DialKeepAliveTimeout: durationOrDefault(c.KeepaliveTimeout, keepaliveTimeout),
+		MaxCallSendMsgSize:   c.MaxCallSendMsgSize,
+		MaxCallRecvMsgSize:   c.MaxCallRecvMsgSize,
 		DialOptions: []grpc.DialOption{
-			grpc.WithUnaryInterceptor(grpcprom.UnaryClientInterceptor),
+			grpc.WithUnaryInterceptor(unaryInterceptor),
 			grpc.WithStreamInterceptor(grpcprom.StreamClientInterceptor),
 		},
 		Endpoints: c.ServerList,
@@ -112,6 +129,13 @@ func newETCD3Client(c storagebackend.TransportConfig) (*clientv3.Client, error)
 	return clientv3.New(cfg)
 }
 
+func durationOrDefault(d, def time.Duration) time.Duration {
+	if d > 0 {
+		return d
+	}
+	return def
+}
+
 type runningCompactor struct {
 	interval time.Duration
 	cancel   context.CancelFunc
@@ -199,5 +223,56 @@ func newETCD3Storage(c storagebackend.Config) (storage.Interface, DestroyFunc, e
 	if transformer == nil {
 		transformer = value.IdentityTransformer
 	}
-	return etcd3.New(client, c.Codec, c.Prefix, transformer, c.Paging), destroyFunc, nil
+	return etcd3.New(client, c.Codec, c.Prefix, transformer, c.Paging, c.LeaseReuseDurationSeconds), destroyFunc, nil
+}
+
+// protoEncodingPrefix is the magic number prefixed to protobuf-encoded values,
+// see k8s.io/apimachinery/pkg/runtime/serializer/protobuf.
+var protoEncodingPrefix = []byte{0x6b, 0x38, 0x73, 0x00}
+
+// unrecognizedVersion is reported for stored values whose apiVersion cannot be
+// determined, for example because they are encrypted at rest.
+const unrecognizedVersion = "<unrecognized>"
+
+// CountObjectVersions samples up to limit values stored under prefix and
+// returns how many of them are stored at each apiVersion. The stored envelopes
+// are inspected directly without decoding the full objects.
+func CountObjectVersions(c storagebackend.Config, prefix string, limit int64) (map[string]int64, error) {
+	client, err := newETCD3Client(c.Transport)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	resp, err := client.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int64{}
+	for _, kv := range resp.Kvs {
+		counts[storedObjectVersion(kv.Value)]++
+	}
+	return counts, nil
+}
+
+// storedObjectVersion extracts the apiVersion from a stored value, which is
+// either a protobuf runtime.Unknown envelope or plain JSON.
+func storedObjectVersion(data []byte) string {
+	if bytes.HasPrefix(data, protoEncodingPrefix) {
+		unknown := &runtime.Unknown{}
+		if err := unknown.Unmarshal(data[len(protoEncodingPrefix):]); err != nil {
+			return unrecognizedVersion
+		}
+		return unknown.APIVersion
+	}
+	typeMeta := struct {
+		APIVersion string `json:"apiVersion"`
+	}{}
+	if err := json.Unmarshal(data, &typeMeta); err != nil || len(typeMeta.APIVersion) == 0 {
+		return unrecognizedVersion
+	}
+	return typeMeta.APIVersion
 }
@@ -18,6 +18,7 @@ package factory
 
 import (
 	"fmt"
+	"sync"
 
 	"k8s.io/apiserver/pkg/storage"
 	"k8s.io/apiserver/pkg/storage/storagebackend"
@@ -26,6 +27,50 @@ import (
 // DestroyFunc is to destroy any resources used by the storage returned in Create() together.
 type DestroyFunc func()
 
+// Backend describes a pluggable storage backend implementation that serves
+// the storage.Interface for a given storage type.
+type Backend struct {
+	// Create creates a storage backend based on given config.
+	Create func(c storagebackend.Config) (storage.Interface, DestroyFunc, error)
+	// CreateHealthCheck creates a healthcheck function based on given config.
+	CreateHealthCheck func(c storagebackend.Config) (func() error, error)
+}
+
+var (
+	backendsLock sync.RWMutex
+	backends     = map[string]Backend{}
+)
+
+// RegisterBackend registers an additional storage backend implementation under
+// the given storage type, making it selectable through the Type field of the
+// storage config (e.g. for serving specific resource groups out of a non-etcd
+// store). It is expected to be called from init() functions of backend
+// packages, before any storage is created. Registering a type twice, or
+// shadowing one of the built-in types, panics.
+func RegisterBackend(storageType string, backend Backend) {
+	backendsLock.Lock()
+	defer backendsLock.Unlock()
+
+	switch storageType {
+	case "etcd2", storagebackend.StorageTypeUnset, storagebackend.StorageTypeETCD3:
+		panic(fmt.Sprintf("storage type %q is built in and cannot be overridden", storageType))
+	}
+	if _, found := backends[storageType]; found {
+		panic(fmt.Sprintf("storage type %q was registered twice", storageType))
+	}
+	if backend.Create == nil || backend.CreateHealthCheck == nil {
+		panic(fmt.Sprintf("storage type %q must provide both Create and CreateHealthCheck", storageType))
+	}
+	backends[storageType] = backend
+}
+
+func registeredBackend(storageType string) (Backend, bool) {
+	backendsLock.RLock()
+	defer backendsLock.RUnlock()
+	backend, found := backends[storageType]
+	return backend, found
+}
+
 // Create creates a storage backend based on given config.
 func Create(c storagebackend.Config) (storage.Interface, DestroyFunc, error) {
 	switch c.Type {
@@ -34,6 +79,9 @@ func Create(c storagebackend.Config) (storage.Interface, DestroyFunc, error) {
 	case storagebackend.StorageTypeUnset, storagebackend.StorageTypeETCD3:
 		return newETCD3Storage(c)
 	default:
+		if backend, found := registeredBackend(c.Type); found {
+			return backend.Create(c)
+		}
 		return nil, nil, fmt.Errorf("unknown storage type: %s", c.Type)
 	}
 }
@@ -46,6 +94,9 @@ func CreateHealthCheck(c storagebackend.Config) (func() error, error) {
 	case storagebackend.StorageTypeUnset, storagebackend.StorageTypeETCD3:
 		return newETCD3HealthCheck(c)
 	default:
+		if backend, found := registeredBackend(c.Type); found {
+			return backend.CreateHealthCheck(c)
+		}
 		return nil, fmt.Errorf("unknown storage type: %s", c.Type)
 	}
 }
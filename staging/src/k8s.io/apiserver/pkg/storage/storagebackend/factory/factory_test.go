@@ -0,0 +1,62 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package factory
+
+import (
+	"testing"
+
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/storagebackend"
+)
+
+func TestRegisterBackend(t *testing.T) {
+	created := false
+	RegisterBackend("test-backend", Backend{
+		Create: func(c storagebackend.Config) (storage.Interface, DestroyFunc, error) {
+			created = true
+			return nil, func() {}, nil
+		},
+		CreateHealthCheck: func(c storagebackend.Config) (func() error, error) {
+			return func() error { return nil }, nil
+		},
+	})
+
+	if _, _, err := Create(storagebackend.Config{Type: "test-backend"}); err != nil {
+		t.Fatalf("Create with a registered backend returned error: %v", err)
+	}
+	if !created {
+		t.Fatalf("Create did not call the registered backend")
+	}
+	if _, err := CreateHealthCheck(storagebackend.Config{Type: "test-backend"}); err != nil {
+		t.Fatalf("CreateHealthCheck with a registered backend returned error: %v", err)
+	}
+
+	// unknown types are still rejected
+	if _, _, err := Create(storagebackend.Config{Type: "no-such-backend"}); err == nil {
+		t.Fatalf("Create with an unregistered backend did not return an error")
+	}
+
+	// the built-in types cannot be shadowed
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatalf("RegisterBackend with a built-in type did not panic")
+			}
+		}()
+		RegisterBackend(storagebackend.StorageTypeETCD3, Backend{})
+	}()
+}
@@ -70,6 +70,15 @@ func NewLogger(prefix string) *log.Logger {
 	return log.New(KlogWriter{}, prefix, 0)
 }
 
+// GlogGetter reports the current glog verbosity level, or the empty string
+// if the flag has not been registered.
+func GlogGetter() string {
+	if f := flag.Lookup("v"); f != nil {
+		return f.Value.String()
+	}
+	return ""
+}
+
 // GlogSetter is a setter to set glog level.
 func GlogSetter(val string) (string, error) {
 	var level klog.Level
@@ -0,0 +1,137 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/clock"
+)
+
+const (
+	// defaultBreakerFailureThreshold is the number of consecutive timeouts
+	// after which calls to a webhook host start failing fast.
+	defaultBreakerFailureThreshold = 5
+	// defaultBreakerOpenDuration is how long calls to a webhook host fail
+	// fast before the next call is let through to probe the backend.
+	defaultBreakerOpenDuration = 10 * time.Second
+)
+
+// circuitBreaker tracks consecutive timeouts per webhook host and fails calls
+// fast while a host is considered unhealthy. Failing fast surfaces the same
+// error path as a timed out call, so the webhook's failurePolicy still decides
+// whether the request is rejected or let through.
+type circuitBreaker struct {
+	failureThreshold int
+	openDuration     time.Duration
+	clock            clock.Clock
+
+	lock  sync.Mutex
+	hosts map[string]*hostBreaker
+}
+
+type hostBreaker struct {
+	consecutiveTimeouts int
+	openUntil           time.Time
+}
+
+func newCircuitBreaker() *circuitBreaker {
+	return &circuitBreaker{
+		failureThreshold: defaultBreakerFailureThreshold,
+		openDuration:     defaultBreakerOpenDuration,
+		clock:            clock.RealClock{},
+		hosts:            map[string]*hostBreaker{},
+	}
+}
+
+// check returns an error if calls to the host are currently failing fast.
+func (cb *circuitBreaker) check(host string) error {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	breaker, ok := cb.hosts[host]
+	if !ok {
+		return nil
+	}
+	if remaining := breaker.openUntil.Sub(cb.clock.Now()); remaining > 0 {
+		return fmt.Errorf("webhook backend %q failed to respond %d times in a row, failing fast for another %v", host, breaker.consecutiveTimeouts, remaining.Round(time.Millisecond))
+	}
+	return nil
+}
+
+// observe records the outcome of a call to the host. A response, even an
+// erroneous one, proves the backend is alive and closes the breaker; a
+// timeout opens the breaker once the failure threshold is reached.
+func (cb *circuitBreaker) observe(host string, err error) {
+	if !isTimeoutErr(err) {
+		cb.lock.Lock()
+		defer cb.lock.Unlock()
+		delete(cb.hosts, host)
+		return
+	}
+
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	breaker, ok := cb.hosts[host]
+	if !ok {
+		breaker = &hostBreaker{}
+		cb.hosts[host] = breaker
+	}
+	breaker.consecutiveTimeouts++
+	if breaker.consecutiveTimeouts >= cb.failureThreshold {
+		breaker.openUntil = cb.clock.Now().Add(cb.openDuration)
+	}
+}
+
+// transport wraps the delegate with the breaker for the given host.
+func (cb *circuitBreaker) transport(host string, delegate http.RoundTripper) http.RoundTripper {
+	return &breakerRoundTripper{host: host, breaker: cb, delegate: delegate}
+}
+
+func isTimeoutErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	if err == context.DeadlineExceeded {
+		return true
+	}
+	if netErr, ok := err.(net.Error); ok {
+		return netErr.Timeout()
+	}
+	return false
+}
+
+type breakerRoundTripper struct {
+	host     string
+	breaker  *circuitBreaker
+	delegate http.RoundTripper
+}
+
+var _ http.RoundTripper = &breakerRoundTripper{}
+
+func (rt *breakerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := rt.breaker.check(rt.host); err != nil {
+		return nil, err
+	}
+	resp, err := rt.delegate.RoundTrip(req)
+	rt.breaker.observe(rt.host, err)
+	return resp, err
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/clock"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "timed out" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestCircuitBreaker(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Now())
+	cb := newCircuitBreaker()
+	cb.clock = fakeClock
+
+	host := "webhook.example.com:443"
+
+	// The breaker stays closed below the failure threshold.
+	for i := 0; i < cb.failureThreshold-1; i++ {
+		cb.observe(host, timeoutError{})
+		if err := cb.check(host); err != nil {
+			t.Fatalf("breaker opened after %d timeouts: %v", i+1, err)
+		}
+	}
+
+	// The threshold-th consecutive timeout opens the breaker.
+	cb.observe(host, timeoutError{})
+	if err := cb.check(host); err == nil {
+		t.Fatalf("breaker still closed after %d timeouts", cb.failureThreshold)
+	}
+
+	// Other hosts are not affected.
+	if err := cb.check("other.example.com:443"); err != nil {
+		t.Fatalf("breaker for unrelated host opened: %v", err)
+	}
+
+	// The breaker closes again once the open duration passes.
+	fakeClock.Step(cb.openDuration + time.Second)
+	if err := cb.check(host); err != nil {
+		t.Fatalf("breaker still open after the open duration passed: %v", err)
+	}
+
+	// Any response, even an erroneous one, resets the failure count.
+	cb.observe(host, timeoutError{})
+	cb.observe(host, errors.New("connection refused"))
+	for i := 0; i < cb.failureThreshold-1; i++ {
+		cb.observe(host, timeoutError{})
+	}
+	if err := cb.check(host); err != nil {
+		t.Fatalf("breaker opened even though the failure count was reset: %v", err)
+	}
+}
+
+func TestIsTimeoutErr(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"deadline exceeded", context.DeadlineExceeded, true},
+		{"net timeout", timeoutError{}, true},
+		{"url error wrapping timeout", &url.Error{Op: "Post", URL: "https://webhook.example.com", Err: timeoutError{}}, true},
+		{"other error", errors.New("connection refused"), false},
+	}
+	for _, tc := range tests {
+		if got := isTimeoutErr(tc.err); got != tc.want {
+			t.Errorf("%s: isTimeoutErr = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"net/url"
 
 	"github.com/hashicorp/golang-lru"
@@ -59,6 +60,7 @@ type ClientManager struct {
 	serviceResolver      ServiceResolver
 	negotiatedSerializer runtime.NegotiatedSerializer
 	cache                *lru.Cache
+	breaker              *circuitBreaker
 }
 
 // NewClientManager creates a clientManager.
@@ -72,7 +74,8 @@ func NewClientManager(gv schema.GroupVersion, addToSchemaFunc func(s *runtime.Sc
 		return ClientManager{}, err
 	}
 	return ClientManager{
-		cache: cache,
+		cache:   cache,
+		breaker: newCircuitBreaker(),
 		negotiatedSerializer: serializer.NegotiatedSerializerWrapper(runtime.SerializerInfo{
 			Serializer: serializer.NewCodecFactory(hookScheme).LegacyCodec(gv),
 		}),
@@ -136,6 +139,21 @@ func (cm *ClientManager) HookClient(cc ClientConfig) (*rest.RESTClient, error) {
 
 		cfg.ContentConfig.NegotiatedSerializer = cm.negotiatedSerializer
 		cfg.ContentConfig.ContentType = runtime.ContentTypeJSON
+
+		// The client is cached, so its transport and the TLS connections it
+		// keeps alive are shared by all admission calls to this webhook.
+		// Fail calls fast while the backend keeps timing out; the resulting
+		// error is subject to the webhook's failurePolicy like any other
+		// call error.
+		host := cfg.Host
+		delegateWrap := cfg.WrapTransport
+		cfg.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+			if delegateWrap != nil {
+				rt = delegateWrap(rt)
+			}
+			return cm.breaker.transport(host, rt)
+		}
+
 		client, err := rest.UnversionedRESTClientFor(cfg)
 		if err == nil {
 			cm.cache.Add(string(cacheKey), client)
@@ -22,6 +22,7 @@ import (
 	"io"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 
@@ -41,6 +42,10 @@ import (
 var FileExtensions = []string{".json", ".yaml", ".yml"}
 var InputExtensions = append(FileExtensions, "stdin")
 
+// KustomizationFileNames lists the file names that mark a directory as a
+// kustomization root, in order of preference.
+var KustomizationFileNames = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
 const defaultHttpGetAttempts int = 3
 
 // Builder provides convenience functions for taking arguments and parameters
@@ -152,6 +157,25 @@ func (o *FilenameOptions) RequireFilenameOrKustomize() error {
 	return nil
 }
 
+// kustomizationDir validates the argument of the -k flag eagerly, so broken
+// kustomization roots are reported before any building happens. The error
+// messages match the ones the kustomize build would produce later.
+func kustomizationDir(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("the path %q passed to -k is not valid: %v", path, err)
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("%q must be a directory to be a root", path)
+	}
+	for _, name := range KustomizationFileNames {
+		if _, err := os.Stat(filepath.Join(path, name)); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("unable to find one of 'kustomization.yaml', 'kustomization.yml' or 'Kustomization' in directory %q", path)
+}
+
 type resourceTuple struct {
 	Resource string
 	Name     string
@@ -239,8 +263,13 @@ func (b *Builder) FilenameParam(enforceNamespace bool, filenameOptions *Filename
 		}
 	}
 	if filenameOptions.Kustomize != "" {
-		b.paths = append(b.paths, &KustomizeVisitor{filenameOptions.Kustomize,
-			NewStreamVisitor(nil, b.mapper, filenameOptions.Kustomize, b.schema)})
+		dir, err := kustomizationDir(filenameOptions.Kustomize)
+		if err != nil {
+			b.errs = append(b.errs, err)
+		} else {
+			b.paths = append(b.paths, &KustomizeVisitor{dir,
+				NewStreamVisitor(nil, b.mapper, dir, b.schema)})
+		}
 	}
 
 	if enforceNamespace {
@@ -50,6 +50,7 @@ import (
 	utiltesting "k8s.io/client-go/util/testing"
 
 	// TODO we need to remove this linkage and create our own scheme
+	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 )
@@ -569,6 +570,109 @@ func TestDirectoryBuilder(t *testing.T) {
 	}
 }
 
+func setupKustomizeOverlay() (string, error) {
+	path, err := ioutil.TempDir("/tmp", "")
+	if err != nil {
+		return "", err
+	}
+
+	contents := map[string]string{
+		"base/deployment.yaml": `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: the-deployment
+spec:
+  replicas: 1
+  template:
+    metadata:
+      labels:
+        deployment: hello
+    spec:
+      containers:
+      - name: the-container
+        image: monopole/hello:1
+`,
+		"base/kustomization.yaml": `
+resources:
+- deployment.yaml
+`,
+		"overlay/replicas.yaml": `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: the-deployment
+spec:
+  replicas: 5
+`,
+		"overlay/labelpatch.json": `[
+    {"op": "add", "path": "/metadata/labels", "value": {"patched": "true"}}
+]`,
+		"overlay/kustomization.yaml": `
+bases:
+- ../base
+patchesStrategicMerge:
+- replicas.yaml
+patchesJson6902:
+- target:
+    group: apps
+    version: v1
+    kind: Deployment
+    name: the-deployment
+  path: labelpatch.json
+`,
+	}
+
+	for filename, content := range contents {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(path, filename)), 0770); err != nil {
+			return "", err
+		}
+		if err := ioutil.WriteFile(filepath.Join(path, filename), []byte(content), 0660); err != nil {
+			return "", err
+		}
+	}
+	return path, nil
+}
+
+// TestKustomizePatchesAndBases ensures the -k path renders bases and applies
+// strategic-merge and JSON6902 patches client-side, so the visited objects
+// match what a standalone kustomize build would produce.
+func TestKustomizePatchesAndBases(t *testing.T) {
+	dir, err := setupKustomizeOverlay()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	b := newDefaultBuilder().
+		FilenameParam(false, &FilenameOptions{Kustomize: filepath.Join(dir, "overlay")}).
+		NamespaceParam("test").DefaultNamespace()
+	test := &testVisitor{}
+	if err := b.Do().Visit(test.Handle); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(test.Infos) != 1 {
+		t.Fatalf("unexpected responses: %#v", test.Infos)
+	}
+
+	obj := test.Infos[0].Object
+	labels, err := metaAccessor.Labels(obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if labels["patched"] != "true" {
+		t.Errorf("JSON6902 patch not applied, labels: %#v", labels)
+	}
+
+	deployment, ok := obj.(*appsv1.Deployment)
+	if !ok {
+		t.Fatalf("unexpected object type %T", obj)
+	}
+	if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas != 5 {
+		t.Errorf("strategic merge patch not applied: %#v", deployment.Spec.Replicas)
+	}
+}
+
 func setupKustomizeDirectory() (string, error) {
 	path, err := ioutil.TempDir("/tmp", "")
 	if err != nil {
@@ -0,0 +1,192 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remotecommand
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/websocket"
+
+	"k8s.io/apimachinery/pkg/util/runtime"
+	restclient "k8s.io/client-go/rest"
+)
+
+// The channel bytes prefixed to every message of the channel.k8s.io
+// subprotocols. The channel numbers match the POSIX file descriptors for the
+// standard streams, followed by the error and resize channels served by the
+// kubelet.
+const (
+	wsStdinChannel = iota
+	wsStdoutChannel
+	wsStderrChannel
+	wsErrorChannel
+	wsResizeChannel
+)
+
+// v4BinaryWebsocketProtocol is the subprotocol negotiated with the server. It
+// multiplexes the standard streams over binary messages and reports errors as
+// a json-marshaled metav1.Status on the error channel.
+const v4BinaryWebsocketProtocol = "v4.channel.k8s.io"
+
+// wsExecutor transports shell-style streams over a WebSocket connection. It
+// is an alternative to the SPDY executor for environments where intermediate
+// proxies do not pass the SPDY upgrade through.
+type wsExecutor struct {
+	config *websocket.Config
+}
+
+// NewWebSocketExecutor connects to the provided server and upgrades the
+// connection to a WebSocket speaking the v4 channel subprotocol. The standard
+// streams, the error channel and terminal resizes are multiplexed over the
+// single connection.
+func NewWebSocketExecutor(config *restclient.Config, url *url.URL) (Executor, error) {
+	wsURL := *url
+	switch wsURL.Scheme {
+	case "https":
+		wsURL.Scheme = "wss"
+	case "http":
+		wsURL.Scheme = "ws"
+	}
+
+	wsConfig, err := websocket.NewConfig(wsURL.String(), "http://localhost")
+	if err != nil {
+		return nil, err
+	}
+	wsConfig.Protocol = []string{v4BinaryWebsocketProtocol}
+
+	wsConfig.TlsConfig, err = restclient.TLSConfigFor(config)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := http.Header{}
+	if len(config.BearerToken) > 0 {
+		headers.Set("Authorization", "Bearer "+config.BearerToken)
+	} else if len(config.Username) > 0 && len(config.Password) > 0 {
+		auth := base64.StdEncoding.EncodeToString([]byte(config.Username + ":" + config.Password))
+		headers.Set("Authorization", "Basic "+auth)
+	}
+	wsConfig.Header = headers
+
+	return &wsExecutor{config: wsConfig}, nil
+}
+
+func (e *wsExecutor) Stream(options StreamOptions) error {
+	conn, err := websocket.DialConfig(e.config)
+	if err != nil {
+		return fmt.Errorf("unable to open websocket connection: %v", err)
+	}
+	defer conn.Close()
+
+	if options.Stdin != nil {
+		go func() {
+			defer runtime.HandleCrash()
+			copyToChannel(conn, wsStdinChannel, options.Stdin)
+		}()
+	}
+
+	if options.TerminalSizeQueue != nil {
+		go func() {
+			defer runtime.HandleCrash()
+			for {
+				size := options.TerminalSizeQueue.Next()
+				if size == nil {
+					return
+				}
+				data, err := json.Marshal(size)
+				if err != nil {
+					return
+				}
+				if err := sendOnChannel(conn, wsResizeChannel, data); err != nil {
+					return
+				}
+			}
+		}()
+	}
+
+	decoder := &errorDecoderV4{}
+	var streamErr error
+	for {
+		var message []byte
+		if err := websocket.Message.Receive(conn, &message); err != nil {
+			if err == io.EOF {
+				return streamErr
+			}
+			if streamErr != nil {
+				return streamErr
+			}
+			return fmt.Errorf("error reading from websocket connection: %v", err)
+		}
+		if len(message) == 0 {
+			continue
+		}
+
+		channel, payload := message[0], message[1:]
+		if len(payload) == 0 {
+			continue
+		}
+		switch channel {
+		case wsStdoutChannel:
+			if options.Stdout != nil {
+				if _, err := options.Stdout.Write(payload); err != nil {
+					return err
+				}
+			}
+		case wsStderrChannel:
+			if options.Stderr != nil {
+				if _, err := options.Stderr.Write(payload); err != nil {
+					return err
+				}
+			}
+		case wsErrorChannel:
+			// The server sends a single status on the error channel before
+			// closing the connection; keep draining so that the standard
+			// streams are fully delivered.
+			streamErr = decoder.decode(payload)
+		}
+	}
+}
+
+// copyToChannel forwards everything read from in to the given channel of the
+// websocket connection.
+func copyToChannel(conn *websocket.Conn, channel byte, in io.Reader) {
+	buffer := make([]byte, 4096)
+	for {
+		n, err := in.Read(buffer)
+		if n > 0 {
+			if sendErr := sendOnChannel(conn, channel, buffer[:n]); sendErr != nil {
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// sendOnChannel writes a single message carrying data on the given channel.
+func sendOnChannel(conn *websocket.Conn, channel byte, data []byte) error {
+	message := make([]byte, 0, len(data)+1)
+	message = append(message, channel)
+	message = append(message, data...)
+	return websocket.Message.Send(conn, message)
+}
@@ -272,7 +272,9 @@ func (c *AvailableConditionController) sync(key string) error {
 
 					// setting the system-masters identity ensures that we will always have access rights
 					transport.SetAuthProxyHeaders(newReq, "system:kube-aggregator", []string{"system:masters"}, nil)
+					start := time.Now()
 					resp, err := c.discoveryClient.Do(newReq)
+					probeDurationHistogram.WithLabelValues(apiService.Name).Observe(time.Since(start).Seconds())
 					if resp != nil {
 						resp.Body.Close()
 						// we should always been in the 200s or 300s
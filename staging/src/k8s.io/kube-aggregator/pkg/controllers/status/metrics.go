@@ -35,9 +35,18 @@ var (
 		},
 		[]string{"name"},
 	)
+	probeDurationHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "aggregator_apiservice_probe_duration_seconds",
+			Help:    "Latency of availability probes against the aggregated API server's discovery endpoint, broken down by APIService name.",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 14),
+		},
+		[]string{"name"},
+	)
 )
 
 func init() {
 	prometheus.MustRegister(unavailableCounter)
 	prometheus.MustRegister(unavailableGauge)
+	prometheus.MustRegister(probeDurationHistogram)
 }